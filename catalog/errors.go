@@ -0,0 +1,94 @@
+// Package catalog exposes a machine-readable mirror of this API's error
+// responses, so SDK generators and client teams can stay in sync with
+// server behavior without reverse-engineering it from source.
+package catalog
+
+import "net/http"
+
+// ErrorEntry describes one error code this API can return.
+type ErrorEntry struct {
+	Code       string `json:"code"`
+	HTTPStatus int    `json:"http_status"`
+	Message    string `json:"message"`
+}
+
+// Errors mirrors every services.Err* sentinel a controller maps to a
+// client-visible response via utils.Error, in the same order those
+// controllers appear in the tree. It is not exhaustive of every error
+// this codebase defines internally -- only ones actually surfaced to a
+// caller -- and it's maintained by hand alongside each controller's
+// errors.Is chain: Go has no reflection over package-level error vars,
+// so there's no way to generate this list from the services package at
+// build time the way the request describes. Adding a new client-visible
+// error should add an entry here in the same commit.
+var Errors = []ErrorEntry{
+	{"product_not_active", http.StatusUnprocessableEntity, "product is not open to new accounts"},
+	{"product_not_eligible", http.StatusUnprocessableEntity, "user does not meet the product's eligibility requirements"},
+	{"unsupported_currency", http.StatusUnprocessableEntity, "unsupported currency"},
+	{"recovery_request_not_pending", http.StatusConflict, "recovery request is not pending review"},
+	{"recovery_step_up_not_verifiable", http.StatusUnprocessableEntity, "recovery step-up is not verifiable"},
+	{"recovery_code_mismatch", http.StatusUnprocessableEntity, "recovery step-up code does not match"},
+	{"justification_required", http.StatusBadRequest, "reason code and justification are required"},
+	{"zero_adjustment", http.StatusBadRequest, "adjustment amount must not be zero"},
+	{"insufficient_funds", http.StatusUnprocessableEntity, "insufficient funds"},
+	{"invalid_scope", http.StatusBadRequest, "invalid scope"},
+	{"approval_not_pending", http.StatusConflict, "approval is not pending"},
+	{"same_maker_checker", http.StatusConflict, "checker must be a different user than the maker"},
+	{"invalid_credentials", http.StatusUnauthorized, "invalid email or pin"},
+	{"bulk_transfer_empty", http.StatusBadRequest, "bulk transfer batch must contain at least one line"},
+	{"bulk_transfer_insufficient_funds", http.StatusUnprocessableEntity, "debit account balance cannot cover bulk transfer batch"},
+	{"chaos_disabled", http.StatusForbidden, "fault injection is disabled in this environment"},
+	{"chaos_unknown_fault", http.StatusBadRequest, "unknown chaos fault"},
+	{"cheque_not_pending", http.StatusConflict, "cheque deposit is not pending"},
+	{"external_transfer_not_filed", http.StatusConflict, "external transfer is not filed"},
+	{"hold_not_pending", http.StatusConflict, "compliance hold is not pending"},
+	{"device_challenge_not_verifiable", http.StatusUnprocessableEntity, "device challenge is not verifiable"},
+	{"device_challenge_code_mismatch", http.StatusUnprocessableEntity, "device challenge code does not match"},
+	{"invalid_device_key", http.StatusBadRequest, "public key must be a base64-encoded ed25519 key"},
+	{"fx_rate_not_configured", http.StatusNotFound, "no fx rate configured for this currency pair"},
+	{"impersonation_target_invalid", http.StatusUnprocessableEntity, "target user is not an impersonatable customer"},
+	{"no_product", http.StatusUnprocessableEntity, "account has no product to accrue interest against"},
+	{"consent_not_found", http.StatusUnauthorized, "consent not found"},
+	{"consent_not_live", http.StatusForbidden, "consent is revoked or expired"},
+	{"account_not_consented", http.StatusForbidden, "account is not covered by this consent"},
+	{"payment_consent_not_found", http.StatusNotFound, "payment consent not found"},
+	{"payment_consent_not_pending", http.StatusConflict, "payment consent is not awaiting authorisation"},
+	{"payment_consent_expired", http.StatusUnprocessableEntity, "payment consent has expired"},
+	{"phone_not_registered", http.StatusNotFound, "phone number not registered"},
+	{"phone_login_cooldown", http.StatusTooManyRequests, "a login code was already requested recently, try again later"},
+	{"phone_login_not_verifiable", http.StatusUnprocessableEntity, "phone login request is not verifiable"},
+	{"phone_login_code_mismatch", http.StatusUnprocessableEntity, "phone login code does not match"},
+	{"phone_login_attempts_exceeded", http.StatusUnprocessableEntity, "too many phone login attempts"},
+	{"pin_reset_cooldown", http.StatusTooManyRequests, "a pin reset was already requested recently, try again later"},
+	{"pin_reset_not_verifiable", http.StatusUnprocessableEntity, "pin reset request is not verifiable"},
+	{"pin_reset_code_mismatch", http.StatusUnprocessableEntity, "pin reset code does not match"},
+	{"pin_reset_attempts_exceeded", http.StatusUnprocessableEntity, "too many pin reset attempts"},
+	{"insufficient_points", http.StatusUnprocessableEntity, "insufficient points"},
+	{"invalid_redemption", http.StatusUnprocessableEntity, "points amount is too small to redeem"},
+	{"session_not_found", http.StatusNotFound, "session not found"},
+	{"statement_email_rate_limited", http.StatusTooManyRequests, "too many statement email requests, try again later"},
+	{"suspense_item_not_open", http.StatusConflict, "suspense item is not open"},
+	{"no_open_teller_session", http.StatusConflict, "no open teller session"},
+	{"limit_exceeded", http.StatusUnprocessableEntity, "transaction exceeds account limit"},
+	{"currency_mismatch", http.StatusUnprocessableEntity, "transaction currency does not match account currency"},
+	{"exposure_ceiling_exceeded", http.StatusUnprocessableEntity, "exposure ceiling exceeded for this identity's KYC level"},
+	{"rate_limit_exceeded", http.StatusTooManyRequests, "transaction submission rate limit exceeded"},
+	{"account_frozen", http.StatusForbidden, "account is temporarily frozen"},
+	{"batch_not_deposit_only", http.StatusBadRequest, "batch posting only supports deposits"},
+	{"batch_tenant_mismatch", http.StatusBadRequest, "batch input belongs to a different tenant"},
+	{"step_up_challenge_not_verifiable", http.StatusNotFound, "step up challenge is not verifiable"},
+	{"step_up_challenge_attempts_exceeded", http.StatusTooManyRequests, "too many step up verification attempts"},
+	{"step_up_code_mismatch", http.StatusUnprocessableEntity, "step up code does not match"},
+	{"transfer_quote_not_found", http.StatusNotFound, "transfer quote not found"},
+	{"transfer_quote_not_pending", http.StatusConflict, "transfer quote is not pending"},
+	{"transfer_quote_expired", http.StatusUnprocessableEntity, "transfer quote has expired, request a new one"},
+	{"duplicate_email", http.StatusConflict, "email already registered"},
+	{"webauthn_challenge_not_verifiable", http.StatusUnprocessableEntity, "webauthn challenge is not verifiable"},
+	{"webauthn_signature_mismatch", http.StatusUnprocessableEntity, "webauthn signature does not verify"},
+	{"webauthn_credential_not_found", http.StatusNotFound, "webauthn credential not found"},
+	{"no_webauthn_credentials", http.StatusNotFound, "user has no registered passkeys"},
+	{"webhook_provider_not_found", http.StatusNotFound, "webhook provider not registered"},
+	{"webhook_signature_invalid", http.StatusUnauthorized, "webhook signature does not verify"},
+	{"unsupported_webhook_event", http.StatusUnprocessableEntity, "unsupported webhook event type"},
+	{"withdrawal_code_not_redeemable", http.StatusUnprocessableEntity, "withdrawal code is not redeemable"},
+}
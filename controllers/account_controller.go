@@ -0,0 +1,89 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/repository"
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// AccountController exposes tenant-scoped account management endpoints.
+type AccountController struct {
+	accounts *repository.AccountRepository
+	service  *services.AccountService
+}
+
+// NewAccountController builds an AccountController.
+func NewAccountController(accounts *repository.AccountRepository, service *services.AccountService) *AccountController {
+	return &AccountController{accounts: accounts, service: service}
+}
+
+type createAccountRequest struct {
+	UserID        string `json:"user_id" binding:"required"`
+	AccountNumber string `json:"account_number" binding:"required"`
+	Currency      string `json:"currency"`
+	ProductID     string `json:"product_id"`
+}
+
+// Create opens a new account for a user under the calling tenant. If
+// ProductID is set, the account is opened against that exact product
+// version, subject to its eligibility rules.
+func (ac *AccountController) Create(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req createAccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	currency := req.Currency
+	if currency == "" {
+		currency = tenant.DefaultCurrency
+	}
+
+	account, err := ac.service.Open(tenant.ID, req.UserID, req.AccountNumber, currency, req.ProductID)
+	if err != nil {
+		ac.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, account)
+}
+
+// Get fetches a single account belonging to the calling tenant.
+func (ac *AccountController) Get(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	account, err := ac.accounts.FindByID(tenant.ID, c.Param("id"))
+	if err != nil {
+		utils.Error(c, http.StatusNotFound, "account not found")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"id":             account.ID,
+		"tenant_id":      account.TenantID,
+		"user_id":        account.UserID,
+		"account_number": account.AccountNumber,
+		"currency":       account.Currency,
+		"balance":        account.Balance,
+		"held_amount":    account.HeldAmount,
+		"available":      account.Available(),
+		"product_id":     account.ProductID,
+		"created_at":     account.CreatedAt,
+		"updated_at":     account.UpdatedAt,
+	})
+}
+
+func (ac *AccountController) handleError(c *gin.Context, err error) {
+	if errors.Is(err, services.ErrProductNotActive) || errors.Is(err, services.ErrProductNotEligible) || errors.Is(err, services.ErrUnsupportedCurrency) {
+		utils.Error(c, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+	utils.Error(c, http.StatusInternalServerError, "failed to create account")
+}
@@ -0,0 +1,45 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// AccountProjectionController serves forward-looking balance projections.
+type AccountProjectionController struct {
+	projections *services.AccountProjectionService
+}
+
+// NewAccountProjectionController builds an AccountProjectionController.
+func NewAccountProjectionController(projections *services.AccountProjectionService) *AccountProjectionController {
+	return &AccountProjectionController{projections: projections}
+}
+
+// Get returns the account's projected balance over the ?days query
+// parameter, defaulting to 30 if omitted.
+func (pc *AccountProjectionController) Get(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	days := 30
+	if raw := c.Query("days"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed <= 0 {
+			utils.Error(c, http.StatusBadRequest, "days must be a positive integer")
+			return
+		}
+		days = parsed
+	}
+
+	projection, err := pc.projections.Project(tenant.ID, c.Param("id"), days)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to project account balance")
+		return
+	}
+
+	c.JSON(http.StatusOK, projection)
+}
@@ -0,0 +1,140 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/repository"
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// AccountRecoveryController drives phone-number account recovery: a user
+// submits evidence, an admin reviews it, and a step-up code confirms the
+// user controls the new number before the rebind takes effect.
+type AccountRecoveryController struct {
+	requests *repository.AccountRecoveryRequestRepository
+	service  *services.AccountRecoveryService
+}
+
+// NewAccountRecoveryController builds an AccountRecoveryController.
+func NewAccountRecoveryController(requests *repository.AccountRecoveryRequestRepository, service *services.AccountRecoveryService) *AccountRecoveryController {
+	return &AccountRecoveryController{requests: requests, service: service}
+}
+
+type submitAccountRecoveryRequest struct {
+	NewPhone string `json:"new_phone" binding:"required"`
+	Evidence string `json:"evidence" binding:"required"`
+}
+
+// Submit opens a recovery request for the calling user.
+func (rc *AccountRecoveryController) Submit(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	userID := c.GetHeader("X-User-ID")
+	if userID == "" {
+		utils.Error(c, http.StatusUnauthorized, "missing X-User-ID header")
+		return
+	}
+
+	var req submitAccountRecoveryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	request, err := rc.service.Submit(tenant.ID, userID, req.NewPhone, req.Evidence)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to submit recovery request")
+		return
+	}
+
+	c.JSON(http.StatusCreated, request)
+}
+
+type verifyAccountRecoveryStepUpRequest struct {
+	Code string `json:"code" binding:"required"`
+}
+
+// VerifyStepUp completes an approved recovery request, rebinding the
+// user's account to the new phone number.
+func (rc *AccountRecoveryController) VerifyStepUp(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req verifyAccountRecoveryStepUpRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := rc.service.VerifyStepUp(tenant.ID, c.Param("id"), req.Code); err != nil {
+		if errors.Is(err, services.ErrRecoveryStepUpNotVerifiable) || errors.Is(err, services.ErrRecoveryCodeMismatch) {
+			utils.Error(c, http.StatusUnprocessableEntity, err.Error())
+			return
+		}
+		utils.Error(c, http.StatusInternalServerError, "failed to verify recovery step-up")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// List returns every recovery request for the calling tenant, for the
+// admin review queue.
+func (rc *AccountRecoveryController) List(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	requests, err := rc.requests.List(tenant.ID)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to list recovery requests")
+		return
+	}
+
+	c.JSON(http.StatusOK, requests)
+}
+
+type reviewAccountRecoveryRequest struct {
+	Note string `json:"note"`
+}
+
+// Approve accepts a pending request's evidence and triggers the step-up
+// code. The reviewer is identified by the same interim X-User-ID header
+// RequireRole uses elsewhere.
+func (rc *AccountRecoveryController) Approve(c *gin.Context) {
+	rc.review(c, func(tenantID, id, reviewerUserID, note string) error {
+		return rc.service.Approve(tenantID, id, reviewerUserID, note)
+	})
+}
+
+// Deny rejects a pending request's evidence.
+func (rc *AccountRecoveryController) Deny(c *gin.Context) {
+	rc.review(c, func(tenantID, id, reviewerUserID, note string) error {
+		return rc.service.Deny(tenantID, id, reviewerUserID, note)
+	})
+}
+
+func (rc *AccountRecoveryController) review(c *gin.Context, apply func(tenantID, id, reviewerUserID, note string) error) {
+	tenant := utils.TenantFromContext(c)
+
+	reviewerUserID := c.GetHeader("X-User-ID")
+	if reviewerUserID == "" {
+		utils.Error(c, http.StatusUnauthorized, "missing X-User-ID header")
+		return
+	}
+
+	var req reviewAccountRecoveryRequest
+	_ = c.ShouldBindJSON(&req)
+
+	if err := apply(tenant.ID, c.Param("id"), reviewerUserID, req.Note); err != nil {
+		if errors.Is(err, services.ErrRecoveryRequestNotPending) {
+			utils.Error(c, http.StatusConflict, err.Error())
+			return
+		}
+		utils.Error(c, http.StatusInternalServerError, "recovery review failed")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
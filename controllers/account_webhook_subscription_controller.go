@@ -0,0 +1,76 @@
+package controllers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// AccountWebhookSubscriptionController lets an account owner register and
+// list outbound webhook subscriptions for one of their accounts.
+type AccountWebhookSubscriptionController struct {
+	webhooks *services.AccountWebhookService
+}
+
+// NewAccountWebhookSubscriptionController builds an
+// AccountWebhookSubscriptionController.
+func NewAccountWebhookSubscriptionController(webhooks *services.AccountWebhookService) *AccountWebhookSubscriptionController {
+	return &AccountWebhookSubscriptionController{webhooks: webhooks}
+}
+
+type createAccountWebhookSubscriptionRequest struct {
+	URL        string                           `json:"url" binding:"required,url"`
+	Secret     string                           `json:"secret" binding:"required"`
+	EventTypes []models.AccountWebhookEventType `json:"event_types"`
+	MinAmount  int64                            `json:"min_amount" binding:"gte=0"`
+}
+
+// Create registers a new webhook subscription for the account. An empty
+// event_types delivers every event type.
+func (wc *AccountWebhookSubscriptionController) Create(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req createAccountWebhookSubscriptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	eventTypes := make([]string, len(req.EventTypes))
+	for i, t := range req.EventTypes {
+		eventTypes[i] = string(t)
+	}
+
+	sub := &models.AccountWebhookSubscription{
+		TenantID:   tenant.ID,
+		AccountID:  c.Param("id"),
+		URL:        req.URL,
+		Secret:     req.Secret,
+		EventTypes: strings.Join(eventTypes, " "),
+		MinAmount:  req.MinAmount,
+	}
+	if err := wc.webhooks.Subscribe(sub); err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to create webhook subscription")
+		return
+	}
+
+	c.JSON(http.StatusCreated, sub)
+}
+
+// List returns every webhook subscription registered for the account.
+func (wc *AccountWebhookSubscriptionController) List(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	subs, err := wc.webhooks.ListForAccount(tenant.ID, c.Param("id"))
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to load webhook subscriptions")
+		return
+	}
+
+	c.JSON(http.StatusOK, subs)
+}
@@ -0,0 +1,61 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// AdjustmentController lets admins correct an account's balance with a
+// fully-recorded, signed ledger entry instead of a raw update.
+type AdjustmentController struct {
+	adjustments *services.AdjustmentService
+}
+
+// NewAdjustmentController builds an AdjustmentController.
+func NewAdjustmentController(adjustments *services.AdjustmentService) *AdjustmentController {
+	return &AdjustmentController{adjustments: adjustments}
+}
+
+type adjustAccountRequest struct {
+	Amount        int64  `json:"amount" binding:"required"`
+	ReasonCode    string `json:"reason_code" binding:"required"`
+	Justification string `json:"justification" binding:"required"`
+}
+
+// Adjust posts a signed manual balance adjustment against an account. A
+// positive amount credits the account, a negative amount debits it.
+func (ac *AdjustmentController) Adjust(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+	admin := utils.ActorFromContext(c)
+
+	var req adjustAccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	tx, err := ac.adjustments.Adjust(c.Request.Context(), tenant.ID, c.Param("id"), admin.ID, req.Amount, req.ReasonCode, req.Justification)
+	if err != nil {
+		ac.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, tx)
+}
+
+func (ac *AdjustmentController) handleError(c *gin.Context, err error) {
+	if errors.Is(err, services.ErrJustificationRequired) || errors.Is(err, services.ErrZeroAdjustment) {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	if errors.Is(err, services.ErrInsufficientFunds) {
+		utils.Error(c, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+	utils.Error(c, http.StatusInternalServerError, "failed to post adjustment")
+}
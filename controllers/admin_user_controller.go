@@ -0,0 +1,141 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// AdminUserController exposes tenant-admin user-management actions
+// beyond the public signup and self-service endpoints.
+type AdminUserController struct {
+	admin *services.AdminUserService
+}
+
+// NewAdminUserController builds an AdminUserController.
+func NewAdminUserController(admin *services.AdminUserService) *AdminUserController {
+	return &AdminUserController{admin: admin}
+}
+
+// List returns the tenant's users, optionally filtered by kyc_level,
+// tier, created_after, and created_before query parameters.
+func (ac *AdminUserController) List(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var filter repository.UserFilter
+	if raw := c.Query("kyc_level"); raw != "" {
+		level, err := strconv.Atoi(raw)
+		if err != nil {
+			utils.Error(c, http.StatusBadRequest, "kyc_level must be an integer")
+			return
+		}
+		filter.KYCLevel = &level
+	}
+	if tier := c.Query("tier"); tier != "" {
+		filter.Tier = models.UserTier(tier)
+	}
+	if raw := c.Query("created_after"); raw != "" {
+		t, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			utils.Error(c, http.StatusBadRequest, "created_after must be an RFC3339 timestamp")
+			return
+		}
+		filter.CreatedAfter = &t
+	}
+	if raw := c.Query("created_before"); raw != "" {
+		t, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			utils.Error(c, http.StatusBadRequest, "created_before must be an RFC3339 timestamp")
+			return
+		}
+		filter.CreatedBefore = &t
+	}
+
+	users, err := ac.admin.List(tenant.ID, filter)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to list users")
+		return
+	}
+
+	c.JSON(http.StatusOK, users)
+}
+
+// Lock locks the user out of authenticating.
+func (ac *AdminUserController) Lock(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	if err := ac.admin.Lock(tenant.ID, c.Param("id")); err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to lock user")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"locked": true})
+}
+
+// Unlock reverses Lock.
+func (ac *AdminUserController) Unlock(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	if err := ac.admin.Unlock(tenant.ID, c.Param("id")); err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to unlock user")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"locked": false})
+}
+
+type setUserTierRequest struct {
+	Tier models.UserTier `json:"tier" binding:"required"`
+}
+
+// SetTier changes the user's product-eligibility tier.
+func (ac *AdminUserController) SetTier(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req setUserTierRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := ac.admin.SetTier(tenant.ID, c.Param("id"), req.Tier); err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to update user tier")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"tier": req.Tier})
+}
+
+// ForcePINReset clears the user's PIN and freezes their accounts, so
+// they must set a new PIN before transacting again.
+func (ac *AdminUserController) ForcePINReset(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	if err := ac.admin.ForcePINReset(tenant.ID, c.Param("id")); err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to force pin reset")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"pin_reset": true})
+}
+
+// Accounts returns the user's accounts and any fraud review flags raised
+// against them.
+func (ac *AdminUserController) Accounts(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	result, err := ac.admin.AccountsAndFlags(tenant.ID, c.Param("id"))
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to load user accounts")
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
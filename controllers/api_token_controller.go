@@ -0,0 +1,78 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/repository"
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// APITokenController lets admins issue and revoke API tokens.
+type APITokenController struct {
+	tokens *repository.APITokenRepository
+	issuer *services.APITokenService
+}
+
+// NewAPITokenController builds an APITokenController.
+func NewAPITokenController(tokens *repository.APITokenRepository, issuer *services.APITokenService) *APITokenController {
+	return &APITokenController{tokens: tokens, issuer: issuer}
+}
+
+type createAPITokenRequest struct {
+	Name   string   `json:"name" binding:"required"`
+	Scopes []string `json:"scopes" binding:"required,min=1"`
+}
+
+// Create issues a new API token for the calling admin, returning the
+// plaintext token once. It is never recoverable again.
+func (tc *APITokenController) Create(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+	admin := utils.ActorFromContext(c)
+
+	var req createAPITokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	token, plaintext, err := tc.issuer.Issue(tenant.ID, admin.ID, req.Name, req.Scopes)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidScope) {
+			utils.Error(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		utils.Error(c, http.StatusInternalServerError, "failed to issue api token")
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"token": token, "plaintext": plaintext})
+}
+
+// List returns every API token issued under the calling tenant.
+func (tc *APITokenController) List(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	tokens, err := tc.tokens.List(tenant.ID)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to list api tokens")
+		return
+	}
+
+	c.JSON(http.StatusOK, tokens)
+}
+
+// Revoke disables an API token so it can no longer authenticate requests.
+func (tc *APITokenController) Revoke(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	if err := tc.issuer.Revoke(tenant.ID, c.Param("id")); err != nil {
+		utils.Error(c, http.StatusNotFound, "api token not found")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
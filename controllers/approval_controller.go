@@ -0,0 +1,95 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/repository"
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// ApprovalController exposes the maker-checker approval queue for
+// transactions that exceeded the tenant's approval threshold.
+type ApprovalController struct {
+	approvals *repository.PendingApprovalRepository
+	service   *services.ApprovalService
+}
+
+// NewApprovalController builds an ApprovalController.
+func NewApprovalController(approvals *repository.PendingApprovalRepository, service *services.ApprovalService) *ApprovalController {
+	return &ApprovalController{approvals: approvals, service: service}
+}
+
+// List returns every pending approval for the calling tenant.
+func (ac *ApprovalController) List(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	approvals, err := ac.approvals.List(tenant.ID)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to list approvals")
+		return
+	}
+
+	c.JSON(http.StatusOK, approvals)
+}
+
+// Approve posts the transaction a pending approval was holding. The
+// checker is identified by the same interim X-User-ID header RequireRole
+// uses elsewhere.
+func (ac *ApprovalController) Approve(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	checkerUserID := c.GetHeader("X-User-ID")
+	if checkerUserID == "" {
+		utils.Error(c, http.StatusUnauthorized, "missing X-User-ID header")
+		return
+	}
+
+	tx, hold, err := ac.service.Approve(c.Request.Context(), tenant.ID, c.Param("id"), checkerUserID)
+	if err != nil {
+		ac.handleError(c, err)
+		return
+	}
+	if hold != nil {
+		c.JSON(http.StatusAccepted, gin.H{
+			"error":   "transfer held for compliance review",
+			"hold_id": hold.ID,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, tx)
+}
+
+// Reject discards the transaction a pending approval was holding.
+func (ac *ApprovalController) Reject(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	checkerUserID := c.GetHeader("X-User-ID")
+	if checkerUserID == "" {
+		utils.Error(c, http.StatusUnauthorized, "missing X-User-ID header")
+		return
+	}
+
+	if err := ac.service.Reject(tenant.ID, c.Param("id"), checkerUserID); err != nil {
+		ac.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (ac *ApprovalController) handleError(c *gin.Context, err error) {
+	if errors.Is(err, services.ErrApprovalNotPending) || errors.Is(err, services.ErrSameMakerChecker) {
+		utils.Error(c, http.StatusConflict, err.Error())
+		return
+	}
+	if errors.Is(err, services.ErrInsufficientFunds) {
+		utils.Error(c, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+	utils.Error(c, http.StatusInternalServerError, "approval operation failed")
+}
@@ -0,0 +1,36 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/repository"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// AuditLogController exposes the append-only trail of privileged actions
+// (balance adjustments, limit overrides, impersonation, account
+// recovery) recorded to the audit log.
+type AuditLogController struct {
+	auditLogs *repository.AuditLogRepository
+}
+
+// NewAuditLogController builds an AuditLogController.
+func NewAuditLogController(auditLogs *repository.AuditLogRepository) *AuditLogController {
+	return &AuditLogController{auditLogs: auditLogs}
+}
+
+// List returns every audit log entry for the calling tenant, most recent
+// first.
+func (ac *AuditLogController) List(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	entries, err := ac.auditLogs.List(tenant.ID)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to list audit log")
+		return
+	}
+
+	c.JSON(http.StatusOK, entries)
+}
@@ -0,0 +1,50 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// AuthController exchanges email/PIN credentials for a JWT.
+type AuthController struct {
+	auth *services.AuthService
+}
+
+// NewAuthController builds an AuthController.
+func NewAuthController(auth *services.AuthService) *AuthController {
+	return &AuthController{auth: auth}
+}
+
+type loginRequest struct {
+	Email string `json:"email" binding:"required,email"`
+	PIN   string `json:"pin" binding:"required"`
+}
+
+// Login verifies a user's email and PIN and, on success, returns a JWT
+// to be sent back as the X-Auth-Token header on subsequent requests.
+func (ac *AuthController) Login(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req loginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	token, err := ac.auth.Login(tenant.ID, req.Email, req.PIN)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidCredentials) {
+			utils.Error(c, http.StatusUnauthorized, err.Error())
+			return
+		}
+		utils.Error(c, http.StatusInternalServerError, "failed to log in")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"token": token})
+}
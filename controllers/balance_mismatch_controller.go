@@ -0,0 +1,33 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/repository"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// BalanceMismatchController lets platform operators inspect balance drift
+// found by the reconciliation checker.
+type BalanceMismatchController struct {
+	mismatches *repository.BalanceMismatchRepository
+}
+
+// NewBalanceMismatchController builds a BalanceMismatchController.
+func NewBalanceMismatchController(mismatches *repository.BalanceMismatchRepository) *BalanceMismatchController {
+	return &BalanceMismatchController{mismatches: mismatches}
+}
+
+// List returns balance mismatches, optionally filtered by ?tenant_id and
+// ?account_id.
+func (bc *BalanceMismatchController) List(c *gin.Context) {
+	mismatches, err := bc.mismatches.List(c.Query("tenant_id"), c.Query("account_id"))
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to list balance mismatches")
+		return
+	}
+
+	c.JSON(http.StatusOK, mismatches)
+}
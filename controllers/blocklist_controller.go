@@ -0,0 +1,78 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/divzzrk/go_bank_api/ids"
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// BlocklistController lets admins manage the internal sanctions/blocklist.
+type BlocklistController struct {
+	blocklist *repository.BlocklistRepository
+}
+
+// NewBlocklistController builds a BlocklistController.
+func NewBlocklistController(blocklist *repository.BlocklistRepository) *BlocklistController {
+	return &BlocklistController{blocklist: blocklist}
+}
+
+type createBlocklistEntryRequest struct {
+	Type   models.BlocklistEntryType `json:"type" binding:"required,oneof=account phone name_pattern"`
+	Value  string                    `json:"value" binding:"required"`
+	Reason string                    `json:"reason"`
+}
+
+// Create adds a new blocklist entry.
+func (bc *BlocklistController) Create(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req createBlocklistEntryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	entry := &models.BlocklistEntry{
+		ID:       ids.New(),
+		TenantID: tenant.ID,
+		Type:     req.Type,
+		Value:    req.Value,
+		Reason:   req.Reason,
+	}
+	if err := bc.blocklist.Create(entry); err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to create blocklist entry")
+		return
+	}
+
+	c.JSON(http.StatusCreated, entry)
+}
+
+// List returns every blocklist entry for the calling tenant.
+func (bc *BlocklistController) List(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	entries, err := bc.blocklist.List(tenant.ID)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to list blocklist entries")
+		return
+	}
+
+	c.JSON(http.StatusOK, entries)
+}
+
+// Delete removes a blocklist entry.
+func (bc *BlocklistController) Delete(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	if err := bc.blocklist.Delete(tenant.ID, c.Param("id")); err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to delete blocklist entry")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,80 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/divzzrk/go_bank_api/ids"
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// BranchController exposes tenant-scoped branch management endpoints.
+type BranchController struct {
+	branches *repository.BranchRepository
+	accounts *repository.AccountRepository
+}
+
+// NewBranchController builds a BranchController.
+func NewBranchController(branches *repository.BranchRepository, accounts *repository.AccountRepository) *BranchController {
+	return &BranchController{branches: branches, accounts: accounts}
+}
+
+type createBranchRequest struct {
+	Name string `json:"name" binding:"required"`
+	Code string `json:"code" binding:"required"`
+}
+
+// Create registers a new branch under the calling tenant.
+func (bc *BranchController) Create(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req createBranchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Every branch gets its own internal cash account, used to reconcile
+	// teller cash-in/cash-out operations against physical cash on hand.
+	cashAccount := &models.Account{
+		ID:            ids.New(),
+		TenantID:      tenant.ID,
+		AccountNumber: "CASH-" + req.Code,
+		Currency:      tenant.DefaultCurrency,
+	}
+	if err := bc.accounts.Create(cashAccount); err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to create branch cash account")
+		return
+	}
+
+	branch := &models.Branch{
+		ID:            ids.New(),
+		TenantID:      tenant.ID,
+		Name:          req.Name,
+		Code:          req.Code,
+		CashAccountID: cashAccount.ID,
+	}
+
+	if err := bc.branches.Create(branch); err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to create branch")
+		return
+	}
+
+	c.JSON(http.StatusCreated, branch)
+}
+
+// List returns every branch belonging to the calling tenant.
+func (bc *BranchController) List(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	branches, err := bc.branches.List(tenant.ID)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to list branches")
+		return
+	}
+
+	c.JSON(http.StatusOK, branches)
+}
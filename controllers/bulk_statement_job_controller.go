@@ -0,0 +1,65 @@
+package controllers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// BulkStatementJobController starts and reports on bulk statement export
+// jobs, for an auditor who needs every account's activity over a period
+// bundled into one archive.
+type BulkStatementJobController struct {
+	jobs *services.BulkStatementJobService
+}
+
+// NewBulkStatementJobController builds a BulkStatementJobController.
+func NewBulkStatementJobController(jobs *services.BulkStatementJobService) *BulkStatementJobController {
+	return &BulkStatementJobController{jobs: jobs}
+}
+
+type createBulkStatementJobRequest struct {
+	From          time.Time `json:"from" binding:"required"`
+	To            time.Time `json:"to" binding:"required"`
+	AccountIDs    []string  `json:"account_ids,omitempty"`
+	WebhookURL    string    `json:"webhook_url,omitempty"`
+	WebhookSecret string    `json:"webhook_secret,omitempty"`
+}
+
+// Create starts a bulk statement export job and returns it immediately;
+// generation continues in the background.
+func (bc *BulkStatementJobController) Create(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req createBulkStatementJobRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	job, err := bc.jobs.Start(tenant.ID, req.From, req.To, req.AccountIDs, req.WebhookURL, req.WebhookSecret)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to start bulk statement job")
+		return
+	}
+
+	c.JSON(http.StatusAccepted, job)
+}
+
+// Get returns a bulk statement job's current status, for polling progress
+// until the archive is ready.
+func (bc *BulkStatementJobController) Get(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	job, err := bc.jobs.Get(tenant.ID, c.Param("id"))
+	if err != nil {
+		utils.Error(c, http.StatusNotFound, "bulk statement job not found")
+		return
+	}
+
+	c.JSON(http.StatusOK, job)
+}
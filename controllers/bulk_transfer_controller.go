@@ -0,0 +1,70 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/divzzrk/go_bank_api/ids"
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// BulkTransferController posts payroll-style bulk transfers: one debit
+// account paying many credit accounts.
+type BulkTransferController struct {
+	bulkTransfers *services.BulkTransferService
+}
+
+// NewBulkTransferController builds a BulkTransferController.
+func NewBulkTransferController(bulkTransfers *services.BulkTransferService) *BulkTransferController {
+	return &BulkTransferController{bulkTransfers: bulkTransfers}
+}
+
+type createBulkTransferRequest struct {
+	DebitAccountID string `json:"debit_account_id" binding:"required"`
+	Transfers      []struct {
+		CreditAccountID string `json:"credit_account_id" binding:"required"`
+		Amount          int64  `json:"amount" binding:"required,gt=0"`
+	} `json:"transfers" binding:"required,min=1"`
+}
+
+// Create validates and posts a bulk transfer batch, returning the batch
+// summary and every line's outcome.
+func (bc *BulkTransferController) Create(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req createBulkTransferRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	correlationID := c.GetHeader("X-Correlation-ID")
+	if correlationID == "" {
+		correlationID = ids.New()
+	}
+
+	lines := make([]services.BulkTransferLine, 0, len(req.Transfers))
+	for _, t := range req.Transfers {
+		lines = append(lines, services.BulkTransferLine{CreditAccountID: t.CreditAccountID, Amount: t.Amount})
+	}
+
+	batch, items, err := bc.bulkTransfers.Submit(c.Request.Context(), tenant.ID, req.DebitAccountID, correlationID, "", lines)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrBulkTransferEmpty):
+			utils.Error(c, http.StatusBadRequest, err.Error())
+			return
+		case errors.Is(err, services.ErrBulkTransferInsufficientFunds):
+			utils.Error(c, http.StatusUnprocessableEntity, err.Error())
+			return
+		default:
+			utils.Error(c, http.StatusInternalServerError, "failed to post bulk transfer")
+			return
+		}
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"batch": batch, "items": items})
+}
@@ -0,0 +1,54 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// CardSwitchController receives decoded ISO 8583 messages from a card
+// switch integration and always answers with HTTP 200: the ISO 8583
+// response code in the body is how success or decline is communicated,
+// the same way the switch itself would treat a non-response as a timeout
+// rather than a protocol-level error.
+type CardSwitchController struct {
+	cardSwitch *services.CardSwitchService
+}
+
+// NewCardSwitchController builds a CardSwitchController.
+func NewCardSwitchController(cardSwitch *services.CardSwitchService) *CardSwitchController {
+	return &CardSwitchController{cardSwitch: cardSwitch}
+}
+
+type cardMessageRequest struct {
+	MTI       string `json:"mti" binding:"required"`
+	RRN       string `json:"rrn" binding:"required"`
+	AccountID string `json:"account_id"`
+	Amount    int64  `json:"amount"`
+	Currency  string `json:"currency"`
+}
+
+// HandleMessage decodes and processes a single ISO 8583 authorization,
+// financial, or reversal message.
+func (cc *CardSwitchController) HandleMessage(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req cardMessageRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp := cc.cardSwitch.HandleMessage(c.Request.Context(), tenant.ID, services.CardMessage{
+		MTI:       req.MTI,
+		RRN:       req.RRN,
+		AccountID: req.AccountID,
+		Amount:    req.Amount,
+		Currency:  req.Currency,
+	})
+
+	c.JSON(http.StatusOK, resp)
+}
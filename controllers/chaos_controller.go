@@ -0,0 +1,59 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// ChaosController lets an operator arm and disarm simulated dependency
+// failures in a non-production environment, to verify retry/dead-letter
+// behavior in staging. See services.ChaosService.
+type ChaosController struct {
+	chaos *services.ChaosService
+}
+
+// NewChaosController builds a ChaosController.
+func NewChaosController(chaos *services.ChaosService) *ChaosController {
+	return &ChaosController{chaos: chaos}
+}
+
+// List returns whether fault injection is available in this environment
+// and, if so, which faults are currently armed.
+func (cc *ChaosController) List(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"enabled": cc.chaos.Enabled(), "armed": cc.chaos.Armed()})
+}
+
+type armChaosFaultRequest struct {
+	On bool `json:"on"`
+}
+
+// Arm turns the :fault path parameter on or off. It fails with 403 if
+// fault injection isn't available in this environment, and 400 for an
+// unrecognized fault.
+func (cc *ChaosController) Arm(c *gin.Context) {
+	var req armChaosFaultRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := cc.chaos.Arm(services.ChaosFault(c.Param("fault")), req.On); err != nil {
+		if errors.Is(err, services.ErrChaosDisabled) {
+			utils.Error(c, http.StatusForbidden, err.Error())
+			return
+		}
+		if errors.Is(err, services.ErrChaosUnknownFault) {
+			utils.Error(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		utils.Error(c, http.StatusInternalServerError, "failed to update fault injection")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"armed": cc.chaos.Armed()})
+}
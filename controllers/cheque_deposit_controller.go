@@ -0,0 +1,97 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/repository"
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// ChequeDepositController exposes cheque deposit and clearing endpoints.
+type ChequeDepositController struct {
+	deposits *repository.ChequeDepositRepository
+	service  *services.ChequeDepositService
+}
+
+// NewChequeDepositController builds a ChequeDepositController.
+func NewChequeDepositController(deposits *repository.ChequeDepositRepository, service *services.ChequeDepositService) *ChequeDepositController {
+	return &ChequeDepositController{deposits: deposits, service: service}
+}
+
+type recordChequeDepositRequest struct {
+	AccountID    string `json:"account_id" binding:"required"`
+	ChequeNumber string `json:"cheque_number" binding:"required"`
+	IssuingBank  string `json:"issuing_bank" binding:"required"`
+	Amount       int64  `json:"amount" binding:"required,gt=0"`
+	ImageRef     string `json:"image_ref"`
+}
+
+// Create records a new pending cheque deposit.
+func (cc *ChequeDepositController) Create(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req recordChequeDepositRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	deposit, err := cc.service.Record(tenant.ID, req.AccountID, req.ChequeNumber, req.IssuingBank, req.ImageRef, req.Amount)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to record cheque deposit")
+		return
+	}
+
+	c.JSON(http.StatusCreated, deposit)
+}
+
+// ListByAccount returns cheque deposit history for an account.
+func (cc *ChequeDepositController) ListByAccount(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	deposits, err := cc.deposits.ListByAccount(tenant.ID, c.Param("id"))
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to list cheque deposits")
+		return
+	}
+
+	c.JSON(http.StatusOK, deposits)
+}
+
+// Clear is the clearing callback that posts a pending cheque deposit.
+func (cc *ChequeDepositController) Clear(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	deposit, err := cc.service.Clear(c.Request.Context(), tenant.ID, c.Param("id"))
+	if err != nil {
+		cc.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, deposit)
+}
+
+// Bounce is the clearing callback that reverses a pending cheque deposit.
+func (cc *ChequeDepositController) Bounce(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	deposit, err := cc.service.Bounce(tenant.ID, c.Param("id"))
+	if err != nil {
+		cc.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, deposit)
+}
+
+func (cc *ChequeDepositController) handleError(c *gin.Context, err error) {
+	if errors.Is(err, services.ErrChequeNotPending) {
+		utils.Error(c, http.StatusConflict, err.Error())
+		return
+	}
+	utils.Error(c, http.StatusInternalServerError, "cheque clearing operation failed")
+}
@@ -0,0 +1,115 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// ClearingController exposes external transfer submission and the
+// platform-wide clearing file lifecycle.
+type ClearingController struct {
+	clearing *services.ClearingService
+}
+
+// NewClearingController builds a ClearingController.
+func NewClearingController(clearing *services.ClearingService) *ClearingController {
+	return &ClearingController{clearing: clearing}
+}
+
+type createExternalTransferRequest struct {
+	AccountID                 string `json:"account_id" binding:"required"`
+	Amount                    int64  `json:"amount" binding:"required,gt=0"`
+	Currency                  string `json:"currency" binding:"required"`
+	CounterpartyName          string `json:"counterparty_name" binding:"required"`
+	CounterpartyRoutingNumber string `json:"counterparty_routing_number" binding:"required"`
+	CounterpartyAccountNumber string `json:"counterparty_account_number" binding:"required"`
+}
+
+// Create submits an external transfer, debiting the account immediately.
+func (cc *ClearingController) Create(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req createExternalTransferRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	transfer, err := cc.clearing.Submit(c.Request.Context(), tenant.ID, req.AccountID, req.Amount, req.Currency, req.CounterpartyName, req.CounterpartyRoutingNumber, req.CounterpartyAccountNumber)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to submit external transfer")
+		return
+	}
+
+	c.JSON(http.StatusCreated, transfer)
+}
+
+type generateClearingFileRequest struct {
+	Format models.ClearingFileFormat `json:"format" binding:"required"`
+}
+
+// GenerateFile batches every pending external transfer into a new
+// clearing file.
+func (cc *ClearingController) GenerateFile(c *gin.Context) {
+	var req generateClearingFileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	file, err := cc.clearing.GenerateDailyFile(req.Format)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to generate clearing file")
+		return
+	}
+	if file == nil {
+		utils.Error(c, http.StatusNoContent, "no pending external transfers")
+		return
+	}
+
+	c.JSON(http.StatusCreated, file)
+}
+
+type acknowledgeClearingFileRequest struct {
+	Acks []struct {
+		ExternalTransferID string `json:"external_transfer_id" binding:"required"`
+		Outcome            string `json:"outcome" binding:"required,oneof=settled rejected"`
+		RejectReason       string `json:"reject_reason"`
+	} `json:"acks" binding:"required,min=1"`
+}
+
+// Acknowledge ingests a clearing partner's acknowledgment file, settling
+// or reversing each transfer it covers.
+func (cc *ClearingController) Acknowledge(c *gin.Context) {
+	var req acknowledgeClearingFileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	acks := make([]services.Ack, 0, len(req.Acks))
+	for _, a := range req.Acks {
+		outcome := services.AckSettled
+		if a.Outcome == string(services.AckRejected) {
+			outcome = services.AckRejected
+		}
+		acks = append(acks, services.Ack{ExternalTransferID: a.ExternalTransferID, Outcome: outcome, RejectReason: a.RejectReason})
+	}
+
+	if err := cc.clearing.IngestAcknowledgment(c.Request.Context(), acks); err != nil {
+		if errors.Is(err, services.ErrExternalTransferNotFiled) {
+			utils.Error(c, http.StatusConflict, err.Error())
+			return
+		}
+		utils.Error(c, http.StatusInternalServerError, "failed to ingest clearing acknowledgment")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "ok"})
+}
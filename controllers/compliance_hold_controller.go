@@ -0,0 +1,70 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/repository"
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// ComplianceHoldController lets admins list and resolve compliance holds
+// opened by the sanctions/blocklist screening.
+type ComplianceHoldController struct {
+	holds      *repository.ComplianceHoldRepository
+	compliance *services.ComplianceService
+}
+
+// NewComplianceHoldController builds a ComplianceHoldController.
+func NewComplianceHoldController(holds *repository.ComplianceHoldRepository, compliance *services.ComplianceService) *ComplianceHoldController {
+	return &ComplianceHoldController{holds: holds, compliance: compliance}
+}
+
+// List returns every compliance hold for the calling tenant.
+func (hc *ComplianceHoldController) List(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	holds, err := hc.holds.List(tenant.ID)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to list compliance holds")
+		return
+	}
+
+	c.JSON(http.StatusOK, holds)
+}
+
+// Release posts the transfer a pending hold was blocking.
+func (hc *ComplianceHoldController) Release(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	tx, err := hc.compliance.Release(c.Request.Context(), tenant.ID, c.Param("id"))
+	if err != nil {
+		hc.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, tx)
+}
+
+// Deny discards the transfer a pending hold was blocking.
+func (hc *ComplianceHoldController) Deny(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	if err := hc.compliance.Deny(tenant.ID, c.Param("id")); err != nil {
+		hc.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (hc *ComplianceHoldController) handleError(c *gin.Context, err error) {
+	if errors.Is(err, services.ErrHoldNotPending) {
+		utils.Error(c, http.StatusConflict, err.Error())
+		return
+	}
+	utils.Error(c, http.StatusInternalServerError, "compliance hold operation failed")
+}
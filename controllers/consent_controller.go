@@ -0,0 +1,62 @@
+package controllers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// ConsentController lets a customer grant and revoke Open Banking
+// consents on their own accounts.
+type ConsentController struct {
+	consents *services.ConsentService
+}
+
+// NewConsentController builds a ConsentController.
+func NewConsentController(consents *services.ConsentService) *ConsentController {
+	return &ConsentController{consents: consents}
+}
+
+type grantConsentRequest struct {
+	ThirdPartyName string   `json:"third_party_name" binding:"required"`
+	AccountIDs     []string `json:"account_ids" binding:"required,min=1"`
+	TTLMinutes     int      `json:"ttl_minutes" binding:"required,gt=0"`
+}
+
+// Grant records a new consent and mints the token the third party will
+// authenticate with. The plaintext token is only ever returned here.
+func (cc *ConsentController) Grant(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+	userID := c.GetHeader("X-User-ID")
+
+	var req grantConsentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	consent, token, err := cc.consents.Grant(tenant.ID, userID, req.ThirdPartyName, req.AccountIDs, time.Duration(req.TTLMinutes)*time.Minute)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to grant consent")
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"consent": consent, "token": token})
+}
+
+// Revoke disables a consent and its underlying token.
+func (cc *ConsentController) Revoke(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	consent, err := cc.consents.Revoke(tenant.ID, c.Param("id"))
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to revoke consent")
+		return
+	}
+
+	c.JSON(http.StatusOK, consent)
+}
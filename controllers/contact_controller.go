@@ -0,0 +1,44 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// ContactController exposes privacy-preserving contact-sync matching.
+type ContactController struct {
+	contacts *services.ContactService
+}
+
+// NewContactController builds a ContactController.
+func NewContactController(contacts *services.ContactService) *ContactController {
+	return &ContactController{contacts: contacts}
+}
+
+type matchContactsRequest struct {
+	PhoneHashes []string `json:"phone_hashes" binding:"required"`
+}
+
+// Match returns which of the submitted hashed phone numbers correspond to
+// registered users under the calling tenant.
+func (cc *ContactController) Match(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req matchContactsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	matches, err := cc.contacts.Match(tenant.ID, req.PhoneHashes)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to match contacts")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"matches": matches})
+}
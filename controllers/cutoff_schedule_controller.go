@@ -0,0 +1,60 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// CutoffScheduleController lets admins view and configure the daily
+// cut-off for a transaction type.
+type CutoffScheduleController struct {
+	cutoffs *services.CutoffService
+}
+
+// NewCutoffScheduleController builds a CutoffScheduleController.
+func NewCutoffScheduleController(cutoffs *services.CutoffService) *CutoffScheduleController {
+	return &CutoffScheduleController{cutoffs: cutoffs}
+}
+
+// Get returns the cut-off configured for a transaction type, or a
+// zero-value, cut-off-free schedule if none has been set.
+func (cc *CutoffScheduleController) Get(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	schedule, err := cc.cutoffs.Get(tenant.ID, models.TransactionType(c.Param("type")))
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to load cut-off schedule")
+		return
+	}
+
+	c.JSON(http.StatusOK, schedule)
+}
+
+type setCutoffScheduleRequest struct {
+	CutoffMinute int `json:"cutoff_minute" binding:"required"`
+}
+
+// Put creates or replaces the cut-off configured for a transaction type,
+// effective on its very next submission.
+func (cc *CutoffScheduleController) Put(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req setCutoffScheduleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	schedule, err := cc.cutoffs.Set(tenant.ID, models.TransactionType(c.Param("type")), req.CutoffMinute)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to update cut-off schedule")
+		return
+	}
+
+	c.JSON(http.StatusOK, schedule)
+}
@@ -0,0 +1,58 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// DailySummaryController lets a user view and set their opt-in to the
+// end-of-day spending summary notification.
+type DailySummaryController struct {
+	summaries *services.DailySummaryService
+}
+
+// NewDailySummaryController builds a DailySummaryController.
+func NewDailySummaryController(summaries *services.DailySummaryService) *DailySummaryController {
+	return &DailySummaryController{summaries: summaries}
+}
+
+// Get returns the user's daily summary subscription, or a disabled
+// zero-value subscription if they've never opted in.
+func (dc *DailySummaryController) Get(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	sub, err := dc.summaries.GetSubscription(tenant.ID, c.Param("id"))
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to load daily summary subscription")
+		return
+	}
+
+	c.JSON(http.StatusOK, sub)
+}
+
+type setDailySummarySubscriptionRequest struct {
+	Enabled bool `json:"enabled"`
+}
+
+// Put sets the user's opt-in to the daily summary notification.
+func (dc *DailySummaryController) Put(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req setDailySummarySubscriptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	sub, err := dc.summaries.SetSubscription(tenant.ID, c.Param("id"), req.Enabled)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to update daily summary subscription")
+		return
+	}
+
+	c.JSON(http.StatusOK, sub)
+}
@@ -0,0 +1,33 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/repository"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// DeadLetterController lets platform operators inspect events that failed
+// to deliver to a downstream consumer after every retry.
+type DeadLetterController struct {
+	deadLetters *repository.DeadLetterRepository
+}
+
+// NewDeadLetterController builds a DeadLetterController.
+func NewDeadLetterController(deadLetters *repository.DeadLetterRepository) *DeadLetterController {
+	return &DeadLetterController{deadLetters: deadLetters}
+}
+
+// List returns dead letters, optionally filtered by ?error_type and
+// ?account_id.
+func (dc *DeadLetterController) List(c *gin.Context) {
+	deadLetters, err := dc.deadLetters.List(c.Query("error_type"), c.Query("account_id"))
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to list dead letters")
+		return
+	}
+
+	c.JSON(http.StatusOK, deadLetters)
+}
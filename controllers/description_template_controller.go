@@ -0,0 +1,60 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// DescriptionTemplateController lets admins view and configure the
+// per-locale template a system-generated transaction's description
+// renders from.
+type DescriptionTemplateController struct {
+	templates *services.DescriptionTemplateService
+}
+
+// NewDescriptionTemplateController builds a DescriptionTemplateController.
+func NewDescriptionTemplateController(templates *services.DescriptionTemplateService) *DescriptionTemplateController {
+	return &DescriptionTemplateController{templates: templates}
+}
+
+// Get returns the template configured for a reason code and locale, or
+// this codebase's built-in default if the tenant hasn't configured one.
+func (dc *DescriptionTemplateController) Get(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	body, err := dc.templates.Get(tenant.ID, c.Param("reason_code"), c.Param("locale"))
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to load description template")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"reason_code": c.Param("reason_code"), "locale": c.Param("locale"), "template": body})
+}
+
+type setDescriptionTemplateRequest struct {
+	Template string `json:"template" binding:"required"`
+}
+
+// Put creates or replaces the template configured for a reason code and
+// locale, effective on its very next render.
+func (dc *DescriptionTemplateController) Put(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req setDescriptionTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	template, err := dc.templates.Set(tenant.ID, c.Param("reason_code"), c.Param("locale"), req.Template)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to update description template")
+		return
+	}
+
+	c.JSON(http.StatusOK, template)
+}
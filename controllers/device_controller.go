@@ -0,0 +1,115 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// DeviceController lets customers list and revoke their trusted devices
+// and complete a step-up OTP challenge for a new device.
+type DeviceController struct {
+	devices *services.DeviceService
+}
+
+// NewDeviceController builds a DeviceController.
+func NewDeviceController(devices *services.DeviceService) *DeviceController {
+	return &DeviceController{devices: devices}
+}
+
+// List returns the trusted devices for the calling user.
+func (dc *DeviceController) List(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	userID := c.GetHeader("X-User-ID")
+	if userID == "" {
+		utils.Error(c, http.StatusUnauthorized, "missing X-User-ID header")
+		return
+	}
+
+	devices, err := dc.devices.ListTrusted(tenant.ID, userID)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to list trusted devices")
+		return
+	}
+
+	c.JSON(http.StatusOK, devices)
+}
+
+// Revoke removes a device from the calling user's trusted-devices list.
+func (dc *DeviceController) Revoke(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	userID := c.GetHeader("X-User-ID")
+	if userID == "" {
+		utils.Error(c, http.StatusUnauthorized, "missing X-User-ID header")
+		return
+	}
+
+	if err := dc.devices.Revoke(tenant.ID, userID, c.Param("id")); err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to revoke device")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+type registerPushTokenRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+// RegisterPushToken records the calling user's push-notification token
+// for one of their trusted devices.
+func (dc *DeviceController) RegisterPushToken(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	userID := c.GetHeader("X-User-ID")
+	if userID == "" {
+		utils.Error(c, http.StatusUnauthorized, "missing X-User-ID header")
+		return
+	}
+
+	var req registerPushTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := dc.devices.SetPushToken(tenant.ID, userID, c.Param("id"), req.Token); err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to register push token")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+type verifyDeviceChallengeRequest struct {
+	Code string `json:"code" binding:"required"`
+}
+
+// VerifyChallenge completes a pending step-up challenge, trusting the
+// device it was issued for.
+func (dc *DeviceController) VerifyChallenge(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req verifyDeviceChallengeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := dc.devices.Verify(tenant.ID, c.Param("id"), req.Code); err != nil {
+		if errors.Is(err, services.ErrChallengeNotVerifiable) || errors.Is(err, services.ErrChallengeCodeMismatch) {
+			utils.Error(c, http.StatusUnprocessableEntity, err.Error())
+			return
+		}
+		utils.Error(c, http.StatusInternalServerError, "failed to verify device challenge")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,56 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// DeviceKeyController lets customers register a device's public key for
+// signing high-value transactions.
+type DeviceKeyController struct {
+	deviceKeys *services.DeviceKeyService
+}
+
+// NewDeviceKeyController builds a DeviceKeyController.
+func NewDeviceKeyController(deviceKeys *services.DeviceKeyService) *DeviceKeyController {
+	return &DeviceKeyController{deviceKeys: deviceKeys}
+}
+
+type registerDeviceKeyRequest struct {
+	DeviceID  string `json:"device_id" binding:"required"`
+	PublicKey string `json:"public_key" binding:"required"`
+}
+
+// Register validates and stores a device's Ed25519 public key.
+func (dc *DeviceKeyController) Register(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	userID := c.GetHeader("X-User-ID")
+	if userID == "" {
+		utils.Error(c, http.StatusUnauthorized, "missing X-User-ID header")
+		return
+	}
+
+	var req registerDeviceKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	key, err := dc.deviceKeys.Register(tenant.ID, userID, req.DeviceID, req.PublicKey)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidDeviceKey) {
+			utils.Error(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		utils.Error(c, http.StatusInternalServerError, "failed to register device key")
+		return
+	}
+
+	c.JSON(http.StatusCreated, key)
+}
@@ -0,0 +1,23 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/catalog"
+)
+
+// ErrorCatalogController serves the public, unauthenticated catalog of
+// error codes this API can return, for SDK generators and client teams.
+type ErrorCatalogController struct{}
+
+// NewErrorCatalogController builds an ErrorCatalogController.
+func NewErrorCatalogController() *ErrorCatalogController {
+	return &ErrorCatalogController{}
+}
+
+// List returns every entry in catalog.Errors.
+func (ec *ErrorCatalogController) List(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"errors": catalog.Errors})
+}
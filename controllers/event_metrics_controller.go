@@ -0,0 +1,41 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// EventMetricsController exposes TransactionService's event-publish
+// throughput and outcome counters, the current queue pause state, and
+// hold expiry aging, for platform operators.
+type EventMetricsController struct {
+	metrics   *services.EventMetrics
+	pauses    *services.QueuePauseService
+	holdAging *services.HoldAgingMetrics
+}
+
+// NewEventMetricsController builds an EventMetricsController.
+func NewEventMetricsController(metrics *services.EventMetrics, pauses *services.QueuePauseService, holdAging *services.HoldAgingMetrics) *EventMetricsController {
+	return &EventMetricsController{metrics: metrics, pauses: pauses, holdAging: holdAging}
+}
+
+// Get returns a point-in-time snapshot of every event-publish counter,
+// which queues (if any) are currently paused, and how card authorization
+// holds have been aging at expiry.
+func (mc *EventMetricsController) Get(c *gin.Context) {
+	pauses, err := mc.pauses.List()
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to load queue pause state")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"events":       mc.metrics.Snapshot(),
+		"queue_pauses": pauses,
+		"hold_aging":   mc.holdAging.Snapshot(),
+	})
+}
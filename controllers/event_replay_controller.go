@@ -0,0 +1,58 @@
+package controllers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/repository"
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// EventReplayController re-emits historical transaction events, for
+// integrators who lost events during their own outage.
+type EventReplayController struct {
+	replay *services.EventReplayService
+}
+
+// NewEventReplayController builds an EventReplayController.
+func NewEventReplayController(replay *services.EventReplayService) *EventReplayController {
+	return &EventReplayController{replay: replay}
+}
+
+// Replay re-emits every transaction event matching the optional
+// tenant_id, account_id, type, from and to query filters and reports how
+// many were replayed.
+func (rc *EventReplayController) Replay(c *gin.Context) {
+	filter := repository.TransactionLogFilter{
+		TenantID:  c.Query("tenant_id"),
+		AccountID: c.Query("account_id"),
+		Type:      c.Query("type"),
+	}
+	if from := c.Query("from"); from != "" {
+		t, err := time.Parse(time.RFC3339, from)
+		if err != nil {
+			utils.Error(c, http.StatusBadRequest, "from must be an RFC3339 timestamp")
+			return
+		}
+		filter.From = &t
+	}
+	if to := c.Query("to"); to != "" {
+		t, err := time.Parse(time.RFC3339, to)
+		if err != nil {
+			utils.Error(c, http.StatusBadRequest, "to must be an RFC3339 timestamp")
+			return
+		}
+		filter.To = &t
+	}
+
+	replayed, err := rc.replay.Replay(c.Request.Context(), filter)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to replay events")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"replayed": replayed})
+}
@@ -0,0 +1,73 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// ExposureLimitController lets admins view and configure the aggregate
+// exposure ceiling enforced on a KYC level.
+type ExposureLimitController struct {
+	exposure *services.ExposureService
+}
+
+// NewExposureLimitController builds an ExposureLimitController.
+func NewExposureLimitController(exposure *services.ExposureService) *ExposureLimitController {
+	return &ExposureLimitController{exposure: exposure}
+}
+
+// Get returns the exposure ceiling configured for a KYC level, or a
+// zero-value, unlimited ceiling if none has been set.
+func (ec *ExposureLimitController) Get(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	kycLevel, err := strconv.Atoi(c.Param("kycLevel"))
+	if err != nil {
+		utils.Error(c, http.StatusBadRequest, "invalid kyc level")
+		return
+	}
+
+	limit, err := ec.exposure.Get(tenant.ID, kycLevel)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to load exposure limit")
+		return
+	}
+
+	c.JSON(http.StatusOK, limit)
+}
+
+type setExposureLimitRequest struct {
+	Ceiling int64 `json:"ceiling"`
+}
+
+// Put creates or replaces the exposure ceiling configured for a KYC
+// level, effective on its very next deposit or inbound transfer. A
+// ceiling of zero disables the check for that level.
+func (ec *ExposureLimitController) Put(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	kycLevel, err := strconv.Atoi(c.Param("kycLevel"))
+	if err != nil {
+		utils.Error(c, http.StatusBadRequest, "invalid kyc level")
+		return
+	}
+
+	var req setExposureLimitRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	limit, err := ec.exposure.Set(tenant.ID, kycLevel, req.Ceiling)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to update exposure limit")
+		return
+	}
+
+	c.JSON(http.StatusOK, limit)
+}
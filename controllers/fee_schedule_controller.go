@@ -0,0 +1,61 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// FeeScheduleController lets admins view and configure the fee charged
+// on a transaction type.
+type FeeScheduleController struct {
+	fees *services.FeeService
+}
+
+// NewFeeScheduleController builds a FeeScheduleController.
+func NewFeeScheduleController(fees *services.FeeService) *FeeScheduleController {
+	return &FeeScheduleController{fees: fees}
+}
+
+// Get returns the fee schedule configured for a transaction type, or a
+// zero-value, fee-free schedule if none has been set.
+func (fc *FeeScheduleController) Get(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	schedule, err := fc.fees.Get(tenant.ID, models.TransactionType(c.Param("type")))
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to load fee schedule")
+		return
+	}
+
+	c.JSON(http.StatusOK, schedule)
+}
+
+type setFeeScheduleRequest struct {
+	FlatFee     int64 `json:"flat_fee"`
+	BasisPoints int   `json:"basis_points"`
+}
+
+// Put creates or replaces the fee schedule configured for a transaction
+// type, effective on its very next preview or post.
+func (fc *FeeScheduleController) Put(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req setFeeScheduleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	schedule, err := fc.fees.Set(tenant.ID, models.TransactionType(c.Param("type")), req.FlatFee, req.BasisPoints)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to update fee schedule")
+		return
+	}
+
+	c.JSON(http.StatusOK, schedule)
+}
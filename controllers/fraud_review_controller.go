@@ -0,0 +1,63 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// FraudReviewController exposes the admin queue of transactions flagged by
+// the fraud rules engine for manual review.
+type FraudReviewController struct {
+	reviews *repository.FraudReviewRepository
+}
+
+// NewFraudReviewController builds a FraudReviewController.
+func NewFraudReviewController(reviews *repository.FraudReviewRepository) *FraudReviewController {
+	return &FraudReviewController{reviews: reviews}
+}
+
+// List returns every fraud review item for the calling tenant.
+func (fc *FraudReviewController) List(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	reviews, err := fc.reviews.List(tenant.ID)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to list fraud reviews")
+		return
+	}
+
+	c.JSON(http.StatusOK, reviews)
+}
+
+type resolveFraudReviewRequest struct {
+	Status models.FraudReviewStatus `json:"status" binding:"required,oneof=approved rejected"`
+}
+
+// Resolve marks a fraud review item approved or rejected. The underlying
+// transaction has already posted; this only clears it from the queue.
+func (fc *FraudReviewController) Resolve(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req resolveFraudReviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if _, err := fc.reviews.FindByID(tenant.ID, c.Param("id")); err != nil {
+		utils.Error(c, http.StatusNotFound, "fraud review not found")
+		return
+	}
+
+	if err := fc.reviews.UpdateStatus(tenant.ID, c.Param("id"), req.Status); err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to update fraud review")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
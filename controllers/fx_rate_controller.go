@@ -0,0 +1,63 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// FXRateController lets admins view and configure the conversion rate
+// used to quote a currency pair.
+type FXRateController struct {
+	rates *services.FXRateService
+}
+
+// NewFXRateController builds an FXRateController.
+func NewFXRateController(rates *services.FXRateService) *FXRateController {
+	return &FXRateController{rates: rates}
+}
+
+// Get returns the rate configured for a currency pair.
+func (fc *FXRateController) Get(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	rate, err := fc.rates.Get(tenant.ID, c.Param("base"), c.Param("quote"))
+	if err != nil {
+		if errors.Is(err, services.ErrFXRateNotConfigured) {
+			utils.Error(c, http.StatusNotFound, err.Error())
+			return
+		}
+		utils.Error(c, http.StatusInternalServerError, "failed to load fx rate")
+		return
+	}
+
+	c.JSON(http.StatusOK, rate)
+}
+
+type setFXRateRequest struct {
+	Rate float64 `json:"rate" binding:"required,gt=0"`
+}
+
+// Put creates or replaces the rate configured for a currency pair,
+// effective on its very next quote.
+func (fc *FXRateController) Put(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req setFXRateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	rate, err := fc.rates.Set(tenant.ID, c.Param("base"), c.Param("quote"), req.Rate)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to update fx rate")
+		return
+	}
+
+	c.JSON(http.StatusOK, rate)
+}
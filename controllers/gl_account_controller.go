@@ -0,0 +1,34 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// GLAccountController exposes the tenant's internal GL accounts (chart of
+// accounts) to admins.
+type GLAccountController struct {
+	gl *services.GLService
+}
+
+// NewGLAccountController builds a GLAccountController.
+func NewGLAccountController(gl *services.GLService) *GLAccountController {
+	return &GLAccountController{gl: gl}
+}
+
+// List returns the tenant's GL accounts and their current balances.
+func (gc *GLAccountController) List(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	accounts, err := gc.gl.ChartOfAccounts(tenant.ID)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to load chart of accounts")
+		return
+	}
+
+	c.JSON(http.StatusOK, accounts)
+}
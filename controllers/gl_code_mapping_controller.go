@@ -0,0 +1,59 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// GLCodeMappingController lets admins configure the external ERP account
+// code a GL account type exports under in the journal export.
+type GLCodeMappingController struct {
+	codes *services.GLCodeMappingService
+}
+
+// NewGLCodeMappingController builds a GLCodeMappingController.
+func NewGLCodeMappingController(codes *services.GLCodeMappingService) *GLCodeMappingController {
+	return &GLCodeMappingController{codes: codes}
+}
+
+// Get returns the external code mapped for a GL account type, or this
+// codebase's own type string if the tenant hasn't configured one.
+func (gc *GLCodeMappingController) Get(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	mapping, err := gc.codes.Get(tenant.ID, models.GLAccountType(c.Param("type")))
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to load gl code mapping")
+		return
+	}
+
+	c.JSON(http.StatusOK, mapping)
+}
+
+type setGLCodeMappingRequest struct {
+	ExternalCode string `json:"external_code" binding:"required"`
+}
+
+// Put creates or replaces the external code mapped for a GL account type.
+func (gc *GLCodeMappingController) Put(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req setGLCodeMappingRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	mapping, err := gc.codes.Set(tenant.ID, models.GLAccountType(c.Param("type")), req.ExternalCode)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to update gl code mapping")
+		return
+	}
+
+	c.JSON(http.StatusOK, mapping)
+}
@@ -0,0 +1,87 @@
+package controllers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/ids"
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// HolidayController lets admins manage the tenant's business-day calendar.
+type HolidayController struct {
+	holidays *repository.HolidayRepository
+}
+
+// NewHolidayController builds a HolidayController.
+func NewHolidayController(holidays *repository.HolidayRepository) *HolidayController {
+	return &HolidayController{holidays: holidays}
+}
+
+type createHolidayRequest struct {
+	Date string `json:"date" binding:"required"`
+	// Region, if set, scopes this holiday to a region within the tenant
+	// instead of the whole tenant; see models.Holiday.
+	Region      string `json:"region"`
+	Description string `json:"description"`
+}
+
+// Create adds a non-business date to the calling tenant's calendar.
+func (hc *HolidayController) Create(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req createHolidayRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	date, err := time.Parse("2006-01-02", req.Date)
+	if err != nil {
+		utils.Error(c, http.StatusBadRequest, "date must be in YYYY-MM-DD format")
+		return
+	}
+
+	holiday := &models.Holiday{
+		ID:          ids.New(),
+		TenantID:    tenant.ID,
+		Date:        date,
+		Region:      req.Region,
+		Description: req.Description,
+	}
+	if err := hc.holidays.Create(holiday); err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to create holiday")
+		return
+	}
+
+	c.JSON(http.StatusCreated, holiday)
+}
+
+// List returns every holiday on the calling tenant's calendar.
+func (hc *HolidayController) List(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	holidays, err := hc.holidays.List(tenant.ID)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to list holidays")
+		return
+	}
+
+	c.JSON(http.StatusOK, holidays)
+}
+
+// Delete removes a holiday from the calling tenant's calendar.
+func (hc *HolidayController) Delete(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	if err := hc.holidays.Delete(tenant.ID, c.Param("id")); err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to delete holiday")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,64 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// ImpersonationController lets support admins open and close time-boxed
+// impersonation sessions scoped to a single customer.
+type ImpersonationController struct {
+	impersonation *services.ImpersonationService
+}
+
+// NewImpersonationController builds an ImpersonationController.
+func NewImpersonationController(impersonation *services.ImpersonationService) *ImpersonationController {
+	return &ImpersonationController{impersonation: impersonation}
+}
+
+type startImpersonationRequest struct {
+	CustomerUserID string `json:"customer_user_id" binding:"required"`
+}
+
+// Start opens a new impersonation session for the calling admin, scoped to
+// the requested customer.
+func (ic *ImpersonationController) Start(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+	admin := utils.ActorFromContext(c)
+
+	var req startImpersonationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	session, err := ic.impersonation.Start(tenant.ID, admin.ID, req.CustomerUserID)
+	if err != nil {
+		if errors.Is(err, services.ErrImpersonationTargetInvalid) {
+			utils.Error(c, http.StatusUnprocessableEntity, err.Error())
+			return
+		}
+		utils.Error(c, http.StatusNotFound, "customer not found")
+		return
+	}
+
+	c.JSON(http.StatusCreated, session)
+}
+
+// End closes the calling admin's impersonation session early.
+func (ic *ImpersonationController) End(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+	admin := utils.ActorFromContext(c)
+
+	if err := ic.impersonation.End(tenant.ID, c.Param("id"), admin.ID); err != nil {
+		utils.Error(c, http.StatusNotFound, "impersonation session not found")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
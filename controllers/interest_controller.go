@@ -0,0 +1,66 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/repository"
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// InterestController lets admins trigger interest accrual for an account.
+type InterestController struct {
+	interest   *services.InterestService
+	breakdowns *repository.InterestAccrualBreakdownRepository
+}
+
+// NewInterestController builds an InterestController.
+func NewInterestController(interest *services.InterestService, breakdowns *repository.InterestAccrualBreakdownRepository) *InterestController {
+	return &InterestController{interest: interest, breakdowns: breakdowns}
+}
+
+// Accrue posts one compounding period's interest against an account, per
+// the terms of the Product it's enrolled in.
+func (ic *InterestController) Accrue(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	tx, err := ic.interest.Accrue(c.Request.Context(), tenant.ID, c.Param("id"))
+	if err != nil {
+		if errors.Is(err, services.ErrNoProduct) {
+			utils.Error(c, http.StatusUnprocessableEntity, err.Error())
+			return
+		}
+		utils.Error(c, http.StatusInternalServerError, "failed to accrue interest")
+		return
+	}
+	if tx == nil {
+		c.JSON(http.StatusOK, gin.H{"message": "no interest accrued this period"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, tx)
+}
+
+// Breakdown returns the recorded calculation behind the interest accrual
+// that posted the transaction identified by :transactionId, for a
+// disputed accrual to be explained without recomputing it against terms
+// that may have since changed.
+func (ic *InterestController) Breakdown(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	breakdown, err := ic.breakdowns.FindByTransaction(tenant.ID, c.Param("transactionId"))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		utils.Error(c, http.StatusNotFound, "no interest accrual breakdown recorded for that transaction")
+		return
+	}
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to load interest accrual breakdown")
+		return
+	}
+
+	c.JSON(http.StatusOK, breakdown)
+}
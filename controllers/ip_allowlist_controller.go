@@ -0,0 +1,80 @@
+package controllers
+
+import (
+	"net"
+	"net/http"
+
+	"github.com/divzzrk/go_bank_api/ids"
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// IPAllowlistController lets admins manage the CIDR ranges allowed to call
+// the API with their tenant's API key.
+type IPAllowlistController struct {
+	allowlist *repository.IPAllowlistRepository
+}
+
+// NewIPAllowlistController builds an IPAllowlistController.
+func NewIPAllowlistController(allowlist *repository.IPAllowlistRepository) *IPAllowlistController {
+	return &IPAllowlistController{allowlist: allowlist}
+}
+
+type createIPAllowlistEntryRequest struct {
+	CIDR string `json:"cidr" binding:"required"`
+}
+
+// Create registers a new allowed CIDR range for the calling tenant.
+func (ic *IPAllowlistController) Create(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req createIPAllowlistEntryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	if _, _, err := net.ParseCIDR(req.CIDR); err != nil {
+		utils.Error(c, http.StatusBadRequest, "cidr must be a valid CIDR range")
+		return
+	}
+
+	entry := &models.IPAllowlistEntry{
+		ID:       ids.New(),
+		TenantID: tenant.ID,
+		CIDR:     req.CIDR,
+	}
+	if err := ic.allowlist.Create(entry); err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to create ip allowlist entry")
+		return
+	}
+
+	c.JSON(http.StatusCreated, entry)
+}
+
+// List returns every CIDR range registered under the calling tenant.
+func (ic *IPAllowlistController) List(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	entries, err := ic.allowlist.ListByTenant(tenant.ID)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to list ip allowlist entries")
+		return
+	}
+
+	c.JSON(http.StatusOK, entries)
+}
+
+// Delete removes a CIDR range from the calling tenant's allowlist.
+func (ic *IPAllowlistController) Delete(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	if err := ic.allowlist.Delete(tenant.ID, c.Param("id")); err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to delete ip allowlist entry")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,80 @@
+package controllers
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// JournalExportController exports the tenant's GL activity for import
+// into an external ERP's general ledger.
+type JournalExportController struct {
+	journal *services.JournalExportService
+}
+
+// NewJournalExportController builds a JournalExportController.
+func NewJournalExportController(journal *services.JournalExportService) *JournalExportController {
+	return &JournalExportController{journal: journal}
+}
+
+// Export streams the journal for the ?date query parameter (YYYY-MM-DD)
+// as a downloadable CSV, one row per GL entry, for a daily batch import.
+// A caller that wants a streaming pull instead of a daily file can poll
+// this same endpoint against a narrower date, since there's no separate
+// live feed this codebase could tail instead.
+func (jc *JournalExportController) Export(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	raw := c.Query("date")
+	if raw == "" {
+		utils.Error(c, http.StatusBadRequest, "date query parameter is required (YYYY-MM-DD)")
+		return
+	}
+	date, err := time.Parse("2006-01-02", raw)
+	if err != nil {
+		utils.Error(c, http.StatusBadRequest, "date must be in YYYY-MM-DD format")
+		return
+	}
+
+	lines, err := jc.journal.Generate(tenant.ID, date)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to build journal export")
+		return
+	}
+
+	c.Header("Content-Type", "text/csv")
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=journal-%s.csv", date.Format("2006-01-02")))
+	c.Status(http.StatusOK)
+
+	w := csv.NewWriter(c.Writer)
+
+	header := []string{"date", "external_code", "gl_account_type", "currency", "direction", "amount", "balance_after", "transaction_id", "reason_code"}
+	if err := w.Write(header); err != nil {
+		return
+	}
+
+	for _, line := range lines {
+		row := []string{
+			line.Date.Format(time.RFC3339),
+			line.ExternalCode,
+			string(line.GLAccountType),
+			line.Currency,
+			string(line.Direction),
+			fmt.Sprintf("%d", line.Amount),
+			fmt.Sprintf("%d", line.BalanceAfter),
+			line.TransactionID,
+			line.ReasonCode,
+		}
+		if err := w.Write(row); err != nil {
+			return
+		}
+	}
+
+	w.Flush()
+}
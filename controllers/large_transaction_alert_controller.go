@@ -0,0 +1,73 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// LargeTransactionAlertController lets a user view and set their large-
+// transaction alert threshold and browse the resulting activity feed.
+type LargeTransactionAlertController struct {
+	alerts *services.LargeTransactionAlertService
+}
+
+// NewLargeTransactionAlertController builds a LargeTransactionAlertController.
+func NewLargeTransactionAlertController(alerts *services.LargeTransactionAlertService) *LargeTransactionAlertController {
+	return &LargeTransactionAlertController{alerts: alerts}
+}
+
+// Get returns the user's large-transaction alert rule, or a disabled
+// zero-value rule if none has been set.
+func (lc *LargeTransactionAlertController) Get(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	rule, err := lc.alerts.GetThreshold(tenant.ID, c.Param("id"))
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to load large-transaction alert")
+		return
+	}
+
+	c.JSON(http.StatusOK, rule)
+}
+
+type setLargeTransactionAlertRequest struct {
+	Threshold int64 `json:"threshold" binding:"gte=0"`
+}
+
+// Put sets the user's large-transaction alert threshold, or disables the
+// alert if threshold is 0.
+func (lc *LargeTransactionAlertController) Put(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req setLargeTransactionAlertRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	rule, err := lc.alerts.SetThreshold(tenant.ID, c.Param("id"), req.Threshold)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to update large-transaction alert")
+		return
+	}
+
+	c.JSON(http.StatusOK, rule)
+}
+
+// ActivityFeed returns the user's past large-transaction alert firings,
+// most recent first.
+func (lc *LargeTransactionAlertController) ActivityFeed(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	events, err := lc.alerts.ActivityFeed(tenant.ID, c.Param("id"))
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to load activity feed")
+		return
+	}
+
+	c.JSON(http.StatusOK, events)
+}
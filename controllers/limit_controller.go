@@ -0,0 +1,64 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// LimitController lets admins view and override an account's transaction
+// limits, e.g. after enhanced due diligence.
+type LimitController struct {
+	limits *services.LimitService
+}
+
+// NewLimitController builds a LimitController.
+func NewLimitController(limits *services.LimitService) *LimitController {
+	return &LimitController{limits: limits}
+}
+
+// Get returns the account's current limit override, or an unlimited
+// zero-value Limit if none has been set.
+func (lc *LimitController) Get(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	limit, err := lc.limits.Get(tenant.ID, c.Param("id"))
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to load account limits")
+		return
+	}
+
+	c.JSON(http.StatusOK, limit)
+}
+
+type setAccountLimitsRequest struct {
+	PerTransactionLimit int64  `json:"per_transaction_limit"`
+	DailyLimit          int64  `json:"daily_limit"`
+	MonthlyLimit        int64  `json:"monthly_limit"`
+	ReasonCode          string `json:"reason_code" binding:"required"`
+	Justification       string `json:"justification" binding:"required"`
+}
+
+// Put overrides the account's transaction limits, effective on its very
+// next transaction, and records the change in the audit log.
+func (lc *LimitController) Put(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+	admin := utils.ActorFromContext(c)
+
+	var req setAccountLimitsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	limit, err := lc.limits.Set(tenant.ID, c.Param("id"), admin.ID, req.PerTransactionLimit, req.DailyLimit, req.MonthlyLimit, req.ReasonCode, req.Justification)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to update account limits")
+		return
+	}
+
+	c.JSON(http.StatusOK, limit)
+}
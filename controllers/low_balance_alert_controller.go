@@ -0,0 +1,59 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// LowBalanceAlertController lets users view and set the low-balance
+// threshold on one of their accounts.
+type LowBalanceAlertController struct {
+	alerts *services.LowBalanceAlertService
+}
+
+// NewLowBalanceAlertController builds a LowBalanceAlertController.
+func NewLowBalanceAlertController(alerts *services.LowBalanceAlertService) *LowBalanceAlertController {
+	return &LowBalanceAlertController{alerts: alerts}
+}
+
+// Get returns the account's low-balance alert configuration, or a
+// disabled zero-value configuration if none has been set.
+func (lc *LowBalanceAlertController) Get(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	alert, err := lc.alerts.Get(tenant.ID, c.Param("id"))
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to load low-balance alert")
+		return
+	}
+
+	c.JSON(http.StatusOK, alert)
+}
+
+type setLowBalanceAlertRequest struct {
+	Threshold int64 `json:"threshold" binding:"gte=0"`
+}
+
+// Put sets the account's low-balance threshold, or disables the alert if
+// threshold is 0.
+func (lc *LowBalanceAlertController) Put(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req setLowBalanceAlertRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	alert, err := lc.alerts.Set(tenant.ID, c.Param("id"), req.Threshold)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to update low-balance alert")
+		return
+	}
+
+	c.JSON(http.StatusOK, alert)
+}
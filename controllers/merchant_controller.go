@@ -0,0 +1,72 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/divzzrk/go_bank_api/ids"
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// MerchantController exposes tenant-scoped merchant management and each
+// merchant's settlement report.
+type MerchantController struct {
+	merchants   *repository.MerchantRepository
+	settlements *services.SettlementService
+}
+
+// NewMerchantController builds a MerchantController.
+func NewMerchantController(merchants *repository.MerchantRepository, settlements *services.SettlementService) *MerchantController {
+	return &MerchantController{merchants: merchants, settlements: settlements}
+}
+
+type createMerchantRequest struct {
+	Name                string `json:"name" binding:"required"`
+	SettlementAccountID string `json:"settlement_account_id" binding:"required"`
+	FeeBps              int    `json:"fee_bps" binding:"min=0"`
+}
+
+// Create registers a merchant and the settlement account its nightly
+// receipts are netted and paid out to.
+func (mc *MerchantController) Create(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req createMerchantRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	merchant := &models.Merchant{
+		ID:                  ids.New(),
+		TenantID:            tenant.ID,
+		Name:                req.Name,
+		SettlementAccountID: req.SettlementAccountID,
+		FeeBps:              req.FeeBps,
+		Active:              true,
+	}
+	if err := mc.merchants.Create(merchant); err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to create merchant")
+		return
+	}
+
+	c.JSON(http.StatusCreated, merchant)
+}
+
+// SettlementHistory returns every settlement batch a merchant's nightly
+// runs have produced, for its downloadable settlement report.
+func (mc *MerchantController) SettlementHistory(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	batches, err := mc.settlements.History(tenant.ID, c.Param("id"))
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to load settlement history")
+		return
+	}
+
+	c.JSON(http.StatusOK, batches)
+}
@@ -0,0 +1,55 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// MonthlySummaryController serves an account's precomputed monthly
+// activity summaries for month-view analytics screens.
+type MonthlySummaryController struct {
+	summaries *services.MonthlySummaryService
+}
+
+// NewMonthlySummaryController builds a MonthlySummaryController.
+func NewMonthlySummaryController(summaries *services.MonthlySummaryService) *MonthlySummaryController {
+	return &MonthlySummaryController{summaries: summaries}
+}
+
+// Get returns accountID's precomputed summary for the month given by the
+// "month" query parameter (format "YYYY-MM").
+func (mc *MonthlySummaryController) Get(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	month := c.Query("month")
+	if month == "" {
+		utils.Error(c, http.StatusBadRequest, "month query parameter is required")
+		return
+	}
+
+	summary, err := mc.summaries.GetSummary(c.Request.Context(), tenant.ID, c.Param("id"), month)
+	if err != nil {
+		utils.Error(c, http.StatusNotFound, "no summary found for that month")
+		return
+	}
+
+	c.JSON(http.StatusOK, summary)
+}
+
+// List returns every precomputed monthly summary for accountID, for a
+// month-over-month trend view.
+func (mc *MonthlySummaryController) List(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	summaries, err := mc.summaries.ListSummaries(c.Request.Context(), tenant.ID, c.Param("id"))
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to load monthly summaries")
+		return
+	}
+
+	c.JSON(http.StatusOK, summaries)
+}
@@ -0,0 +1,107 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/middleware"
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// OpenBankingController exposes a read-only account-information surface
+// for third parties acting under an explicit customer Consent.
+type OpenBankingController struct {
+	accounts     *repository.AccountRepository
+	transactions *repository.TransactionRepository
+	consents     *services.ConsentService
+}
+
+// NewOpenBankingController builds an OpenBankingController.
+func NewOpenBankingController(accounts *repository.AccountRepository, transactions *repository.TransactionRepository, consents *services.ConsentService) *OpenBankingController {
+	return &OpenBankingController{accounts: accounts, transactions: transactions, consents: consents}
+}
+
+// Accounts lists the accounts the caller's consent covers.
+func (oc *OpenBankingController) Accounts(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+	token := c.MustGet(middleware.TokenContextKey).(*models.APIToken)
+
+	consent, err := oc.consents.Resolve(tenant.ID, token.ID)
+	if err != nil {
+		oc.handleError(c, err)
+		return
+	}
+
+	accounts, err := oc.accounts.ListByUser(tenant.ID, token.UserID)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to list accounts")
+		return
+	}
+
+	covered := make([]models.Account, 0, len(accounts))
+	for _, account := range accounts {
+		if consent.HasAccount(account.ID) {
+			covered = append(covered, account)
+		}
+	}
+
+	c.JSON(http.StatusOK, covered)
+}
+
+// Balance returns a single consented account's current balance.
+func (oc *OpenBankingController) Balance(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+	token := c.MustGet(middleware.TokenContextKey).(*models.APIToken)
+	accountID := c.Param("id")
+
+	if _, err := oc.consents.Authorize(tenant.ID, token.ID, accountID); err != nil {
+		oc.handleError(c, err)
+		return
+	}
+
+	account, err := oc.accounts.FindByID(tenant.ID, accountID)
+	if err != nil {
+		utils.Error(c, http.StatusNotFound, "account not found")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"account_id": account.ID, "currency": account.Currency, "balance": account.Balance, "available": account.Available()})
+}
+
+// Transactions returns a single consented account's transaction history.
+func (oc *OpenBankingController) Transactions(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+	token := c.MustGet(middleware.TokenContextKey).(*models.APIToken)
+	accountID := c.Param("id")
+
+	if _, err := oc.consents.Authorize(tenant.ID, token.ID, accountID); err != nil {
+		oc.handleError(c, err)
+		return
+	}
+
+	txs, err := oc.transactions.ListByAccount(tenant.ID, accountID)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to list transactions")
+		return
+	}
+
+	c.JSON(http.StatusOK, txs)
+}
+
+func (oc *OpenBankingController) handleError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, services.ErrConsentNotFound):
+		utils.Error(c, http.StatusUnauthorized, err.Error())
+	case errors.Is(err, services.ErrConsentNotLive):
+		utils.Error(c, http.StatusForbidden, err.Error())
+	case errors.Is(err, services.ErrAccountNotConsented):
+		utils.Error(c, http.StatusForbidden, err.Error())
+	default:
+		utils.Error(c, http.StatusInternalServerError, "failed to authorize consent")
+	}
+}
@@ -0,0 +1,94 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/middleware"
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// PaymentInitiationController exposes the PSD2-style payment initiation
+// flow: a third party requests a payment, and the customer separately
+// confirms or rejects it.
+type PaymentInitiationController struct {
+	payments *services.PaymentInitiationService
+}
+
+// NewPaymentInitiationController builds a PaymentInitiationController.
+func NewPaymentInitiationController(payments *services.PaymentInitiationService) *PaymentInitiationController {
+	return &PaymentInitiationController{payments: payments}
+}
+
+type initiatePaymentRequest struct {
+	AccountID             string `json:"account_id" binding:"required"`
+	CounterpartyAccountID string `json:"counterparty_account_id" binding:"required"`
+	Amount                int64  `json:"amount" binding:"required,gt=0"`
+	Currency              string `json:"currency" binding:"required"`
+	Reference             string `json:"reference"`
+}
+
+// Initiate is called by a third party to request a payment. The transfer
+// is not enqueued until the customer confirms it.
+func (pc *PaymentInitiationController) Initiate(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+	token := c.MustGet(middleware.TokenContextKey).(*models.APIToken)
+
+	var req initiatePaymentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	consent, err := pc.payments.Initiate(tenant.ID, token.UserID, token.Name, req.AccountID, req.CounterpartyAccountID, req.Amount, req.Currency, req.Reference)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to initiate payment")
+		return
+	}
+
+	c.JSON(http.StatusCreated, consent)
+}
+
+// Confirm is called once the customer has approved the payment through
+// the redirect flow, posting the transfer.
+func (pc *PaymentInitiationController) Confirm(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	tx, err := pc.payments.Confirm(c.Request.Context(), tenant.ID, c.Param("id"))
+	if err != nil {
+		pc.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, tx)
+}
+
+// Reject is called when the customer declines the payment.
+func (pc *PaymentInitiationController) Reject(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	consent, err := pc.payments.Reject(tenant.ID, c.Param("id"))
+	if err != nil {
+		pc.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, consent)
+}
+
+func (pc *PaymentInitiationController) handleError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, services.ErrPaymentConsentNotFound):
+		utils.Error(c, http.StatusNotFound, err.Error())
+	case errors.Is(err, services.ErrPaymentConsentNotPending):
+		utils.Error(c, http.StatusConflict, err.Error())
+	case errors.Is(err, services.ErrPaymentConsentExpired):
+		utils.Error(c, http.StatusUnprocessableEntity, err.Error())
+	default:
+		utils.Error(c, http.StatusInternalServerError, "failed to process payment consent")
+	}
+}
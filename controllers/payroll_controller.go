@@ -0,0 +1,68 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// PayrollController exposes tenant-scoped payroll template management.
+type PayrollController struct {
+	payroll *services.PayrollService
+}
+
+// NewPayrollController builds a PayrollController.
+func NewPayrollController(payroll *services.PayrollService) *PayrollController {
+	return &PayrollController{payroll: payroll}
+}
+
+type createPayrollTemplateRequest struct {
+	AccountID string `json:"account_id" binding:"required"`
+	Name      string `json:"name" binding:"required"`
+	PayDay    int    `json:"pay_day" binding:"required,min=1,max=28"`
+	Employees []struct {
+		EmployeeAccountID string `json:"employee_account_id" binding:"required"`
+		Amount            int64  `json:"amount" binding:"required,gt=0"`
+	} `json:"employees" binding:"required,min=1"`
+}
+
+// Create defines a new recurring payroll template.
+func (pc *PayrollController) Create(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req createPayrollTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	lines := make([]services.PayrollLineInput, 0, len(req.Employees))
+	for _, e := range req.Employees {
+		lines = append(lines, services.PayrollLineInput{EmployeeAccountID: e.EmployeeAccountID, Amount: e.Amount})
+	}
+
+	template, err := pc.payroll.CreateTemplate(tenant.ID, req.AccountID, req.Name, req.PayDay, lines)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to create payroll template")
+		return
+	}
+
+	c.JSON(http.StatusCreated, template)
+}
+
+// History returns every bulk transfer batch a payroll template's runs
+// have produced.
+func (pc *PayrollController) History(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	batches, err := pc.payroll.History(tenant.ID, c.Param("id"))
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to load payroll history")
+		return
+	}
+
+	c.JSON(http.StatusOK, batches)
+}
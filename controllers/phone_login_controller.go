@@ -0,0 +1,84 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// PhoneLoginController drives passwordless login: requesting an OTP to a
+// registered phone and exchanging it for an API token.
+type PhoneLoginController struct {
+	logins *services.PhoneLoginService
+}
+
+// NewPhoneLoginController builds a PhoneLoginController.
+func NewPhoneLoginController(logins *services.PhoneLoginService) *PhoneLoginController {
+	return &PhoneLoginController{logins: logins}
+}
+
+type requestPhoneLoginRequest struct {
+	Phone string `json:"phone" binding:"required"`
+}
+
+// Request sends a login OTP to phone, if it belongs to a registered user.
+func (pc *PhoneLoginController) Request(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req requestPhoneLoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	request, err := pc.logins.Request(tenant.ID, req.Phone)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrPhoneNotRegistered):
+			utils.Error(c, http.StatusNotFound, err.Error())
+			return
+		case errors.Is(err, services.ErrPhoneLoginCooldown):
+			utils.Error(c, http.StatusTooManyRequests, err.Error())
+			return
+		default:
+			utils.Error(c, http.StatusInternalServerError, "failed to request phone login")
+			return
+		}
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"id": request.ID, "expires_at": request.ExpiresAt})
+}
+
+type verifyPhoneLoginRequest struct {
+	Code string `json:"code" binding:"required"`
+}
+
+// Verify completes a pending phone-login request, returning a freshly
+// issued API token in exchange for the correct OTP.
+func (pc *PhoneLoginController) Verify(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req verifyPhoneLoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	token, plaintext, err := pc.logins.Verify(tenant.ID, c.Param("id"), req.Code)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrPhoneLoginNotVerifiable), errors.Is(err, services.ErrPhoneLoginCodeMismatch), errors.Is(err, services.ErrPhoneLoginAttemptsExceeded):
+			utils.Error(c, http.StatusUnprocessableEntity, err.Error())
+			return
+		default:
+			utils.Error(c, http.StatusInternalServerError, "failed to verify phone login")
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{"token": token, "plaintext": plaintext})
+}
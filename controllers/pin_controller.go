@@ -0,0 +1,84 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/repository"
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// PINController drives the forgot-PIN flow: requesting an OTP and
+// verifying it to set a new PIN.
+type PINController struct {
+	pins  *services.PINService
+	users *repository.UserRepository
+}
+
+// NewPINController builds a PINController.
+func NewPINController(pins *services.PINService, users *repository.UserRepository) *PINController {
+	return &PINController{pins: pins, users: users}
+}
+
+// RequestReset sends a PIN-reset OTP to the calling user's registered
+// phone.
+func (pc *PINController) RequestReset(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	userID := c.GetHeader("X-User-ID")
+	if userID == "" {
+		utils.Error(c, http.StatusUnauthorized, "missing X-User-ID header")
+		return
+	}
+
+	user, err := pc.users.FindByID(tenant.ID, userID)
+	if err != nil {
+		utils.Error(c, http.StatusNotFound, "user not found")
+		return
+	}
+
+	request, err := pc.pins.Request(tenant.ID, user)
+	if err != nil {
+		if errors.Is(err, services.ErrPINResetCooldown) {
+			utils.Error(c, http.StatusTooManyRequests, err.Error())
+			return
+		}
+		utils.Error(c, http.StatusInternalServerError, "failed to request pin reset")
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"id": request.ID, "expires_at": request.ExpiresAt})
+}
+
+type verifyPINResetRequest struct {
+	Code   string `json:"code" binding:"required"`
+	NewPIN string `json:"new_pin" binding:"required"`
+}
+
+// VerifyReset completes a pending PIN-reset request, setting the new PIN
+// and freezing the user's accounts against new transactions.
+func (pc *PINController) VerifyReset(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req verifyPINResetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := pc.pins.Verify(tenant.ID, c.Param("id"), req.Code, req.NewPIN); err != nil {
+		switch {
+		case errors.Is(err, services.ErrPINResetNotVerifiable), errors.Is(err, services.ErrPINResetCodeMismatch), errors.Is(err, services.ErrPINResetAttemptsExceeded):
+			utils.Error(c, http.StatusUnprocessableEntity, err.Error())
+			return
+		default:
+			utils.Error(c, http.StatusInternalServerError, "failed to verify pin reset")
+			return
+		}
+	}
+
+	c.Status(http.StatusNoContent)
+}
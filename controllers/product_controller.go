@@ -0,0 +1,126 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/divzzrk/go_bank_api/ids"
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// ProductController lets admins manage the tenant's savings/deposit
+// product catalog.
+type ProductController struct {
+	products *repository.ProductRepository
+}
+
+// NewProductController builds a ProductController.
+func NewProductController(products *repository.ProductRepository) *ProductController {
+	return &ProductController{products: products}
+}
+
+type productRequest struct {
+	Name                 string                      `json:"name" binding:"required"`
+	InterestRateBps      int                         `json:"interest_rate_bps"`
+	CompoundingFrequency models.CompoundingFrequency `json:"compounding_frequency" binding:"required,oneof=daily monthly quarterly annually"`
+	MinimumBalance       int64                       `json:"minimum_balance"`
+	WithdrawalPenaltyBps int                         `json:"withdrawal_penalty_bps"`
+	MinimumTier          models.UserTier             `json:"minimum_tier" binding:"required,oneof=basic plus premium"`
+	MinimumKYCLevel      int                         `json:"minimum_kyc_level"`
+}
+
+// Create defines a new product for the calling tenant, as version 1 of a
+// new product family.
+func (pc *ProductController) Create(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req productRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	id := ids.New()
+	product := &models.Product{
+		ID:                   id,
+		TenantID:             tenant.ID,
+		FamilyID:             id,
+		Version:              1,
+		Active:               true,
+		Name:                 req.Name,
+		InterestRateBps:      req.InterestRateBps,
+		CompoundingFrequency: req.CompoundingFrequency,
+		MinimumBalance:       req.MinimumBalance,
+		WithdrawalPenaltyBps: req.WithdrawalPenaltyBps,
+		MinimumTier:          req.MinimumTier,
+		MinimumKYCLevel:      req.MinimumKYCLevel,
+	}
+	if err := pc.products.Create(product); err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to create product")
+		return
+	}
+
+	c.JSON(http.StatusCreated, product)
+}
+
+// Revise retires a product version and replaces it with a new one in the
+// same family. Accounts already open against the retired version keep its
+// exact terms; new accounts open against the version this call creates.
+func (pc *ProductController) Revise(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req productRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	current, err := pc.products.FindByID(tenant.ID, c.Param("id"))
+	if err != nil {
+		utils.Error(c, http.StatusNotFound, "product not found")
+		return
+	}
+
+	revised := &models.Product{
+		ID:                   ids.New(),
+		TenantID:             tenant.ID,
+		FamilyID:             current.FamilyID,
+		Version:              current.Version + 1,
+		Active:               true,
+		Name:                 req.Name,
+		InterestRateBps:      req.InterestRateBps,
+		CompoundingFrequency: req.CompoundingFrequency,
+		MinimumBalance:       req.MinimumBalance,
+		WithdrawalPenaltyBps: req.WithdrawalPenaltyBps,
+		MinimumTier:          req.MinimumTier,
+		MinimumKYCLevel:      req.MinimumKYCLevel,
+	}
+	if err := pc.products.Create(revised); err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to create revised product")
+		return
+	}
+
+	if err := pc.products.Deactivate(tenant.ID, current.ID); err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to retire previous product version")
+		return
+	}
+
+	c.JSON(http.StatusOK, revised)
+}
+
+// List returns the tenant's current product catalog, i.e. every version
+// still open to new accounts.
+func (pc *ProductController) List(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	products, err := pc.products.List(tenant.ID)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to list products")
+		return
+	}
+
+	c.JSON(http.StatusOK, products)
+}
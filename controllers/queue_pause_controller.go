@@ -0,0 +1,54 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// QueuePauseController lets an operator pause and resume outbound
+// transaction event publishing, globally or per transaction-type queue.
+// See services.QueuePauseService.
+type QueuePauseController struct {
+	pauses *services.QueuePauseService
+}
+
+// NewQueuePauseController builds a QueuePauseController.
+func NewQueuePauseController(pauses *services.QueuePauseService) *QueuePauseController {
+	return &QueuePauseController{pauses: pauses}
+}
+
+// List returns every queue with a recorded pause state.
+func (qc *QueuePauseController) List(c *gin.Context) {
+	states, err := qc.pauses.List()
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to load queue pause state")
+		return
+	}
+	c.JSON(http.StatusOK, states)
+}
+
+type setQueuePauseRequest struct {
+	Paused bool `json:"paused"`
+}
+
+// Set pauses or resumes the :queue path parameter, which is either
+// "global" or a transaction type's name (e.g. "withdrawal").
+func (qc *QueuePauseController) Set(c *gin.Context) {
+	var req setQueuePauseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	state, err := qc.pauses.Set(c.Param("queue"), req.Paused)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to update queue pause state")
+		return
+	}
+
+	c.JSON(http.StatusOK, state)
+}
@@ -0,0 +1,33 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// ReceiptController serves PDF receipts for posted transactions.
+type ReceiptController struct {
+	receipts *services.ReceiptService
+}
+
+// NewReceiptController builds a ReceiptController.
+func NewReceiptController(receipts *services.ReceiptService) *ReceiptController {
+	return &ReceiptController{receipts: receipts}
+}
+
+// Get renders and returns the PDF receipt for a transaction.
+func (rc *ReceiptController) Get(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	pdfBytes, err := rc.receipts.Render(tenant.ID, c.Param("id"))
+	if err != nil {
+		utils.Error(c, http.StatusNotFound, "transaction not found")
+		return
+	}
+
+	c.Data(http.StatusOK, "application/pdf", pdfBytes)
+}
@@ -0,0 +1,102 @@
+package controllers
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// reconciliationReportMovementTypes fixes the column order for movement
+// totals in the exported report, so the CSV's header stays stable across
+// requests instead of following Go's randomized map iteration order.
+var reconciliationReportMovementTypes = []models.TransactionType{
+	models.TransactionTypeDeposit,
+	models.TransactionTypeWithdrawal,
+	models.TransactionTypeTransfer,
+	models.TransactionTypeAdjustmentCredit,
+	models.TransactionTypeAdjustmentDebit,
+}
+
+// ReconciliationReportController exports the finance team's daily
+// reconciliation report.
+type ReconciliationReportController struct {
+	reports *services.ReconciliationReportService
+}
+
+// NewReconciliationReportController builds a ReconciliationReportController.
+func NewReconciliationReportController(reports *services.ReconciliationReportService) *ReconciliationReportController {
+	return &ReconciliationReportController{reports: reports}
+}
+
+// Export streams the reconciliation report for the ?date query parameter
+// (YYYY-MM-DD) as a downloadable CSV: one row per account plus a trailing
+// aggregate row, opening and closing balances, movement totals by
+// transaction type, and fees collected. Only CSV is supported today; this
+// codebase has no XLSX-writing dependency to build the spreadsheet variant
+// the request also asked for.
+func (rc *ReconciliationReportController) Export(c *gin.Context) {
+	raw := c.Query("date")
+	if raw == "" {
+		utils.Error(c, http.StatusBadRequest, "date query parameter is required (YYYY-MM-DD)")
+		return
+	}
+	date, err := time.Parse("2006-01-02", raw)
+	if err != nil {
+		utils.Error(c, http.StatusBadRequest, "date must be in YYYY-MM-DD format")
+		return
+	}
+
+	if format := c.Query("format"); format != "" && format != "csv" {
+		utils.Error(c, http.StatusBadRequest, "only csv is supported")
+		return
+	}
+
+	report, err := rc.reports.Generate(date)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to build reconciliation report")
+		return
+	}
+
+	c.Header("Content-Type", "text/csv")
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=reconciliation-%s.csv", date.Format("2006-01-02")))
+	c.Status(http.StatusOK)
+
+	w := csv.NewWriter(c.Writer)
+
+	header := []string{"tenant_id", "account_id", "currency", "opening_balance", "closing_balance"}
+	for _, t := range reconciliationReportMovementTypes {
+		header = append(header, string(t))
+	}
+	header = append(header, "fees_collected")
+	if err := w.Write(header); err != nil {
+		return
+	}
+
+	for _, line := range report.Accounts {
+		if err := w.Write(reconciliationReportRow(line.TenantID, line.AccountID, line.Currency, line.OpeningBalance, line.ClosingBalance, line.Movements, line.FeesCollected)); err != nil {
+			return
+		}
+	}
+
+	if err := w.Write(reconciliationReportRow("", "TOTAL", "", report.TotalOpeningBalance, report.TotalClosingBalance, report.TotalMovements, report.TotalFeesCollected)); err != nil {
+		return
+	}
+
+	w.Flush()
+}
+
+func reconciliationReportRow(tenantID, accountID, currency string, opening, closing int64, movements map[models.TransactionType]int64, fees int64) []string {
+	row := []string{tenantID, accountID, currency, fmt.Sprintf("%d", opening), fmt.Sprintf("%d", closing)}
+	for _, t := range reconciliationReportMovementTypes {
+		row = append(row, fmt.Sprintf("%d", movements[t]))
+	}
+	row = append(row, fmt.Sprintf("%d", fees))
+	return row
+}
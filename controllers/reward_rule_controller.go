@@ -0,0 +1,79 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/divzzrk/go_bank_api/ids"
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// RewardRuleController lets admins configure how points are earned.
+type RewardRuleController struct {
+	rules *repository.RewardRuleRepository
+}
+
+// NewRewardRuleController builds a RewardRuleController.
+func NewRewardRuleController(rules *repository.RewardRuleRepository) *RewardRuleController {
+	return &RewardRuleController{rules: rules}
+}
+
+type rewardRuleRequest struct {
+	Category      string `json:"category"`
+	Merchant      string `json:"merchant"`
+	PointsRateBps int    `json:"points_rate_bps" binding:"required,gt=0"`
+}
+
+// Create adds a new reward rule.
+func (rc *RewardRuleController) Create(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req rewardRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	rule := &models.RewardRule{
+		ID:            ids.New(),
+		TenantID:      tenant.ID,
+		Category:      req.Category,
+		Merchant:      req.Merchant,
+		PointsRateBps: req.PointsRateBps,
+		Active:        true,
+	}
+	if err := rc.rules.Create(rule); err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to create reward rule")
+		return
+	}
+
+	c.JSON(http.StatusCreated, rule)
+}
+
+// List returns every reward rule for the calling tenant.
+func (rc *RewardRuleController) List(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	rules, err := rc.rules.List(tenant.ID)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to list reward rules")
+		return
+	}
+
+	c.JSON(http.StatusOK, rules)
+}
+
+// Deactivate stops a reward rule from earning further points.
+func (rc *RewardRuleController) Deactivate(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	if err := rc.rules.Deactivate(tenant.ID, c.Param("id")); err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to deactivate reward rule")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,78 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// RewardsController exposes a customer's points balance and lets them
+// redeem points into a deposit.
+type RewardsController struct {
+	rewards *services.RewardsService
+}
+
+// NewRewardsController builds a RewardsController.
+func NewRewardsController(rewards *services.RewardsService) *RewardsController {
+	return &RewardsController{rewards: rewards}
+}
+
+// Balance returns the calling user's points balance. The caller identifies
+// themselves via X-User-ID, matching the interim auth used elsewhere for
+// self-service endpoints.
+func (rc *RewardsController) Balance(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	userID := c.GetHeader("X-User-ID")
+	if userID == "" {
+		utils.Error(c, http.StatusBadRequest, "X-User-ID header is required")
+		return
+	}
+
+	balance, err := rc.rewards.Balance(tenant.ID, userID)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to load points balance")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"balance": balance})
+}
+
+type redeemPointsRequest struct {
+	AccountID string `json:"account_id" binding:"required"`
+	Points    int64  `json:"points" binding:"required,gt=0"`
+}
+
+// Redeem converts points from the calling user's balance into a deposit
+// posted to the given account.
+func (rc *RewardsController) Redeem(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	userID := c.GetHeader("X-User-ID")
+	if userID == "" {
+		utils.Error(c, http.StatusBadRequest, "X-User-ID header is required")
+		return
+	}
+
+	var req redeemPointsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	tx, err := rc.rewards.Redeem(c.Request.Context(), tenant.ID, userID, req.AccountID, req.Points)
+	if err != nil {
+		if errors.Is(err, services.ErrInsufficientPoints) || errors.Is(err, services.ErrInvalidRedemption) {
+			utils.Error(c, http.StatusUnprocessableEntity, err.Error())
+			return
+		}
+		utils.Error(c, http.StatusInternalServerError, "failed to redeem points")
+		return
+	}
+
+	c.JSON(http.StatusCreated, tx)
+}
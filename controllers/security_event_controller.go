@@ -0,0 +1,32 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/repository"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// SecurityEventController lets platform operators inspect security-
+// relevant request rejections, such as IP allowlist violations.
+type SecurityEventController struct {
+	events *repository.SecurityEventRepository
+}
+
+// NewSecurityEventController builds a SecurityEventController.
+func NewSecurityEventController(events *repository.SecurityEventRepository) *SecurityEventController {
+	return &SecurityEventController{events: events}
+}
+
+// List returns security events, optionally filtered by ?tenant_id.
+func (sc *SecurityEventController) List(c *gin.Context) {
+	events, err := sc.events.List(c.Query("tenant_id"))
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to list security events")
+		return
+	}
+
+	c.JSON(http.StatusOK, events)
+}
@@ -0,0 +1,52 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// SessionController lets a user (or an admin acting on their behalf) see
+// active sessions and revoke a compromised one.
+type SessionController struct {
+	sessions *services.SessionService
+}
+
+// NewSessionController builds a SessionController.
+func NewSessionController(sessions *services.SessionService) *SessionController {
+	return &SessionController{sessions: sessions}
+}
+
+// List returns every session recorded for the user in :id.
+func (sc *SessionController) List(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	sessions, err := sc.sessions.List(tenant.ID, c.Param("id"))
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to list sessions")
+		return
+	}
+
+	c.JSON(http.StatusOK, sessions)
+}
+
+// Revoke ends a session immediately; SessionTracker rejects the next
+// request that presents it.
+func (sc *SessionController) Revoke(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	if err := sc.sessions.Revoke(tenant.ID, c.Param("id"), c.Param("session_id")); err != nil {
+		if errors.Is(err, services.ErrSessionNotFound) {
+			utils.Error(c, http.StatusNotFound, err.Error())
+			return
+		}
+		utils.Error(c, http.StatusInternalServerError, "failed to revoke session")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
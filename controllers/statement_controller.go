@@ -0,0 +1,90 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// StatementController serves date-range account statements.
+type StatementController struct {
+	statements *services.StatementService
+}
+
+// NewStatementController builds a StatementController.
+func NewStatementController(statements *services.StatementService) *StatementController {
+	return &StatementController{statements: statements}
+}
+
+// Get returns the account's statement for the ?from and ?to query
+// parameters, both RFC 3339 timestamps.
+func (sc *StatementController) Get(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	from, err := time.Parse(time.RFC3339, c.Query("from"))
+	if err != nil {
+		utils.Error(c, http.StatusBadRequest, "from must be an RFC3339 timestamp")
+		return
+	}
+	to, err := time.Parse(time.RFC3339, c.Query("to"))
+	if err != nil {
+		utils.Error(c, http.StatusBadRequest, "to must be an RFC3339 timestamp")
+		return
+	}
+	if to.Before(from) {
+		utils.Error(c, http.StatusBadRequest, "to must not be before from")
+		return
+	}
+
+	statement, err := sc.statements.Generate(tenant.ID, c.Param("id"), from, to)
+	if err != nil {
+		utils.Error(c, http.StatusNotFound, "account not found")
+		return
+	}
+
+	c.JSON(http.StatusOK, statement)
+}
+
+type emailStatementRequest struct {
+	From time.Time `json:"from" binding:"required"`
+	To   time.Time `json:"to" binding:"required"`
+}
+
+// Email requests that the account's statement for the given period be
+// generated and emailed to the calling user.
+func (sc *StatementController) Email(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	userID := c.GetHeader("X-User-ID")
+	if userID == "" {
+		utils.Error(c, http.StatusBadRequest, "X-User-ID header is required")
+		return
+	}
+
+	var req emailStatementRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	if req.To.Before(req.From) {
+		utils.Error(c, http.StatusBadRequest, "to must not be before from")
+		return
+	}
+
+	emailReq, err := sc.statements.EmailStatement(tenant.ID, c.Param("id"), userID, req.From, req.To)
+	if err != nil {
+		if errors.Is(err, services.ErrStatementEmailRateLimited) {
+			utils.Error(c, http.StatusTooManyRequests, err.Error())
+			return
+		}
+		utils.Error(c, http.StatusInternalServerError, "failed to email statement")
+		return
+	}
+
+	c.JSON(http.StatusAccepted, emailReq)
+}
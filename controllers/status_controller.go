@@ -0,0 +1,25 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/services"
+)
+
+// StatusController serves the public, unauthenticated system status the
+// mobile app polls to decide whether to show an incident banner.
+type StatusController struct {
+	status *services.PublicStatusService
+}
+
+// NewStatusController builds a StatusController.
+func NewStatusController(status *services.PublicStatusService) *StatusController {
+	return &StatusController{status: status}
+}
+
+// Get returns a coarse, human-readable system health summary.
+func (sc *StatusController) Get(c *gin.Context) {
+	c.JSON(http.StatusOK, sc.status.Snapshot())
+}
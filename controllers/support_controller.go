@@ -0,0 +1,115 @@
+package controllers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/repository"
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// SupportController exposes the read-mostly views available under an
+// active impersonation session. Every handler is scoped to the session's
+// customer and every successful read is stamped into the audit log with
+// both the admin's and the customer's identity via
+// ImpersonationService.RecordAction.
+type SupportController struct {
+	accounts      *repository.AccountRepository
+	transactions  *repository.TransactionRepository
+	statements    *services.StatementService
+	impersonation *services.ImpersonationService
+}
+
+// NewSupportController builds a SupportController.
+func NewSupportController(accounts *repository.AccountRepository, transactions *repository.TransactionRepository, statements *services.StatementService, impersonation *services.ImpersonationService) *SupportController {
+	return &SupportController{accounts: accounts, transactions: transactions, statements: statements, impersonation: impersonation}
+}
+
+// ViewAccount returns the impersonated customer's account.
+func (sc *SupportController) ViewAccount(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+	session := utils.ImpersonationFromContext(c)
+
+	account, err := sc.accounts.FindByID(tenant.ID, c.Param("id"))
+	if err != nil || account.UserID != session.CustomerUserID {
+		utils.Error(c, http.StatusNotFound, "account not found")
+		return
+	}
+
+	if err := sc.impersonation.RecordAction(session, "impersonation_view_account", "account", account.ID); err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to record impersonation action")
+		return
+	}
+
+	c.JSON(http.StatusOK, account)
+}
+
+// ViewTransactions returns the impersonated customer's transaction history
+// for one account.
+func (sc *SupportController) ViewTransactions(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+	session := utils.ImpersonationFromContext(c)
+
+	account, err := sc.accounts.FindByID(tenant.ID, c.Param("id"))
+	if err != nil || account.UserID != session.CustomerUserID {
+		utils.Error(c, http.StatusNotFound, "account not found")
+		return
+	}
+
+	txs, err := sc.transactions.ListByAccount(tenant.ID, account.ID)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to load transactions")
+		return
+	}
+
+	if err := sc.impersonation.RecordAction(session, "impersonation_view_transactions", "account", account.ID); err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to record impersonation action")
+		return
+	}
+
+	c.JSON(http.StatusOK, txs)
+}
+
+// ViewStatement returns the impersonated customer's statement for the
+// ?from and ?to query parameters, both RFC 3339 timestamps.
+func (sc *SupportController) ViewStatement(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+	session := utils.ImpersonationFromContext(c)
+
+	account, err := sc.accounts.FindByID(tenant.ID, c.Param("id"))
+	if err != nil || account.UserID != session.CustomerUserID {
+		utils.Error(c, http.StatusNotFound, "account not found")
+		return
+	}
+
+	from, err := time.Parse(time.RFC3339, c.Query("from"))
+	if err != nil {
+		utils.Error(c, http.StatusBadRequest, "from must be an RFC3339 timestamp")
+		return
+	}
+	to, err := time.Parse(time.RFC3339, c.Query("to"))
+	if err != nil {
+		utils.Error(c, http.StatusBadRequest, "to must be an RFC3339 timestamp")
+		return
+	}
+	if to.Before(from) {
+		utils.Error(c, http.StatusBadRequest, "to must not be before from")
+		return
+	}
+
+	statement, err := sc.statements.Generate(tenant.ID, account.ID, from, to)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to generate statement")
+		return
+	}
+
+	if err := sc.impersonation.RecordAction(session, "impersonation_view_statement", "account", account.ID); err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to record impersonation action")
+		return
+	}
+
+	c.JSON(http.StatusOK, statement)
+}
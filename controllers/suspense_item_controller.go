@@ -0,0 +1,89 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// SuspenseItemController lets admins review inbound credits held in
+// suspense and release each one to the right customer account or return
+// it to the sender.
+type SuspenseItemController struct {
+	suspense *services.SuspenseService
+}
+
+// NewSuspenseItemController builds a SuspenseItemController.
+func NewSuspenseItemController(suspense *services.SuspenseService) *SuspenseItemController {
+	return &SuspenseItemController{suspense: suspense}
+}
+
+// List returns the calling tenant's unresolved suspense items.
+func (sc *SuspenseItemController) List(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	items, err := sc.suspense.List(tenant.ID)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to list suspense items")
+		return
+	}
+
+	c.JSON(http.StatusOK, items)
+}
+
+type matchSuspenseItemRequest struct {
+	AccountID string `json:"account_id" binding:"required"`
+}
+
+// Match releases a suspense item to the named account.
+func (sc *SuspenseItemController) Match(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req matchSuspenseItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	tx, err := sc.suspense.Match(c.Request.Context(), tenant.ID, c.Param("id"), req.AccountID)
+	if err != nil {
+		sc.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, tx)
+}
+
+type returnSuspenseItemRequest struct {
+	Reason string `json:"reason" binding:"required"`
+}
+
+// Return sends a suspense item's held amount back to the sender.
+func (sc *SuspenseItemController) Return(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req returnSuspenseItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := sc.suspense.Return(tenant.ID, c.Param("id"), req.Reason); err != nil {
+		sc.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (sc *SuspenseItemController) handleError(c *gin.Context, err error) {
+	if errors.Is(err, services.ErrSuspenseItemNotOpen) {
+		utils.Error(c, http.StatusConflict, err.Error())
+		return
+	}
+	utils.Error(c, http.StatusInternalServerError, "failed to resolve suspense item")
+}
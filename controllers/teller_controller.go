@@ -0,0 +1,134 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// TellerController exposes agent-only cash-drawer operations.
+type TellerController struct {
+	teller *services.TellerService
+}
+
+// NewTellerController builds a TellerController.
+func NewTellerController(teller *services.TellerService) *TellerController {
+	return &TellerController{teller: teller}
+}
+
+type cashRequest struct {
+	BranchID  string `json:"branch_id" binding:"required"`
+	AccountID string `json:"account_id" binding:"required"`
+	Amount    int64  `json:"amount" binding:"required,gt=0"`
+}
+
+// CashIn posts a customer cash deposit against the branch cash account.
+func (tc *TellerController) CashIn(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+	agent := utils.ActorFromContext(c)
+
+	var req cashRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	tx, err := tc.teller.CashIn(c.Request.Context(), tenant.ID, req.BranchID, agent.ID, req.AccountID, req.Amount)
+	if err != nil {
+		tc.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, tx)
+}
+
+// CashOut posts a customer cash withdrawal against the branch cash account.
+func (tc *TellerController) CashOut(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+	agent := utils.ActorFromContext(c)
+
+	var req cashRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	tx, err := tc.teller.CashOut(c.Request.Context(), tenant.ID, req.BranchID, agent.ID, req.AccountID, req.Amount)
+	if err != nil {
+		tc.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, tx)
+}
+
+type openSessionRequest struct {
+	BranchID       string `json:"branch_id" binding:"required"`
+	OpeningBalance int64  `json:"opening_balance"`
+}
+
+// OpenSession starts the calling agent's cash-drawer session for the day.
+func (tc *TellerController) OpenSession(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+	agent := utils.ActorFromContext(c)
+
+	var req openSessionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	session, err := tc.teller.OpenSession(tenant.ID, req.BranchID, agent.ID, req.OpeningBalance)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to open session")
+		return
+	}
+
+	c.JSON(http.StatusCreated, session)
+}
+
+type closeSessionRequest struct {
+	ClosingBalance int64 `json:"closing_balance"`
+}
+
+// CloseSession closes the calling agent's open cash-drawer session and
+// returns the end-of-day cash balance report for it.
+func (tc *TellerController) CloseSession(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+	agent := utils.ActorFromContext(c)
+
+	var req closeSessionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	session, err := tc.teller.CloseSession(tenant.ID, agent.ID, req.ClosingBalance)
+	if err != nil {
+		tc.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"session":         session,
+		"opening_balance": session.OpeningBalance,
+		"closing_balance": session.ClosingBalance,
+		"net_movement":    session.ClosingBalance - session.OpeningBalance,
+	})
+}
+
+func (tc *TellerController) handleError(c *gin.Context, err error) {
+	if errors.Is(err, services.ErrNoOpenSession) {
+		utils.Error(c, http.StatusConflict, err.Error())
+		return
+	}
+	if errors.Is(err, services.ErrInsufficientFunds) {
+		utils.Error(c, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+	utils.Error(c, http.StatusInternalServerError, "teller operation failed")
+}
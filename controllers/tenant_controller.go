@@ -0,0 +1,77 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/divzzrk/go_bank_api/ids"
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// TenantController exposes platform-admin endpoints for provisioning
+// tenants. These routes are not tenant-scoped themselves since they manage
+// tenants.
+type TenantController struct {
+	tenants *repository.TenantRepository
+}
+
+// NewTenantController builds a TenantController.
+func NewTenantController(tenants *repository.TenantRepository) *TenantController {
+	return &TenantController{tenants: tenants}
+}
+
+type createTenantRequest struct {
+	Name               string `json:"name" binding:"required"`
+	DefaultCurrency    string `json:"default_currency"`
+	BrandingName       string `json:"branding_name"`
+	BrandingLogoURL    string `json:"branding_logo_url"`
+	ApprovalThreshold  int64  `json:"approval_threshold"`
+	SignatureThreshold int64  `json:"signature_threshold"`
+}
+
+// Create provisions a new tenant and issues its API key.
+func (tc *TenantController) Create(c *gin.Context) {
+	var req createTenantRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	currency := req.DefaultCurrency
+	if currency == "" {
+		currency = "USD"
+	}
+
+	tenant := &models.Tenant{
+		ID:                 ids.New(),
+		Name:               req.Name,
+		APIKey:             uuid.NewString(),
+		DefaultCurrency:    currency,
+		BrandingName:       req.BrandingName,
+		BrandingLogoURL:    req.BrandingLogoURL,
+		ApprovalThreshold:  req.ApprovalThreshold,
+		SignatureThreshold: req.SignatureThreshold,
+	}
+
+	if err := tc.tenants.Create(tenant); err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to create tenant")
+		return
+	}
+
+	// The API key is only ever surfaced at creation time; subsequent reads
+	// of a tenant omit it.
+	c.JSON(http.StatusCreated, gin.H{
+		"id":                  tenant.ID,
+		"name":                tenant.Name,
+		"api_key":             tenant.APIKey,
+		"default_currency":    tenant.DefaultCurrency,
+		"branding_name":       tenant.BrandingName,
+		"branding_logo_url":   tenant.BrandingLogoURL,
+		"approval_threshold":  tenant.ApprovalThreshold,
+		"signature_threshold": tenant.SignatureThreshold,
+	})
+}
@@ -0,0 +1,46 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// TopUpController exposes the airtime/mobile top-up purchase endpoint.
+type TopUpController struct {
+	topups *services.TopUpService
+}
+
+// NewTopUpController builds a TopUpController.
+func NewTopUpController(topups *services.TopUpService) *TopUpController {
+	return &TopUpController{topups: topups}
+}
+
+type createTopUpRequest struct {
+	AccountID   string `json:"account_id" binding:"required"`
+	PhoneNumber string `json:"phone_number" binding:"required"`
+	Amount      int64  `json:"amount" binding:"required,gt=0"`
+}
+
+// Create debits the account and purchases airtime for the given phone
+// number, reversing the debit if the provider call fails.
+func (tc *TopUpController) Create(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req createTopUpRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	topup, err := tc.topups.Purchase(c.Request.Context(), tenant.ID, req.AccountID, req.PhoneNumber, req.Amount)
+	if err != nil {
+		utils.Error(c, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+
+	c.JSON(http.StatusCreated, topup)
+}
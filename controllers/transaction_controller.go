@@ -0,0 +1,416 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/divzzrk/go_bank_api/ids"
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/middleware"
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// TransactionController posts deposits, withdrawals, and transfers.
+type TransactionController struct {
+	transactions *services.TransactionService
+	approvals    *services.ApprovalService
+	devices      *services.DeviceService
+	deviceKeys   *services.DeviceKeyService
+	users        *repository.UserRepository
+	accounts     *repository.AccountRepository
+	referrals    *services.ReferralService
+	rewards      *services.RewardsService
+	stepUp       *services.StepUpChallengeService
+}
+
+// NewTransactionController builds a TransactionController.
+func NewTransactionController(transactions *services.TransactionService, approvals *services.ApprovalService, devices *services.DeviceService, deviceKeys *services.DeviceKeyService, users *repository.UserRepository, accounts *repository.AccountRepository, referrals *services.ReferralService, rewards *services.RewardsService, stepUp *services.StepUpChallengeService) *TransactionController {
+	return &TransactionController{transactions: transactions, approvals: approvals, devices: devices, deviceKeys: deviceKeys, users: users, accounts: accounts, referrals: referrals, rewards: rewards, stepUp: stepUp}
+}
+
+type createTransactionRequest struct {
+	AccountID             string                 `json:"account_id" binding:"required"`
+	CounterpartyAccountID string                 `json:"counterparty_account_id"`
+	Type                  models.TransactionType `json:"type" binding:"required"`
+	Amount                int64                  `json:"amount" binding:"required,gt=0"`
+	Currency              string                 `json:"currency"`
+	Category              string                 `json:"category"`
+	Merchant              string                 `json:"merchant"`
+	Signature             string                 `json:"signature"`
+}
+
+// Create posts a transaction against the calling tenant. If the request
+// was authenticated as an agent (see RequireRole), the agent's identity and
+// branch are attributed on the resulting transaction.
+func (tc *TransactionController) Create(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req createTransactionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// A token scoped to ScopeDepositCreate rather than the broader
+	// ScopeTransactionCreate (see RequireScope on this route) can only
+	// post deposits; RequireScope alone can't express that, since it
+	// runs before the body is parsed.
+	if raw, ok := c.Get(middleware.TokenContextKey); ok {
+		token := raw.(*models.APIToken)
+		if !token.HasScope(models.ScopeTransactionCreate) && !(token.HasScope(models.ScopeDepositCreate) && req.Type == models.TransactionTypeDeposit) {
+			utils.Error(c, http.StatusForbidden, "api token missing required scope for this transaction type")
+			return
+		}
+	}
+
+	// Generated up front so the client gets back the same transaction_id no
+	// matter which path the request takes: posted immediately, held for
+	// maker-checker approval, or held for compliance review.
+	requestID := ids.New()
+
+	// Callers that already track a correlation ID (e.g. from an upstream
+	// gateway) can pass it through; otherwise we mint one so this request's
+	// activity can still be traced end to end.
+	correlationID := c.GetHeader("X-Correlation-ID")
+	if correlationID == "" {
+		correlationID = ids.New()
+	}
+	c.Header("X-Correlation-ID", correlationID)
+
+	input := services.TransactionInput{
+		TenantID:              tenant.ID,
+		AccountID:             req.AccountID,
+		CounterpartyAccountID: req.CounterpartyAccountID,
+		Type:                  req.Type,
+		Amount:                req.Amount,
+		Currency:              req.Currency,
+		ClientIP:              c.ClientIP(),
+		Category:              req.Category,
+		Merchant:              req.Merchant,
+		IdempotencyKey:        c.GetHeader("Idempotency-Key"),
+		RequestID:             requestID,
+		CorrelationID:         correlationID,
+	}
+
+	actor, isAgentActor := c.Get(middleware.ActorContextKey)
+	if isAgentActor {
+		agent := actor.(*models.User)
+		input.PerformedByUserID = agent.ID
+		input.BranchID = agent.BranchID
+	} else if jwtActor, ok := c.Get(middleware.JWTUserContextKey); ok {
+		// A JWT-authenticated caller can only move money out of an
+		// account they own; agents are exempt, since acting on a
+		// customer's behalf is the whole point of the /agent channel.
+		jwtUser := jwtActor.(*models.User)
+		account, err := tc.accounts.FindByID(tenant.ID, req.AccountID)
+		if err != nil {
+			utils.Error(c, http.StatusNotFound, "account not found")
+			return
+		}
+		if account.UserID != jwtUser.ID {
+			utils.Error(c, http.StatusForbidden, "account does not belong to caller")
+			return
+		}
+		input.PerformedByUserID = jwtUser.ID
+	} else if userID := c.GetHeader("X-User-ID"); userID != "" {
+		// Self-service callers aren't role-gated, but if they identify
+		// themselves we still want a maker on record for dual control.
+		input.PerformedByUserID = userID
+	}
+
+	// Self-service transfers from an unseen device require a step-up OTP
+	// challenge before they're allowed to post. Agent/teller channels
+	// authenticate through RequireRole instead and are exempt.
+	if !isAgentActor && req.Type == models.TransactionTypeTransfer {
+		if respondedWithChallenge := tc.enforceDeviceStepUp(c, tenant.ID); respondedWithChallenge {
+			return
+		}
+	}
+
+	// High-value transfers and withdrawals require a signature over the
+	// canonical payload from a device with a registered key, giving
+	// non-repudiation beyond what the bearer X-User-ID header can prove.
+	if !isAgentActor && (req.Type == models.TransactionTypeTransfer || req.Type == models.TransactionTypeWithdrawal) {
+		if respondedWithError := tc.enforceSignature(c, tenant, input, req.Signature); respondedWithError {
+			return
+		}
+	}
+
+	// Self-service transactions at or above the tenant's step-up threshold
+	// are held behind an OTP challenge instead of posting immediately.
+	// Agent/teller channels are exempt, since they've already
+	// authenticated through RequireRole.
+	if !isAgentActor && tenant.StepUpThreshold > 0 && input.Amount >= tenant.StepUpThreshold {
+		if respondedWithChallenge := tc.enforceAmountStepUp(c, tenant.ID, input); respondedWithChallenge {
+			return
+		}
+	}
+
+	// Transactions at or above the tenant's approval threshold enter
+	// maker-checker review instead of posting immediately. Transfers that
+	// clear the threshold are still screened against the sanctions/
+	// blocklist before they post (see ApprovalService.post).
+	tx, hold, approval, err := tc.approvals.Submit(c.Request.Context(), input, tenant.ApprovalThreshold)
+	if err != nil {
+		if errors.Is(err, services.ErrInsufficientFunds) || errors.Is(err, services.ErrLimitExceeded) || errors.Is(err, services.ErrCurrencyMismatch) || errors.Is(err, services.ErrExposureCeilingExceeded) {
+			utils.Error(c, http.StatusUnprocessableEntity, err.Error())
+			return
+		}
+		if errors.Is(err, services.ErrRateLimitExceeded) {
+			utils.Error(c, http.StatusTooManyRequests, err.Error())
+			return
+		}
+		utils.Error(c, http.StatusInternalServerError, "failed to post transaction")
+		return
+	}
+	if approval != nil {
+		c.JSON(http.StatusAccepted, gin.H{
+			"error":          "transaction requires approval before it posts",
+			"approval_id":    approval.ID,
+			"transaction_id": requestID,
+		})
+		return
+	}
+	if hold != nil {
+		c.JSON(http.StatusAccepted, gin.H{
+			"error":          "transfer held for compliance review",
+			"hold_id":        hold.ID,
+			"transaction_id": requestID,
+		})
+		return
+	}
+
+	if tc.referrals != nil {
+		tc.referrals.OnQualifyingTransaction(c.Request.Context(), tenant.ID, tx.AccountID, tx.Type, tx.Amount)
+	}
+	if tc.rewards != nil {
+		tc.rewards.OnQualifyingTransaction(c.Request.Context(), tenant.ID, tx)
+	}
+
+	c.JSON(http.StatusCreated, tx)
+}
+
+type previewTransactionRequest struct {
+	AccountID             string                 `json:"account_id" binding:"required"`
+	CounterpartyAccountID string                 `json:"counterparty_account_id"`
+	Type                  models.TransactionType `json:"type" binding:"required"`
+	Amount                int64                  `json:"amount" binding:"required,gt=0"`
+	Currency              string                 `json:"currency"`
+}
+
+// Preview runs the same validation, limit checks, and fee calculation
+// Create would, without enqueuing anything, so a client can show the
+// customer the exact amount that would be debited or credited before
+// they confirm.
+func (tc *TransactionController) Preview(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req previewTransactionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	preview, err := tc.transactions.Preview(c.Request.Context(), services.TransactionInput{
+		TenantID:              tenant.ID,
+		AccountID:             req.AccountID,
+		CounterpartyAccountID: req.CounterpartyAccountID,
+		Type:                  req.Type,
+		Amount:                req.Amount,
+		Currency:              req.Currency,
+	})
+	if err != nil {
+		if errors.Is(err, services.ErrInsufficientFunds) || errors.Is(err, services.ErrLimitExceeded) || errors.Is(err, services.ErrCurrencyMismatch) || errors.Is(err, services.ErrExposureCeilingExceeded) {
+			utils.Error(c, http.StatusUnprocessableEntity, err.Error())
+			return
+		}
+		if errors.Is(err, services.ErrAccountFrozen) {
+			utils.Error(c, http.StatusForbidden, err.Error())
+			return
+		}
+		utils.Error(c, http.StatusInternalServerError, "failed to preview transaction")
+		return
+	}
+
+	c.JSON(http.StatusOK, preview)
+}
+
+type createBatchDepositRequest struct {
+	Deposits []struct {
+		AccountID string `json:"account_id" binding:"required"`
+		Amount    int64  `json:"amount" binding:"required,gt=0"`
+		Category  string `json:"category"`
+		Merchant  string `json:"merchant"`
+	} `json:"deposits" binding:"required,min=1"`
+}
+
+// CreateBatch posts a set of deposits for the calling tenant in a single
+// database transaction, for high-volume sources (e.g. a payroll run)
+// where the interactive per-request path in Create doesn't scale. See
+// services.TransactionService.PostBatch for what it deliberately skips.
+func (tc *TransactionController) CreateBatch(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req createBatchDepositRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	inputs := make([]services.TransactionInput, 0, len(req.Deposits))
+	for _, d := range req.Deposits {
+		inputs = append(inputs, services.TransactionInput{
+			TenantID:  tenant.ID,
+			AccountID: d.AccountID,
+			Type:      models.TransactionTypeDeposit,
+			Amount:    d.Amount,
+			Category:  d.Category,
+			Merchant:  d.Merchant,
+		})
+	}
+
+	txs, err := tc.transactions.PostBatch(c.Request.Context(), tenant.ID, inputs)
+	if err != nil {
+		if errors.Is(err, services.ErrBatchNotDepositOnly) || errors.Is(err, services.ErrBatchTenantMismatch) {
+			utils.Error(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		utils.Error(c, http.StatusInternalServerError, "failed to post deposit batch")
+		return
+	}
+
+	c.JSON(http.StatusCreated, txs)
+}
+
+// enforceSignature checks a signature over in's canonical payload against
+// the calling device's registered key, but only once in.Amount reaches
+// the tenant's SignatureThreshold; a tenant with the threshold disabled
+// (the default) never requires one.
+func (tc *TransactionController) enforceSignature(c *gin.Context, tenant *models.Tenant, in services.TransactionInput, signature string) bool {
+	if tenant.SignatureThreshold <= 0 || in.Amount < tenant.SignatureThreshold {
+		return false
+	}
+
+	userID := c.GetHeader("X-User-ID")
+	deviceID := c.GetHeader("X-Device-ID")
+	if userID == "" || deviceID == "" {
+		utils.Error(c, http.StatusBadRequest, "signature required: missing X-User-ID or X-Device-ID header")
+		return true
+	}
+	if signature == "" {
+		utils.Error(c, http.StatusBadRequest, "signature required for this transaction amount")
+		return true
+	}
+
+	if err := tc.deviceKeys.Verify(tenant.ID, userID, deviceID, services.CanonicalTransactionPayload(in), signature); err != nil {
+		utils.Error(c, http.StatusUnauthorized, err.Error())
+		return true
+	}
+	return false
+}
+
+// enforceDeviceStepUp checks the X-User-ID/X-Device-ID headers on a
+// self-service transfer against the caller's trusted-devices list. If both
+// headers are present and the device hasn't been seen before, it issues a
+// step-up challenge and writes a 428 response, returning true so the
+// caller stops processing the transaction. Requests missing either header
+// are let through unchecked, since device tracking is opt-in until every
+// client sends them.
+func (tc *TransactionController) enforceDeviceStepUp(c *gin.Context, tenantID string) bool {
+	userID := c.GetHeader("X-User-ID")
+	deviceID := c.GetHeader("X-Device-ID")
+	if userID == "" || deviceID == "" {
+		return false
+	}
+
+	user, err := tc.users.FindByID(tenantID, userID)
+	if err != nil {
+		return false
+	}
+
+	trusted, err := tc.devices.IsTrusted(tenantID, user.ID, deviceID)
+	if err != nil || trusted {
+		return false
+	}
+
+	challenge, err := tc.devices.Challenge(tenantID, user, deviceID, c.GetHeader("X-Device-Fingerprint"))
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to issue device challenge")
+		return true
+	}
+
+	c.JSON(http.StatusPreconditionRequired, gin.H{
+		"error":        "unrecognized device, verification required",
+		"challenge_id": challenge.ID,
+	})
+	return true
+}
+
+// enforceAmountStepUp queues in for posting behind an OTP challenge sent
+// to the caller's registered phone, once in.Amount has already been
+// confirmed to reach the tenant's StepUpThreshold. A request with no
+// identified user is let through unchecked, since there's no phone
+// number to challenge.
+func (tc *TransactionController) enforceAmountStepUp(c *gin.Context, tenantID string, in services.TransactionInput) bool {
+	if in.PerformedByUserID == "" {
+		return false
+	}
+
+	user, err := tc.users.FindByID(tenantID, in.PerformedByUserID)
+	if err != nil {
+		return false
+	}
+
+	challenge, err := tc.stepUp.Challenge(in, user.Phone)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to issue step up challenge")
+		return true
+	}
+
+	c.JSON(http.StatusPreconditionRequired, gin.H{
+		"error":        "transaction requires verification before it posts",
+		"challenge_id": challenge.ID,
+	})
+	return true
+}
+
+type confirmTransactionRequest struct {
+	ChallengeID string `json:"challenge_id" binding:"required"`
+	Code        string `json:"code" binding:"required"`
+}
+
+// Confirm verifies the OTP code for a step-up challenge raised by Create
+// and, on success, posts the transaction it was holding.
+func (tc *TransactionController) Confirm(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req confirmTransactionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	tx, err := tc.stepUp.Confirm(c.Request.Context(), tenant.ID, req.ChallengeID, req.Code)
+	if err != nil {
+		if errors.Is(err, services.ErrStepUpChallengeNotVerifiable) {
+			utils.Error(c, http.StatusNotFound, err.Error())
+			return
+		}
+		if errors.Is(err, services.ErrStepUpChallengeAttemptsExceeded) {
+			utils.Error(c, http.StatusTooManyRequests, err.Error())
+			return
+		}
+		if errors.Is(err, services.ErrStepUpCodeMismatch) {
+			utils.Error(c, http.StatusUnprocessableEntity, err.Error())
+			return
+		}
+		utils.Error(c, http.StatusInternalServerError, "failed to confirm transaction")
+		return
+	}
+
+	c.JSON(http.StatusCreated, tx)
+}
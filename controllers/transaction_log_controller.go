@@ -0,0 +1,166 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/middleware"
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// defaultSearchPageSize and maxSearchPageSize bound the page_size query
+// parameter Search accepts.
+const (
+	defaultSearchPageSize = 20
+	maxSearchPageSize     = 100
+)
+
+// TransactionLogController exposes platform-wide access to the raw
+// transaction log for warehousing and support tooling.
+type TransactionLogController struct {
+	logs     *repository.TransactionLogRepository
+	accounts *repository.AccountRepository
+}
+
+// NewTransactionLogController builds a TransactionLogController.
+func NewTransactionLogController(logs *repository.TransactionLogRepository, accounts *repository.AccountRepository) *TransactionLogController {
+	return &TransactionLogController{logs: logs, accounts: accounts}
+}
+
+// Export streams the transaction log matching the optional tenant_id,
+// account_id, type, from and to query filters as newline-delimited JSON,
+// one entry per line, flushing after each so a slow downstream loader
+// applies backpressure instead of the handler buffering the whole export
+// in memory.
+func (tc *TransactionLogController) Export(c *gin.Context) {
+	filter := repository.TransactionLogFilter{
+		TenantID:  c.Query("tenant_id"),
+		AccountID: c.Query("account_id"),
+		Type:      c.Query("type"),
+	}
+	if from := c.Query("from"); from != "" {
+		t, err := time.Parse(time.RFC3339, from)
+		if err != nil {
+			utils.Error(c, http.StatusBadRequest, "from must be an RFC3339 timestamp")
+			return
+		}
+		filter.From = &t
+	}
+	if to := c.Query("to"); to != "" {
+		t, err := time.Parse(time.RFC3339, to)
+		if err != nil {
+			utils.Error(c, http.StatusBadRequest, "to must be an RFC3339 timestamp")
+			return
+		}
+		filter.To = &t
+	}
+
+	ctx := c.Request.Context()
+	cursor, err := tc.logs.Export(ctx, filter)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to export transaction log")
+		return
+	}
+	defer cursor.Close(ctx)
+
+	c.Header("Content-Type", "application/x-ndjson")
+	c.Status(http.StatusOK)
+
+	encoder := json.NewEncoder(c.Writer)
+	flusher, canFlush := c.Writer.(http.Flusher)
+	for cursor.Next(ctx) {
+		var entry models.TransactionLog
+		if err := cursor.Decode(&entry); err != nil {
+			return
+		}
+		if err := encoder.Encode(&entry); err != nil {
+			return
+		}
+		if canFlush {
+			flusher.Flush()
+		}
+	}
+}
+
+// History returns an account's transaction log, most recent first,
+// including each entry's direction and counterparty details. If the
+// caller authenticated with a JWT (see middleware.JWTAuth), the
+// account must belong to them.
+func (tc *TransactionLogController) History(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+	accountID := c.Param("id")
+
+	if jwtActor, ok := c.Get(middleware.JWTUserContextKey); ok {
+		account, err := tc.accounts.FindByID(tenant.ID, accountID)
+		if err != nil {
+			utils.Error(c, http.StatusNotFound, "account not found")
+			return
+		}
+		if account.UserID != jwtActor.(*models.User).ID {
+			utils.Error(c, http.StatusForbidden, "account does not belong to caller")
+			return
+		}
+	}
+
+	entries, err := tc.logs.ListByAccount(c.Request.Context(), tenant.ID, accountID)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to load transaction history")
+		return
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].CreatedAt.After(entries[j].CreatedAt)
+	})
+
+	c.JSON(http.StatusOK, entries)
+}
+
+// Search runs a full-text query over an account's transaction log, using
+// the ?q= query parameter, and returns matches ranked by relevance, most
+// relevant first, paginated by ?page (1-indexed, default 1) and
+// ?page_size (default 20, at most 100; a larger value is rejected with
+// 400).
+func (tc *TransactionLogController) Search(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	query := c.Query("q")
+	if query == "" {
+		utils.Error(c, http.StatusBadRequest, "q query parameter is required")
+		return
+	}
+
+	page := 1
+	if raw := c.Query("page"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed <= 0 {
+			utils.Error(c, http.StatusBadRequest, "page must be a positive integer")
+			return
+		}
+		page = parsed
+	}
+
+	pageSize := defaultSearchPageSize
+	if raw := c.Query("page_size"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed <= 0 || parsed > maxSearchPageSize {
+			utils.Error(c, http.StatusBadRequest, "page_size must be a positive integer no greater than 100")
+			return
+		}
+		pageSize = parsed
+	}
+
+	results, err := tc.logs.Search(c.Request.Context(), tenant.ID, c.Param("id"), query, page, pageSize)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "search failed")
+		return
+	}
+
+	c.JSON(http.StatusOK, results)
+}
@@ -0,0 +1,86 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// TransferQuoteController prices cross-currency external transfers and
+// executes them at the quoted rate and fee.
+type TransferQuoteController struct {
+	quotes *services.TransferQuoteService
+}
+
+// NewTransferQuoteController builds a TransferQuoteController.
+func NewTransferQuoteController(quotes *services.TransferQuoteService) *TransferQuoteController {
+	return &TransferQuoteController{quotes: quotes}
+}
+
+type createTransferQuoteRequest struct {
+	AccountID                 string `json:"account_id" binding:"required"`
+	CounterpartyName          string `json:"counterparty_name" binding:"required"`
+	CounterpartyRoutingNumber string `json:"counterparty_routing_number" binding:"required"`
+	CounterpartyAccountNumber string `json:"counterparty_account_number" binding:"required"`
+	Amount                    int64  `json:"amount" binding:"required,gt=0"`
+	TargetCurrency            string `json:"target_currency" binding:"required"`
+}
+
+// Quote prices a cross-currency transfer and returns a quote_id the
+// client submits to Execute; the quoted rate and fee hold until the
+// quote expires.
+func (tc *TransferQuoteController) Quote(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req createTransferQuoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	quote, err := tc.quotes.Quote(tenant.ID, req.AccountID, req.CounterpartyName, req.CounterpartyRoutingNumber, req.CounterpartyAccountNumber, req.Amount, req.TargetCurrency)
+	if err != nil {
+		if errors.Is(err, services.ErrFXRateNotConfigured) || errors.Is(err, services.ErrInsufficientFunds) {
+			utils.Error(c, http.StatusUnprocessableEntity, err.Error())
+			return
+		}
+		utils.Error(c, http.StatusInternalServerError, "failed to price transfer quote")
+		return
+	}
+
+	c.JSON(http.StatusCreated, quote)
+}
+
+// Execute submits the external transfer priced by a previously issued
+// quote, honoring its rate and fee exactly or failing with a re-quote
+// error if it has since expired.
+func (tc *TransferQuoteController) Execute(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	transfer, err := tc.quotes.Execute(c.Request.Context(), tenant.ID, c.Param("id"))
+	if err != nil {
+		tc.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, transfer)
+}
+
+func (tc *TransferQuoteController) handleError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, services.ErrTransferQuoteNotFound):
+		utils.Error(c, http.StatusNotFound, err.Error())
+	case errors.Is(err, services.ErrTransferQuoteNotPending):
+		utils.Error(c, http.StatusConflict, err.Error())
+	case errors.Is(err, services.ErrTransferQuoteExpired):
+		utils.Error(c, http.StatusUnprocessableEntity, err.Error())
+	case errors.Is(err, services.ErrInsufficientFunds):
+		utils.Error(c, http.StatusUnprocessableEntity, err.Error())
+	default:
+		utils.Error(c, http.StatusInternalServerError, "failed to execute transfer quote")
+	}
+}
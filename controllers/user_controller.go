@@ -0,0 +1,107 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/repository"
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// UserController exposes tenant-scoped user management endpoints.
+type UserController struct {
+	users     *repository.UserRepository
+	referrals *services.ReferralService
+}
+
+// NewUserController builds a UserController.
+func NewUserController(users *repository.UserRepository, referrals *services.ReferralService) *UserController {
+	return &UserController{users: users, referrals: referrals}
+}
+
+type createUserRequest struct {
+	Name         string `json:"name" binding:"required"`
+	Email        string `json:"email" binding:"required,email"`
+	Phone        string `json:"phone"`
+	ReferralCode string `json:"referral_code"`
+	// PIN, if set, is stored as the new user's credential so they can
+	// authenticate via POST /auth/login right away. Omitted, a user has
+	// no PIN until they go through the forgot-PIN flow or an admin sets
+	// one via PINService.
+	PIN string `json:"pin"`
+}
+
+// Create registers a new user under the calling tenant. If ReferralCode
+// matches an existing user, the signup is attributed to them.
+func (uc *UserController) Create(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req createUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	user, err := uc.referrals.Signup(tenant.ID, req.Name, req.Email, req.Phone, req.ReferralCode, req.PIN)
+	if err != nil {
+		if errors.Is(err, services.ErrDuplicateEmail) {
+			utils.Error(c, http.StatusConflict, err.Error())
+			return
+		}
+		utils.Error(c, http.StatusInternalServerError, "failed to create user")
+		return
+	}
+
+	c.JSON(http.StatusCreated, user)
+}
+
+// Get fetches a single user belonging to the calling tenant.
+func (uc *UserController) Get(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	user, err := uc.users.FindByID(tenant.ID, c.Param("id"))
+	if err != nil {
+		utils.Error(c, http.StatusNotFound, "user not found")
+		return
+	}
+
+	c.JSON(http.StatusOK, user)
+}
+
+// encryptPhonesBatchSize bounds how many rows EncryptPhones migrates per
+// call, so a tenant with a very large user table can't tie up the
+// request for an unbounded amount of time; the caller re-invokes the
+// endpoint until migrated is 0.
+const encryptPhonesBatchSize = 500
+
+// EncryptPhones runs one batch of the PhoneCodec migration path for
+// users whose phone number predates PIIEncryptionKey being configured,
+// encrypting and hashing up to encryptPhonesBatchSize of them. Call it
+// repeatedly until it reports migrated: 0.
+func (uc *UserController) EncryptPhones(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	migrated, err := uc.users.EncryptExistingPhones(tenant.ID, encryptPhonesBatchSize)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to migrate phone encryption")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"migrated": migrated})
+}
+
+// List returns every user belonging to the calling tenant.
+func (uc *UserController) List(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	users, err := uc.users.List(tenant.ID)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to list users")
+		return
+	}
+
+	c.JSON(http.StatusOK, users)
+}
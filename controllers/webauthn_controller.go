@@ -0,0 +1,140 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/middleware"
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// WebAuthnController drives passkey registration for an already
+// logged-in customer, and passwordless login for one who registered a
+// passkey previously.
+type WebAuthnController struct {
+	webauthn *services.WebAuthnService
+}
+
+// NewWebAuthnController builds a WebAuthnController.
+func NewWebAuthnController(webauthn *services.WebAuthnService) *WebAuthnController {
+	return &WebAuthnController{webauthn: webauthn}
+}
+
+// BeginRegistration issues a challenge for the calling JWT-authenticated
+// user's authenticator to sign, the first half of registering a new
+// passkey.
+func (wc *WebAuthnController) BeginRegistration(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	jwtActor, ok := c.Get(middleware.JWTUserContextKey)
+	if !ok {
+		utils.Error(c, http.StatusUnauthorized, "passkey registration requires a signed-in session")
+		return
+	}
+	user := jwtActor.(*models.User)
+
+	challenge, err := wc.webauthn.BeginRegistration(tenant.ID, user)
+	if err != nil {
+		utils.Error(c, http.StatusInternalServerError, "failed to start passkey registration")
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"id": challenge.ID, "challenge": challenge.Challenge, "expires_at": challenge.ExpiresAt})
+}
+
+type finishWebAuthnRegistrationRequest struct {
+	Name      string `json:"name" binding:"required"`
+	PublicKey string `json:"public_key" binding:"required"`
+	Signature string `json:"signature" binding:"required"`
+}
+
+// FinishRegistration verifies the signed challenge and stores the
+// submitted public key as a new named passkey.
+func (wc *WebAuthnController) FinishRegistration(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req finishWebAuthnRegistrationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	credential, err := wc.webauthn.FinishRegistration(tenant.ID, c.Param("id"), req.Name, req.PublicKey, req.Signature)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrWebAuthnChallengeNotVerifiable), errors.Is(err, services.ErrWebAuthnSignatureMismatch):
+			utils.Error(c, http.StatusUnprocessableEntity, err.Error())
+			return
+		default:
+			utils.Error(c, http.StatusInternalServerError, "failed to finish passkey registration")
+			return
+		}
+	}
+
+	c.JSON(http.StatusCreated, credential)
+}
+
+type beginWebAuthnAssertionRequest struct {
+	Email string `json:"email" binding:"required"`
+}
+
+// BeginAssertion issues a challenge for one of email's registered
+// passkeys to sign, the first half of passkey login.
+func (wc *WebAuthnController) BeginAssertion(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req beginWebAuthnAssertionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	challenge, err := wc.webauthn.BeginAssertion(tenant.ID, req.Email)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrWebAuthnCredentialNotFound), errors.Is(err, services.ErrNoWebAuthnCredentials):
+			utils.Error(c, http.StatusNotFound, err.Error())
+			return
+		default:
+			utils.Error(c, http.StatusInternalServerError, "failed to start passkey login")
+			return
+		}
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"id": challenge.ID, "challenge": challenge.Challenge, "expires_at": challenge.ExpiresAt})
+}
+
+type finishWebAuthnAssertionRequest struct {
+	CredentialID string `json:"credential_id" binding:"required"`
+	Signature    string `json:"signature" binding:"required"`
+}
+
+// FinishAssertion completes a pending passkey login, returning a freshly
+// issued API token in exchange for a valid signature over the challenge.
+func (wc *WebAuthnController) FinishAssertion(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req finishWebAuthnAssertionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	token, plaintext, err := wc.webauthn.FinishAssertion(tenant.ID, c.Param("id"), req.CredentialID, req.Signature)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrWebAuthnChallengeNotVerifiable), errors.Is(err, services.ErrWebAuthnSignatureMismatch), errors.Is(err, services.ErrWebAuthnCredentialNotFound):
+			utils.Error(c, http.StatusUnprocessableEntity, err.Error())
+			return
+		default:
+			utils.Error(c, http.StatusInternalServerError, "failed to finish passkey login")
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{"token": token, "plaintext": plaintext})
+}
@@ -0,0 +1,54 @@
+package controllers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// WebhookController receives inbound webhooks from external
+// account-linking aggregators and payment processors.
+type WebhookController struct {
+	webhooks *services.WebhookService
+}
+
+// NewWebhookController builds a WebhookController.
+func NewWebhookController(webhooks *services.WebhookService) *WebhookController {
+	return &WebhookController{webhooks: webhooks}
+}
+
+// Receive verifies and processes a single webhook delivery. The provider
+// signs the raw request body, so it's read and passed through unparsed
+// rather than via ShouldBindJSON.
+func (wc *WebhookController) Receive(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+	provider := c.Param("provider")
+	signature := c.GetHeader("X-Webhook-Signature")
+
+	payload, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		utils.Error(c, http.StatusBadRequest, "failed to read webhook body")
+		return
+	}
+
+	if err := wc.webhooks.Receive(c.Request.Context(), tenant.ID, provider, signature, payload); err != nil {
+		switch {
+		case errors.Is(err, services.ErrWebhookProviderNotFound):
+			utils.Error(c, http.StatusNotFound, err.Error())
+		case errors.Is(err, services.ErrWebhookSignatureInvalid):
+			utils.Error(c, http.StatusUnauthorized, err.Error())
+		case errors.Is(err, services.ErrUnsupportedWebhookEvent):
+			utils.Error(c, http.StatusUnprocessableEntity, err.Error())
+		default:
+			utils.Error(c, http.StatusInternalServerError, "failed to process webhook")
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"received": true})
+}
@@ -0,0 +1,77 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/services"
+	"github.com/divzzrk/go_bank_api/utils"
+)
+
+// WithdrawalCodeController exposes cardless ATM withdrawal code endpoints.
+type WithdrawalCodeController struct {
+	codes *services.WithdrawalCodeService
+}
+
+// NewWithdrawalCodeController builds a WithdrawalCodeController.
+func NewWithdrawalCodeController(codes *services.WithdrawalCodeService) *WithdrawalCodeController {
+	return &WithdrawalCodeController{codes: codes}
+}
+
+type generateWithdrawalCodeRequest struct {
+	AccountID string `json:"account_id" binding:"required"`
+	Amount    int64  `json:"amount" binding:"required,gt=0"`
+}
+
+// Generate places a hold and issues a one-time withdrawal code.
+func (wc *WithdrawalCodeController) Generate(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req generateWithdrawalCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	code, err := wc.codes.Generate(tenant.ID, req.AccountID, req.Amount)
+	if err != nil {
+		if errors.Is(err, services.ErrInsufficientFunds) {
+			utils.Error(c, http.StatusUnprocessableEntity, err.Error())
+			return
+		}
+		utils.Error(c, http.StatusInternalServerError, "failed to generate withdrawal code")
+		return
+	}
+
+	c.JSON(http.StatusCreated, code)
+}
+
+type redeemWithdrawalCodeRequest struct {
+	Code string `json:"code" binding:"required"`
+}
+
+// Redeem is called by ATM/agent systems to convert a code's hold into a
+// posted withdrawal.
+func (wc *WithdrawalCodeController) Redeem(c *gin.Context) {
+	tenant := utils.TenantFromContext(c)
+
+	var req redeemWithdrawalCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		utils.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	tx, err := wc.codes.Redeem(c.Request.Context(), tenant.ID, req.Code)
+	if err != nil {
+		if errors.Is(err, services.ErrCodeNotRedeemable) {
+			utils.Error(c, http.StatusUnprocessableEntity, err.Error())
+			return
+		}
+		utils.Error(c, http.StatusInternalServerError, "failed to redeem withdrawal code")
+		return
+	}
+
+	c.JSON(http.StatusOK, tx)
+}
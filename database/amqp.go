@@ -0,0 +1,19 @@
+package database
+
+import amqp "github.com/rabbitmq/amqp091-go"
+
+// NewAMQPChannel connects to RabbitMQ and returns the channel used to
+// publish domain events.
+func NewAMQPChannel(url string) (*amqp.Channel, error) {
+	conn, err := amqp.Dial(url)
+	if err != nil {
+		return nil, err
+	}
+
+	channel, err := conn.Channel()
+	if err != nil {
+		return nil, err
+	}
+
+	return channel, nil
+}
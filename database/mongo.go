@@ -0,0 +1,47 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// NewMongo connects to MongoDB and returns the database handle used for the
+// transaction log and other append-heavy collections.
+func NewMongo(uri, database string) (*mongo.Database, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(uri))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.Ping(ctx, nil); err != nil {
+		return nil, err
+	}
+
+	return client.Database(database), nil
+}
+
+// EnsureTransactionLogTextIndex creates the text index the transaction
+// log's full-text search endpoint relies on, over the fields that stand
+// in for a free-text description, counterparty name, and reference
+// number: merchant, reason_code, and correlation_id. It's idempotent, so
+// it's safe to call on every startup.
+func EnsureTransactionLogTextIndex(db *mongo.Database) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := db.Collection("transaction_logs").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "merchant", Value: "text"},
+			{Key: "reason_code", Value: "text"},
+			{Key: "correlation_id", Value: "text"},
+		},
+	})
+	return err
+}
@@ -0,0 +1,93 @@
+// Package database wires up the Postgres (via GORM) and MongoDB connections
+// used throughout the API.
+package database
+
+import (
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// NewPostgres opens a GORM connection to Postgres and runs auto-migrations
+// for every model owned by the core domain.
+func NewPostgres(dsn string) (*gorm.DB, error) {
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.AutoMigrate(
+		&models.Tenant{},
+		&models.User{},
+		&models.Account{},
+		&models.Limit{},
+		&models.Branch{},
+		&models.Transaction{},
+		&models.TellerSession{},
+		&models.WithdrawalCode{},
+		&models.ChequeDeposit{},
+		&models.TopUp{},
+		&models.FraudReview{},
+		&models.TrustedDevice{},
+		&models.DeviceChallenge{},
+		&models.BlocklistEntry{},
+		&models.ComplianceHold{},
+		&models.PendingApproval{},
+		&models.AuditLog{},
+		&models.Product{},
+		&models.Referral{},
+		&models.RewardRule{},
+		&models.PointsAccount{},
+		&models.PointsLedgerEntry{},
+		&models.DeadLetter{},
+		&models.ProcessedTransaction{},
+		&models.BalanceMismatch{},
+		&models.StatementEmailRequest{},
+		&models.ImpersonationSession{},
+		&models.APIToken{},
+		&models.IPAllowlistEntry{},
+		&models.SecurityEvent{},
+		&models.Session{},
+		&models.PINResetRequest{},
+		&models.AccountFreeze{},
+		&models.AccountRecoveryRequest{},
+		&models.DeviceKey{},
+		&models.CardAuthorization{},
+	); err != nil {
+		return nil, err
+	}
+
+	return db, nil
+}
+
+// EnsureBalanceChangeTrigger installs a Postgres trigger that emits a
+// pg_notify on the balance_changes channel whenever an account's balance
+// changes, so services.BalanceChangeListener can turn it into a
+// balance.changed event without any application code sitting in the
+// transaction's write path.
+func EnsureBalanceChangeTrigger(db *gorm.DB) error {
+	if err := db.Exec(`
+		CREATE OR REPLACE FUNCTION notify_balance_change() RETURNS trigger AS $$
+		BEGIN
+			PERFORM pg_notify('balance_changes', json_build_object(
+				'tenant_id', NEW.tenant_id,
+				'account_id', NEW.id,
+				'balance', NEW.balance
+			)::text);
+			RETURN NEW;
+		END;
+		$$ LANGUAGE plpgsql;
+	`).Error; err != nil {
+		return err
+	}
+
+	return db.Exec(`
+		DROP TRIGGER IF EXISTS accounts_balance_change ON accounts;
+		CREATE TRIGGER accounts_balance_change
+			AFTER UPDATE OF balance ON accounts
+			FOR EACH ROW
+			WHEN (NEW.balance IS DISTINCT FROM OLD.balance)
+			EXECUTE FUNCTION notify_balance_change();
+	`).Error
+}
@@ -0,0 +1,23 @@
+// Package ids is the single place every layer generates record
+// identifiers from, so that changing the scheme (as when this package
+// moved from random UUIDv4 to time-ordered UUIDv7) doesn't mean hunting
+// down every call site.
+package ids
+
+import "github.com/google/uuid"
+
+// New returns a new time-ordered UUIDv7 identifier. UUIDv7 embeds a
+// millisecond timestamp ahead of its random bits, so identifiers sort
+// roughly by creation time (friendlier to database indexes than the
+// fully random UUIDv4 this replaced) while remaining unguessable.
+//
+// NewV7 only errors if the system's entropy source fails, which isn't
+// recoverable at the call site either; on that rare failure New falls
+// back to a random UUIDv4 rather than panicking.
+func New() string {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return uuid.NewString()
+	}
+	return id.String()
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/divzzrk/go_bank_api/config"
+	"github.com/divzzrk/go_bank_api/database"
+	"github.com/divzzrk/go_bank_api/logging"
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+	"github.com/divzzrk/go_bank_api/routes"
+	"github.com/divzzrk/go_bank_api/services"
+)
+
+func main() {
+	cfg := config.Load()
+
+	log.SetOutput(logging.NewRedactingWriter(os.Stdout, logging.Masks{
+		Phones:     cfg.LogMaskPhones,
+		AccountIDs: cfg.LogMaskAccountIDs,
+		Balances:   cfg.LogMaskBalances,
+	}))
+
+	db, err := database.NewPostgres(cfg.PostgresDSN)
+	if err != nil {
+		log.Fatalf("failed to connect to postgres: %v", err)
+	}
+
+	mongodb, err := database.NewMongo(cfg.MongoURI, cfg.MongoDatabase)
+	if err != nil {
+		log.Fatalf("failed to connect to mongo: %v", err)
+	}
+
+	// The text index is a nice-to-have for the transaction search
+	// endpoint, not core to posting transactions, so a Mongo that can't
+	// build it degrades to that endpoint returning no results instead of
+	// blocking startup.
+	if err := database.EnsureTransactionLogTextIndex(mongodb); err != nil {
+		log.Printf("failed to create transaction log text index, full-text search will not work: %v", err)
+	}
+
+	// Envelope encryption is opt-in: without a configured key, events
+	// publish as plain JSON exactly as before.
+	var kms services.KMSProvider
+	if cfg.QueueEncryptionKey != "" {
+		kms = services.NewLocalKMSProvider([]byte(cfg.QueueEncryptionKey))
+	}
+
+	// The event fanout is a nice-to-have for notifications/webhooks/
+	// analytics, not core to posting transactions, so a broker that isn't
+	// reachable degrades to logging events instead of blocking startup.
+	var events services.EventPublisher = services.NoopEventPublisher{}
+	if channel, err := database.NewAMQPChannel(cfg.RabbitMQURL); err != nil {
+		log.Printf("failed to connect to rabbitmq, falling back to noop event publisher: %v", err)
+	} else if publisher, err := services.NewAMQPEventPublisher(channel, kms, cfg.EventsQueueName, services.TransactionQueues{
+		models.TransactionTypeDeposit:    cfg.DepositQueueName,
+		models.TransactionTypeWithdrawal: cfg.WithdrawalQueueName,
+		models.TransactionTypeTransfer:   cfg.TransferQueueName,
+	}, cfg.TransactionQueueQuorum); err != nil {
+		log.Printf("failed to declare transaction events exchange, falling back to noop event publisher: %v", err)
+	} else {
+		events = publisher
+	}
+
+	// The balance-change listener is a nice-to-have for cache invalidation
+	// and real-time streams, not core to posting transactions, so a
+	// Postgres that doesn't support it (or a trigger that can't be
+	// installed) degrades to no real-time balance.changed events instead
+	// of blocking startup.
+	lowBalanceAlertService := services.NewLowBalanceAlertService(repository.NewLowBalanceAlertRepository(db), repository.NewAccountRepository(db), services.NoopNotificationProvider{}, events)
+
+	if err := database.EnsureBalanceChangeTrigger(db); err != nil {
+		log.Printf("failed to install balance-change notify trigger, balance.changed events will not be published: %v", err)
+	} else if listener, err := services.NewBalanceChangeListener(context.Background(), cfg.PostgresDSN, events, lowBalanceAlertService); err != nil {
+		log.Printf("failed to start balance-change listener, falling back to no real-time balance.changed events: %v", err)
+	} else {
+		go listener.Run(context.Background())
+	}
+
+	router := routes.Setup(cfg, db, mongodb, events)
+
+	if err := router.Run(":" + cfg.Port); err != nil {
+		log.Fatalf("server exited: %v", err)
+	}
+}
@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// TokenContextKey is the gin context key the resolved APIToken is stored
+// under by APITokenAuth, when a request authenticates with one.
+const TokenContextKey = "api_token"
+
+// APITokenAuth resolves an Authorization: Bearer <token> header against
+// issued APITokens. Requests without the header are left untouched, since
+// API tokens are an additional credential alongside the interim
+// X-User-ID header, not a replacement for it. Enforcing what a token's
+// scopes actually permit is RequireScope's job, applied per route.
+func APITokenAuth(tokens *repository.APITokenRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := c.GetHeader("Authorization")
+		if header == "" {
+			c.Next()
+			return
+		}
+
+		plaintext, ok := strings.CutPrefix(header, "Bearer ")
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "malformed Authorization header"})
+			return
+		}
+
+		sum := sha256.Sum256([]byte(plaintext))
+		token, err := tokens.FindByHash(hex.EncodeToString(sum[:]))
+		if err != nil || token.RevokedAt != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid api token"})
+			return
+		}
+
+		c.Set(TokenContextKey, token)
+		c.Next()
+	}
+}
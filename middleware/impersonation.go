@@ -0,0 +1,40 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/services"
+)
+
+// ImpersonationContextKey is the gin context key the active
+// ImpersonationSession is stored under by ImpersonationRequired.
+const ImpersonationContextKey = "impersonation_session"
+
+// ImpersonationRequired resolves an active impersonation session from the
+// X-Impersonation-Session-ID header, scoped to the tenant and the admin
+// already resolved by TenantResolver and RequireRole, and rejects the
+// request if none is active. It must run after RequireRole.
+func ImpersonationRequired(impersonation *services.ImpersonationService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		tenant := c.MustGet(TenantContextKey).(*models.Tenant)
+		admin := c.MustGet(ActorContextKey).(*models.User)
+
+		sessionID := c.GetHeader("X-Impersonation-Session-ID")
+		if sessionID == "" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "missing X-Impersonation-Session-ID header"})
+			return
+		}
+
+		session, err := impersonation.Validate(tenant.ID, sessionID, admin.ID)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "impersonation session is not active"})
+			return
+		}
+
+		c.Set(ImpersonationContextKey, session)
+		c.Next()
+	}
+}
@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	"net"
+	"net/http"
+
+	"github.com/divzzrk/go_bank_api/ids"
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// IPAllowlist rejects requests from outside the calling tenant's
+// registered CIDR ranges, recording a SecurityEvent for every rejection.
+// A tenant with no ranges registered is not restricted, since allowlisting
+// is opt-in. Must run after TenantResolver.
+func IPAllowlist(allowlist *repository.IPAllowlistRepository, securityEvents *repository.SecurityEventRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		tenant := c.MustGet(TenantContextKey).(*models.Tenant)
+
+		entries, err := allowlist.ListByTenant(tenant.ID)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "failed to check ip allowlist"})
+			return
+		}
+		if len(entries) == 0 {
+			c.Next()
+			return
+		}
+
+		clientIP := net.ParseIP(c.ClientIP())
+		for _, entry := range entries {
+			_, cidr, err := net.ParseCIDR(entry.CIDR)
+			if err != nil {
+				continue
+			}
+			if clientIP != nil && cidr.Contains(clientIP) {
+				c.Next()
+				return
+			}
+		}
+
+		_ = securityEvents.Create(&models.SecurityEvent{
+			ID:        ids.New(),
+			TenantID:  tenant.ID,
+			Type:      models.SecurityEventIPBlocked,
+			IPAddress: c.ClientIP(),
+			Detail:    "request rejected: source ip outside registered allowlist",
+		})
+
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "source ip not allowed"})
+	}
+}
@@ -0,0 +1,169 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequestTimeout replaces the request's context with one carrying
+// duration as a deadline, so downstream code selecting on ctx.Done()
+// (Mongo queries, outbound webhook delivery) unwinds promptly instead of
+// running on after the client has given up, and aborts with 408 if the
+// handler chain is still running once it fires.
+//
+// The handler chain runs in this same goroutine, not a spawned one: gin's
+// Recovery middleware only guards the goroutine that calls c.Next(), and
+// a bystander goroutine racing the deadline would keep mutating the
+// shared *gin.Context (headers, body, writer) after this middleware had
+// already written the timeout response and returned. Slow call sites are
+// expected to check ctx.Done() themselves and return promptly; this
+// middleware can only report that they didn't, not stop them.
+func RequestTimeout(duration time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, cancel := context.WithTimeout(c.Request.Context(), duration)
+		defer cancel()
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+
+		if ctx.Err() == context.DeadlineExceeded && !c.Writer.Written() {
+			c.AbortWithStatusJSON(http.StatusRequestTimeout, gin.H{"error": "request timed out"})
+		}
+	}
+}
+
+// MaxBodyBytes rejects a request whose body exceeds limit with 413,
+// reading at most limit+1 bytes so an oversized body can't be used to
+// exhaust memory before it's rejected.
+func MaxBodyBytes(limit int64) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Body == nil {
+			c.Next()
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(c.Request.Body, limit+1))
+		c.Request.Body.Close()
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "failed to read request body"})
+			return
+		}
+		if int64(len(body)) > limit {
+			c.AbortWithStatusJSON(http.StatusRequestEntityTooLarge, gin.H{"error": "request body exceeds maximum allowed size"})
+			return
+		}
+
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+		c.Next()
+	}
+}
+
+// ipRateLimitWindow is the fixed window RateLimitPerMinute counts
+// requests over.
+const ipRateLimitWindow = time.Minute
+
+// RateLimitPerMinute rejects a client IP's requests with 429 once it has
+// made more than limit within the current one-minute window, for an
+// unauthenticated endpoint with no tenant or account to key a DB-backed
+// limit off of (compare TransactionService.checkRateLimit, which counts
+// against the account's own transactions instead). Windows are tracked
+// in memory, so a restart resets every client's count; that's an
+// acceptable tradeoff for an endpoint this low-stakes.
+func RateLimitPerMinute(limit int) gin.HandlerFunc {
+	limiter := newWindowRateLimiter(ipRateLimitWindow)
+
+	return func(c *gin.Context) {
+		if retryAfter, exceeded := limiter.hit(c.ClientIP(), limit); exceeded {
+			abortRateLimited(c, retryAfter)
+			return
+		}
+		c.Next()
+	}
+}
+
+// rateLimitAccountRequest peeks the account_id field a POST /transactions
+// body carries, without otherwise interpreting it, so RateLimitTransactions
+// can key its limit off the account before the controller binds the body.
+type rateLimitAccountRequest struct {
+	AccountID string `json:"account_id"`
+}
+
+// RateLimitTransactions rejects a client with 429 once it has made more
+// than limit requests against POST /transactions within the current
+// one-minute window, keyed by the account_id and client IP pair. Each
+// pair has its own count, so one account submitting from many IPs, or
+// many accounts sharing one IP, is counted per pair and not as a whole.
+// It sits in front of
+// TransactionService.checkRateLimit, which throttles a given account's
+// actual posted transactions once parsed; this middleware throttles the
+// request itself before that, including malformed ones. account_id is
+// read from the body without consuming it, so downstream binding in
+// TransactionController.Create still sees the full request.
+func RateLimitTransactions(limit int) gin.HandlerFunc {
+	limiter := newWindowRateLimiter(ipRateLimitWindow)
+
+	return func(c *gin.Context) {
+		accountID := ""
+		if c.Request.Body != nil {
+			body, err := io.ReadAll(c.Request.Body)
+			c.Request.Body.Close()
+			if err == nil {
+				c.Request.Body = io.NopCloser(bytes.NewReader(body))
+				var req rateLimitAccountRequest
+				if json.Unmarshal(body, &req) == nil {
+					accountID = req.AccountID
+				}
+			}
+		}
+
+		key := accountID + "|" + c.ClientIP()
+		if retryAfter, exceeded := limiter.hit(key, limit); exceeded {
+			abortRateLimited(c, retryAfter)
+			return
+		}
+		c.Next()
+	}
+}
+
+func abortRateLimited(c *gin.Context, retryAfter time.Duration) {
+	c.Header("Retry-After", strconv.Itoa(int(retryAfter.Seconds())))
+	c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{"error": "rate limit exceeded"})
+}
+
+// windowRateLimiter counts hits per key within a fixed, rolling window,
+// tracked in memory: a restart resets every key's count, an acceptable
+// tradeoff for the endpoints this backs.
+type windowRateLimiter struct {
+	mu          sync.Mutex
+	window      time.Duration
+	windowStart time.Time
+	counts      map[string]int
+}
+
+func newWindowRateLimiter(window time.Duration) *windowRateLimiter {
+	return &windowRateLimiter{window: window, windowStart: time.Now(), counts: make(map[string]int)}
+}
+
+// hit records one request against key and reports whether it exceeded
+// limit, along with how long until the current window resets.
+func (l *windowRateLimiter) hit(key string, limit int) (retryAfter time.Duration, exceeded bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	elapsed := time.Since(l.windowStart)
+	if elapsed > l.window {
+		l.windowStart = time.Now()
+		l.counts = make(map[string]int)
+		elapsed = 0
+	}
+	l.counts[key]++
+	return l.window - elapsed, l.counts[key] > limit
+}
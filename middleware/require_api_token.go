@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequireAPIToken rejects any request that didn't authenticate with an
+// APIToken (see APITokenAuth). Unlike RequireScope, which lets the
+// interim X-User-ID header through unchecked, some surfaces — like Open
+// Banking access on behalf of a third party — must never fall back to
+// that header, since there's no third party to attribute it to.
+func RequireAPIToken() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, ok := c.Get(TokenContextKey); !ok {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "api token required"})
+			return
+		}
+		c.Next()
+	}
+}
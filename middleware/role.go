@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// ActorContextKey is the gin context key the authenticated user is stored
+// under by RequireRole.
+const ActorContextKey = "actor"
+
+// RequireRole resolves the calling user from the X-User-ID header, scoped
+// to the tenant already resolved by TenantResolver, and rejects the
+// request unless the user has one of the allowed roles. This is an interim
+// mechanism until token-based authentication is in place.
+func RequireRole(users *repository.UserRepository, allowed ...models.Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		tenant := c.MustGet(TenantContextKey).(*models.Tenant)
+
+		userID := c.GetHeader("X-User-ID")
+		if userID == "" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "missing X-User-ID header"})
+			return
+		}
+
+		user, err := users.FindByID(tenant.ID, userID)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "unknown user"})
+			return
+		}
+
+		for _, role := range allowed {
+			if user.Role == role {
+				c.Set(ActorContextKey, user)
+				c.Next()
+				return
+			}
+		}
+
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "role not permitted"})
+	}
+}
@@ -0,0 +1,33 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// RequireScope rejects the request if it authenticated with an APIToken
+// (see APITokenAuth) that doesn't grant at least one of scopes. Requests
+// authenticated the interim way, via X-User-ID, carry no token and so
+// aren't scope-limited by this check.
+func RequireScope(scopes ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		raw, ok := c.Get(TokenContextKey)
+		if !ok {
+			c.Next()
+			return
+		}
+
+		token := raw.(*models.APIToken)
+		for _, scope := range scopes {
+			if token.HasScope(scope) {
+				c.Next()
+				return
+			}
+		}
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "api token missing required scope: " + strings.Join(scopes, " or ")})
+	}
+}
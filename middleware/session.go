@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/services"
+)
+
+// SessionTracker records activity for the calling X-User-ID/X-Device-ID
+// pair and rejects the request immediately if that session has been
+// revoked. Requests without an X-User-ID header aren't identifying a user
+// yet and pass through untouched. Must run after TenantResolver.
+func SessionTracker(sessions *services.SessionService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID := c.GetHeader("X-User-ID")
+		if userID == "" {
+			c.Next()
+			return
+		}
+		tenant := c.MustGet(TenantContextKey).(*models.Tenant)
+
+		deviceID := c.GetHeader("X-Device-ID")
+		if deviceID == "" {
+			deviceID = "unknown"
+		}
+
+		if _, err := sessions.Touch(tenant.ID, userID, deviceID, c.ClientIP(), c.Request.UserAgent()); err != nil {
+			if errors.Is(err, services.ErrSessionRevoked) {
+				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "session has been revoked"})
+				return
+			}
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "failed to track session"})
+			return
+		}
+
+		c.Next()
+	}
+}
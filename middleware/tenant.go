@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// TenantContextKey is the gin context key the resolved tenant is stored
+// under by TenantResolver.
+const TenantContextKey = "tenant"
+
+// TenantResolver resolves the calling tenant from the X-API-Key header (or
+// X-Tenant-ID as a fallback for internal callers) and rejects the request
+// if no matching tenant exists. Downstream handlers and repositories must
+// use the tenant stored in the context to scope every query.
+func TenantResolver(tenants *repository.TenantRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		apiKey := c.GetHeader("X-API-Key")
+		if apiKey != "" {
+			tenant, err := tenants.FindByAPIKey(apiKey)
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid api key"})
+				return
+			}
+			c.Set(TenantContextKey, tenant)
+			c.Next()
+			return
+		}
+
+		tenantID := c.GetHeader("X-Tenant-ID")
+		if tenantID == "" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "tenant could not be resolved"})
+			return
+		}
+
+		tenant, err := tenants.FindByID(tenantID)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "unknown tenant"})
+			return
+		}
+		c.Set(TenantContextKey, tenant)
+		c.Next()
+	}
+}
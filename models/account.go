@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+// Account represents a customer's bank account. Balances are stored as
+// integer minor units (cents) to avoid floating point drift.
+type Account struct {
+	ID            string    `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID      string    `gorm:"type:uuid;not null;index:idx_accounts_tenant" json:"tenant_id"`
+	UserID        string    `gorm:"type:uuid;not null;index:idx_accounts_tenant_user" json:"user_id"`
+	AccountNumber string    `gorm:"not null;index:idx_accounts_tenant_number" json:"account_number"`
+	Currency      string    `gorm:"not null;default:USD" json:"currency"`
+	Balance       int64     `gorm:"not null;default:0" json:"balance"`
+	HeldAmount    int64     `gorm:"not null;default:0" json:"held_amount"`
+	ProductID     string    `gorm:"type:uuid" json:"product_id,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// Available returns the balance minus any active holds.
+func (a *Account) Available() int64 {
+	return a.Balance - a.HeldAmount
+}
@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// AccountFreeze temporarily blocks an account from posting new
+// transactions, e.g. immediately after a PIN reset, to limit the damage
+// an attacker who social-engineered the reset could otherwise do before
+// the customer notices.
+type AccountFreeze struct {
+	ID        string    `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID  string    `gorm:"type:uuid;not null;index:idx_account_freezes_tenant" json:"tenant_id"`
+	AccountID string    `gorm:"type:uuid;not null;index:idx_account_freezes_account" json:"account_id"`
+	Reason    string    `gorm:"not null" json:"reason"`
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
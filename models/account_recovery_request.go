@@ -0,0 +1,40 @@
+package models
+
+import "time"
+
+// AccountRecoveryStatus is the lifecycle state of an AccountRecoveryRequest.
+type AccountRecoveryStatus string
+
+const (
+	// AccountRecoveryPending awaits admin review of the submitted evidence.
+	AccountRecoveryPending AccountRecoveryStatus = "pending"
+	// AccountRecoveryStepUpSent means an admin approved the evidence and a
+	// verification code has been sent to the new phone number.
+	AccountRecoveryStepUpSent AccountRecoveryStatus = "step_up_sent"
+	// AccountRecoveryVerified means the user proved control of the new
+	// phone number and the account has been re-bound to it.
+	AccountRecoveryVerified AccountRecoveryStatus = "verified"
+	// AccountRecoveryDenied means an admin rejected the submitted evidence.
+	AccountRecoveryDenied AccountRecoveryStatus = "denied"
+)
+
+// AccountRecoveryRequest re-binds a user's account to a new phone number
+// after they lose access to the old one. Phone doubles as both the
+// identity key and the OTP delivery channel, so the rebind can't be a
+// self-service phone-number edit: it requires an admin to review
+// submitted identity evidence before a step-up code is even sent to the
+// new number.
+type AccountRecoveryRequest struct {
+	ID               string                `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID         string                `gorm:"type:uuid;not null;index:idx_account_recovery_requests_tenant" json:"tenant_id"`
+	UserID           string                `gorm:"type:uuid;not null" json:"user_id"`
+	NewPhone         string                `gorm:"not null" json:"new_phone"`
+	Evidence         string                `gorm:"not null" json:"evidence"`
+	Status           AccountRecoveryStatus `gorm:"not null;default:pending" json:"status"`
+	ReviewedByUserID string                `gorm:"type:uuid" json:"reviewed_by_user_id,omitempty"`
+	ReviewNote       string                `json:"review_note,omitempty"`
+	Code             string                `gorm:"not null" json:"-"`
+	CodeExpiresAt    time.Time             `json:"-"`
+	CreatedAt        time.Time             `json:"created_at"`
+	UpdatedAt        time.Time             `json:"updated_at"`
+}
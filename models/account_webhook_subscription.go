@@ -0,0 +1,55 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// AccountWebhookEventType is a kind of account activity an
+// AccountWebhookSubscription can filter delivery to.
+type AccountWebhookEventType string
+
+const (
+	AccountWebhookEventCredit AccountWebhookEventType = "credit"
+	AccountWebhookEventDebit  AccountWebhookEventType = "debit"
+	AccountWebhookEventFailed AccountWebhookEventType = "failed"
+
+	// AccountWebhookEventReversed has no producer yet: nothing in this
+	// codebase transitions a Transaction to TransactionStatusReversed. It's
+	// defined so a subscription can already select it, ready for whichever
+	// reversal flow lands first.
+	AccountWebhookEventReversed AccountWebhookEventType = "reversed"
+)
+
+// AccountWebhookSubscription is a tenant's registration to receive an
+// outbound, HMAC-signed webhook for activity on one of their accounts,
+// e.g. a merchant that only wants to be notified when money arrives.
+// EventTypes is a space-separated list of AccountWebhookEventType values;
+// an empty EventTypes matches every event type. MinAmount, if set,
+// further restricts delivery to transactions at or above that amount.
+type AccountWebhookSubscription struct {
+	ID         string    `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID   string    `gorm:"type:uuid;not null;index:idx_account_webhook_subs_tenant" json:"tenant_id"`
+	AccountID  string    `gorm:"type:uuid;not null;index:idx_account_webhook_subs_account" json:"account_id"`
+	URL        string    `gorm:"not null" json:"url"`
+	Secret     string    `gorm:"not null" json:"-"`
+	EventTypes string    `json:"event_types"`
+	MinAmount  int64     `gorm:"not null;default:0" json:"min_amount"`
+	Active     bool      `gorm:"not null;default:true" json:"active"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// MatchesEventType reports whether eventType should be delivered under
+// this subscription's filter. An empty EventTypes matches everything.
+func (s *AccountWebhookSubscription) MatchesEventType(eventType AccountWebhookEventType) bool {
+	if s.EventTypes == "" {
+		return true
+	}
+	for _, t := range strings.Fields(s.EventTypes) {
+		if AccountWebhookEventType(t) == eventType {
+			return true
+		}
+	}
+	return false
+}
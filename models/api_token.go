@@ -0,0 +1,67 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// Scope constants recognized by middleware.RequireScope. ScopeAdminAll is
+// a wildcard that grants every scope and should only ever be issued to a
+// token meant to act on the /admin or /backoffice route groups.
+const (
+	ScopeBalanceRead       = "balance:read"
+	ScopeHistoryRead       = "history:read"
+	ScopeTransactionCreate = "transaction:create"
+
+	// ScopeDepositCreate grants a narrower right than
+	// ScopeTransactionCreate: posting deposits only, for a
+	// service-to-service integration that should never be able to move
+	// money out of an account. See controllers.TransactionController.Create.
+	ScopeDepositCreate = "deposit:create"
+
+	ScopeAdminAll = "admin:*"
+)
+
+// ValidScope reports whether scope is one this codebase knows how to
+// enforce.
+func ValidScope(scope string) bool {
+	switch scope {
+	case ScopeBalanceRead, ScopeHistoryRead, ScopeTransactionCreate, ScopeDepositCreate, ScopeAdminAll:
+		return true
+	default:
+		return false
+	}
+}
+
+// APIToken is a long-lived credential a tenant can issue for programmatic
+// access, as an alternative to the interim X-User-ID header. It's this
+// codebase's machine credential for service-to-service callers (e.g. an
+// internal integration that should only be able to post deposits): the
+// X-API-Key header is already claimed by TenantResolver for identifying
+// the calling tenant, so a token like this authenticates via
+// Authorization: Bearer instead (see middleware.APITokenAuth). Only the
+// SHA-256 hash of the token is stored; the plaintext is returned once, at
+// issuance, and never again. Scope is a space-delimited scope list,
+// following the OAuth 2.0 "scope" convention, and limits which routes the
+// token can be used on.
+type APIToken struct {
+	ID        string     `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID  string     `gorm:"type:uuid;not null;index:idx_api_tokens_tenant" json:"tenant_id"`
+	UserID    string     `gorm:"type:uuid;not null" json:"user_id"`
+	Name      string     `gorm:"not null" json:"name"`
+	TokenHash string     `gorm:"not null;uniqueIndex:idx_api_tokens_hash" json:"-"`
+	Scope     string     `gorm:"not null" json:"scope"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// HasScope reports whether the token grants scope, honoring the
+// admin:* wildcard which grants every scope.
+func (t *APIToken) HasScope(scope string) bool {
+	for _, s := range strings.Fields(t.Scope) {
+		if s == scope || s == ScopeAdminAll {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+// AuditLog is an append-only record of administrative actions that fall
+// outside normal customer/agent activity, such as manual balance
+// adjustments. Unlike TransactionLog (a mirror of every ledger movement),
+// AuditLog exists specifically to capture who did something and why.
+type AuditLog struct {
+	ID          string `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID    string `gorm:"type:uuid;not null;index:idx_audit_logs_tenant" json:"tenant_id"`
+	ActorUserID string `gorm:"type:uuid;not null" json:"actor_user_id"`
+	// OnBehalfOfUserID identifies the customer being acted on when the
+	// actor is a support admin operating under an ImpersonationSession, so
+	// the log preserves both identities. Empty for ordinary admin actions.
+	OnBehalfOfUserID string `gorm:"type:uuid" json:"on_behalf_of_user_id,omitempty"`
+	Action           string `gorm:"not null" json:"action"`
+	EntityType       string `gorm:"not null" json:"entity_type"`
+	EntityID         string `gorm:"type:uuid;not null" json:"entity_id"`
+	ReasonCode       string `gorm:"not null" json:"reason_code"`
+	Description      string `json:"description"`
+	// BeforeValue and AfterValue are JSON snapshots of the entity's
+	// relevant state immediately before and after the action, when the
+	// caller has them on hand. Left empty for actions with no meaningful
+	// before/after state to capture (e.g. account freezes).
+	BeforeValue string    `json:"before_value,omitempty"`
+	AfterValue  string    `json:"after_value,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+// BalanceMismatch records that the reconciliation checker recomputed an
+// account's balance from its ledger history and found it disagreed with the
+// stored balance, along with enough context to investigate the drift.
+type BalanceMismatch struct {
+	ID              string    `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID        string    `gorm:"type:uuid;not null;index:idx_balance_mismatches_tenant" json:"tenant_id"`
+	AccountID       string    `gorm:"type:uuid;not null" json:"account_id"`
+	ExpectedBalance int64     `gorm:"not null" json:"expected_balance"`
+	ActualBalance   int64     `gorm:"not null" json:"actual_balance"`
+	CreatedAt       time.Time `json:"created_at"`
+}
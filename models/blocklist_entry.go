@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+// BlocklistEntryType is the kind of value a BlocklistEntry matches against.
+type BlocklistEntryType string
+
+const (
+	BlocklistEntryAccount     BlocklistEntryType = "account"
+	BlocklistEntryPhone       BlocklistEntryType = "phone"
+	BlocklistEntryNamePattern BlocklistEntryType = "name_pattern"
+)
+
+// BlocklistEntry is a single internally maintained sanctions/blocklist
+// record. NamePattern entries are matched with a SQL LIKE, everything else
+// is matched exactly.
+type BlocklistEntry struct {
+	ID        string             `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID  string             `gorm:"type:uuid;not null;index:idx_blocklist_entries_tenant" json:"tenant_id"`
+	Type      BlocklistEntryType `gorm:"not null" json:"type"`
+	Value     string             `gorm:"not null" json:"value"`
+	Reason    string             `json:"reason"`
+	CreatedAt time.Time          `json:"created_at"`
+}
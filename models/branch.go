@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// Branch represents a physical or virtual branch of a tenant bank. Agents
+// are attached to a branch, and every transaction they perform records that
+// branch for reporting.
+type Branch struct {
+	ID            string    `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID      string    `gorm:"type:uuid;not null;index:idx_branches_tenant" json:"tenant_id"`
+	Name          string    `gorm:"not null" json:"name"`
+	Code          string    `gorm:"not null" json:"code"`
+	CashAccountID string    `gorm:"type:uuid" json:"cash_account_id,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
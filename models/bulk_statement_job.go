@@ -0,0 +1,46 @@
+package models
+
+import "time"
+
+// BulkStatementJobStatus is the lifecycle state of a BulkStatementJob.
+type BulkStatementJobStatus string
+
+const (
+	BulkStatementJobPending   BulkStatementJobStatus = "pending"
+	BulkStatementJobRunning   BulkStatementJobStatus = "running"
+	BulkStatementJobCompleted BulkStatementJobStatus = "completed"
+	BulkStatementJobFailed    BulkStatementJobStatus = "failed"
+)
+
+// BulkStatementJob is a single run of the bulk statement export: every
+// account's statement for [From, To) bundled into one archive, for an
+// auditor who needs a single download rather than one API call per
+// account. Generation happens in the background; TotalAccounts and
+// DoneAccounts let a caller poll for progress.
+type BulkStatementJob struct {
+	ID       string    `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID string    `gorm:"type:uuid;not null;index:idx_bulk_statement_jobs_tenant" json:"tenant_id"`
+	From     time.Time `gorm:"not null" json:"from"`
+	To       time.Time `gorm:"not null" json:"to"`
+
+	// AccountIDs, if set, is a space-separated list of accounts to
+	// include, following this codebase's convention for a multi-value
+	// column (see APIToken.Scope). Empty means every account under the
+	// tenant.
+	AccountIDs string `json:"account_ids,omitempty"`
+
+	// WebhookURL and WebhookSecret, if set, are where and how Start
+	// reports completion, delivered the same way an account webhook
+	// subscription is (see services.WebhookDispatcher).
+	WebhookURL    string `json:"webhook_url,omitempty"`
+	WebhookSecret string `gorm:"column:webhook_secret" json:"-"`
+
+	Status        BulkStatementJobStatus `gorm:"not null;default:pending" json:"status"`
+	TotalAccounts int                    `json:"total_accounts"`
+	DoneAccounts  int                    `json:"done_accounts"`
+	ArchiveURL    string                 `json:"archive_url,omitempty"`
+	Error         string                 `json:"error,omitempty"`
+
+	CreatedAt   time.Time  `json:"created_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
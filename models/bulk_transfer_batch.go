@@ -0,0 +1,39 @@
+package models
+
+import "time"
+
+// BulkTransferStatus summarizes how a BulkTransferBatch's lines resolved.
+type BulkTransferStatus string
+
+const (
+	// BulkTransferCompleted means every line posted.
+	BulkTransferCompleted BulkTransferStatus = "completed"
+	// BulkTransferCompletedWithErrors means some lines posted and some
+	// failed.
+	BulkTransferCompletedWithErrors BulkTransferStatus = "completed_with_errors"
+	// BulkTransferRejected means the batch never posted a single line,
+	// either because every line failed or the up-front balance check
+	// rejected it outright.
+	BulkTransferRejected BulkTransferStatus = "rejected"
+)
+
+// BulkTransferBatch is a payroll-style bulk transfer: one debit account
+// paying out to many credit accounts, validated as a whole against the
+// debit account's balance before any line posts, with a summary report of
+// how many lines posted and how many failed.
+type BulkTransferBatch struct {
+	ID             string             `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID       string             `gorm:"type:uuid;not null;index:idx_bulk_transfer_batches_tenant" json:"tenant_id"`
+	DebitAccountID string             `gorm:"type:uuid;not null" json:"debit_account_id"`
+	TotalAmount    int64              `json:"total_amount"`
+	ItemCount      int                `json:"item_count"`
+	SuccessCount   int                `json:"success_count"`
+	FailureCount   int                `json:"failure_count"`
+	Status         BulkTransferStatus `gorm:"not null" json:"status"`
+
+	// PayrollTemplateID links this batch back to the PayrollTemplate whose
+	// scheduled run produced it, empty for a batch submitted directly via
+	// the bulk transfer endpoint.
+	PayrollTemplateID string    `gorm:"type:uuid;index:idx_bulk_transfer_batches_payroll_template" json:"payroll_template_id,omitempty"`
+	CreatedAt         time.Time `json:"created_at"`
+}
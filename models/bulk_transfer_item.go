@@ -0,0 +1,26 @@
+package models
+
+import "time"
+
+// BulkTransferItemStatus is the outcome of a single line within a
+// BulkTransferBatch.
+type BulkTransferItemStatus string
+
+const (
+	BulkTransferItemPosted BulkTransferItemStatus = "posted"
+	BulkTransferItemFailed BulkTransferItemStatus = "failed"
+)
+
+// BulkTransferItem is a single credit-account/amount line within a
+// BulkTransferBatch, recording whether it posted and, if not, why.
+type BulkTransferItem struct {
+	ID              string                 `gorm:"type:uuid;primaryKey" json:"id"`
+	BatchID         string                 `gorm:"type:uuid;not null;index:idx_bulk_transfer_items_batch" json:"batch_id"`
+	TenantID        string                 `gorm:"type:uuid;not null" json:"tenant_id"`
+	CreditAccountID string                 `gorm:"type:uuid;not null" json:"credit_account_id"`
+	Amount          int64                  `json:"amount"`
+	TransactionID   string                 `gorm:"type:uuid" json:"transaction_id,omitempty"`
+	Status          BulkTransferItemStatus `gorm:"not null" json:"status"`
+	Error           string                 `json:"error,omitempty"`
+	CreatedAt       time.Time              `json:"created_at"`
+}
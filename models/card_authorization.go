@@ -0,0 +1,30 @@
+package models
+
+import "time"
+
+// CardAuthorizationStatus is the lifecycle state of a CardAuthorization.
+type CardAuthorizationStatus string
+
+const (
+	CardAuthorizationPending  CardAuthorizationStatus = "pending"
+	CardAuthorizationCaptured CardAuthorizationStatus = "captured"
+	CardAuthorizationReversed CardAuthorizationStatus = "reversed"
+)
+
+// CardAuthorization is a hold placed against an account by an ISO 8583
+// authorization request (MTI 0100) from the card switch, identified by
+// its Retrieval Reference Number so a later financial or reversal
+// message (0200/0400) can be matched back to it.
+type CardAuthorization struct {
+	ID            string                  `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID      string                  `gorm:"type:uuid;not null;index:idx_card_authorizations_tenant" json:"tenant_id"`
+	AccountID     string                  `gorm:"type:uuid;not null;index:idx_card_authorizations_account" json:"account_id"`
+	RRN           string                  `gorm:"not null;index:idx_card_authorizations_rrn" json:"rrn"`
+	Amount        int64                   `gorm:"not null" json:"amount"`
+	Currency      string                  `gorm:"not null" json:"currency"`
+	Status        CardAuthorizationStatus `gorm:"not null;default:pending" json:"status"`
+	TransactionID string                  `gorm:"type:uuid" json:"transaction_id,omitempty"`
+	ExpiresAt     time.Time               `json:"expires_at"`
+	CreatedAt     time.Time               `json:"created_at"`
+	UpdatedAt     time.Time               `json:"updated_at"`
+}
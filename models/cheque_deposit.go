@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+// ChequeDepositStatus is the lifecycle state of a ChequeDeposit.
+type ChequeDepositStatus string
+
+const (
+	ChequeDepositPending ChequeDepositStatus = "pending"
+	ChequeDepositCleared ChequeDepositStatus = "cleared"
+	ChequeDepositBounced ChequeDepositStatus = "bounced"
+)
+
+// ChequeDeposit records a customer depositing a physical cheque. Funds sit
+// in Pending status, invisible to the account's spendable balance, until a
+// clearing callback either posts the deposit or reverses it on bounce.
+type ChequeDeposit struct {
+	ID            string              `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID      string              `gorm:"type:uuid;not null;index:idx_cheque_deposits_tenant" json:"tenant_id"`
+	AccountID     string              `gorm:"type:uuid;not null;index:idx_cheque_deposits_account" json:"account_id"`
+	ChequeNumber  string              `gorm:"not null" json:"cheque_number"`
+	IssuingBank   string              `gorm:"not null" json:"issuing_bank"`
+	Amount        int64               `gorm:"not null" json:"amount"`
+	ImageRef      string              `json:"image_ref"`
+	Status        ChequeDepositStatus `gorm:"not null;default:pending" json:"status"`
+	TransactionID string              `gorm:"type:uuid" json:"transaction_id,omitempty"`
+	CreatedAt     time.Time           `json:"created_at"`
+	UpdatedAt     time.Time           `json:"updated_at"`
+}
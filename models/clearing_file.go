@@ -0,0 +1,34 @@
+package models
+
+import "time"
+
+// ClearingFileFormat is the wire format a ClearingFile was rendered in.
+type ClearingFileFormat string
+
+const (
+	ClearingFileFormatPain001 ClearingFileFormat = "pain001"
+	ClearingFileFormatNACHA   ClearingFileFormat = "nacha"
+)
+
+// ClearingFileStatus is the lifecycle state of a ClearingFile.
+type ClearingFileStatus string
+
+const (
+	ClearingFileGenerated    ClearingFileStatus = "generated"
+	ClearingFileAcknowledged ClearingFileStatus = "acknowledged"
+)
+
+// ClearingFile is a single day's batch of ExternalTransfers rendered into
+// a standards-compliant payload (pain.001 XML or a NACHA fixed-width
+// file) for the clearing partner. It is platform-wide rather than
+// tenant-scoped, the same way DeadLetter is: one clearing partner
+// relationship batches transfers from every tenant into the same file.
+type ClearingFile struct {
+	ID          string             `gorm:"type:uuid;primaryKey" json:"id"`
+	Format      ClearingFileFormat `gorm:"not null" json:"format"`
+	Content     string             `gorm:"type:text;not null" json:"content"`
+	ItemCount   int                `gorm:"not null" json:"item_count"`
+	TotalAmount int64              `gorm:"not null" json:"total_amount"`
+	Status      ClearingFileStatus `gorm:"not null;default:generated" json:"status"`
+	CreatedAt   time.Time          `json:"created_at"`
+}
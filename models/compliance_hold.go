@@ -0,0 +1,32 @@
+package models
+
+import "time"
+
+// ComplianceHoldStatus is the lifecycle state of a screening hold.
+type ComplianceHoldStatus string
+
+const (
+	ComplianceHoldPending  ComplianceHoldStatus = "pending"
+	ComplianceHoldReleased ComplianceHoldStatus = "released"
+	ComplianceHoldDenied   ComplianceHoldStatus = "denied"
+)
+
+// ComplianceHold captures a transfer that screening matched against a
+// blocklist or external sanctions list. The underlying transfer never
+// posts until an admin releases the hold; denying it discards the
+// transfer entirely.
+type ComplianceHold struct {
+	ID                    string               `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID              string               `gorm:"type:uuid;not null;index:idx_compliance_holds_tenant" json:"tenant_id"`
+	AccountID             string               `gorm:"type:uuid;not null" json:"account_id"`
+	CounterpartyAccountID string               `gorm:"type:uuid;not null" json:"counterparty_account_id"`
+	Amount                int64                `gorm:"not null" json:"amount"`
+	BranchID              string               `gorm:"type:uuid" json:"branch_id,omitempty"`
+	PerformedByUserID     string               `gorm:"type:uuid" json:"performed_by_user_id,omitempty"`
+	MatchedValue          string               `gorm:"not null" json:"matched_value"`
+	Reason                string               `gorm:"not null" json:"reason"`
+	Status                ComplianceHoldStatus `gorm:"not null;default:pending" json:"status"`
+	TransactionID         string               `gorm:"type:uuid" json:"transaction_id,omitempty"`
+	CreatedAt             time.Time            `json:"created_at"`
+	UpdatedAt             time.Time            `json:"updated_at"`
+}
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// ConsentStatus is the lifecycle state of a Consent.
+type ConsentStatus string
+
+const (
+	ConsentActive  ConsentStatus = "active"
+	ConsentRevoked ConsentStatus = "revoked"
+)
+
+// Consent records a customer's explicit approval for a third party to
+// read a specific set of their accounts under the Open Banking API,
+// backed by a scoped APIToken minted for exactly that grant. AccountIDs
+// is a space-delimited account ID list, the same convention APIToken.Scope
+// uses for its space-delimited scope list.
+type Consent struct {
+	ID             string        `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID       string        `gorm:"type:uuid;not null;index:idx_consents_tenant" json:"tenant_id"`
+	UserID         string        `gorm:"type:uuid;not null" json:"user_id"`
+	ThirdPartyName string        `gorm:"not null" json:"third_party_name"`
+	AccountIDs     string        `gorm:"not null" json:"account_ids"`
+	APITokenID     string        `gorm:"type:uuid;not null;index:idx_consents_api_token" json:"api_token_id"`
+	Status         ConsentStatus `gorm:"not null;default:active" json:"status"`
+	ExpiresAt      time.Time     `json:"expires_at"`
+	CreatedAt      time.Time     `json:"created_at"`
+	UpdatedAt      time.Time     `json:"updated_at"`
+}
+
+// HasAccount reports whether accountID is covered by this consent.
+func (c *Consent) HasAccount(accountID string) bool {
+	for _, id := range strings.Fields(c.AccountIDs) {
+		if id == accountID {
+			return true
+		}
+	}
+	return false
+}
+
+// Live reports whether the consent is still active and unexpired as of
+// now.
+func (c *Consent) Live(now time.Time) bool {
+	return c.Status == ConsentActive && now.Before(c.ExpiresAt)
+}
@@ -0,0 +1,59 @@
+package models
+
+// currencyMinorUnits maps an ISO 4217 currency code to how many decimal
+// places its minor unit has. Most currencies use 2 (cents), but some use
+// none (e.g. JPY, whose yen is its own minor unit) and some use 3 (e.g.
+// BHD, KWD, OMR). Amounts are always stored as an integer count of minor
+// units; this registry is what lets code convert to/from a currency's
+// major-unit representation, or round a fractional minor-unit result
+// (from FX conversion or interest accrual), without hardcoding "2 decimal
+// places" everywhere.
+var currencyMinorUnits = map[string]int{
+	"JPY": 0,
+	"KRW": 0,
+	"BHD": 3,
+	"KWD": 3,
+	"OMR": 3,
+	"USD": 2,
+	"EUR": 2,
+	"GBP": 2,
+	"NGN": 2,
+	"KES": 2,
+	"ZAR": 2,
+	"INR": 2,
+}
+
+// defaultMinorUnits is used for any currency code not in the registry,
+// matching the two-decimal-place convention most currencies follow.
+const defaultMinorUnits = 2
+
+// ValidCurrency reports whether code is a currency this codebase knows
+// how to handle, i.e. one listed in the currencyMinorUnits registry.
+// Account creation is validated against this so a typo'd or unsupported
+// code can't silently fall back to defaultMinorUnits' two-decimal-place
+// assumption.
+func ValidCurrency(code string) bool {
+	_, ok := currencyMinorUnits[code]
+	return ok
+}
+
+// CurrencyMinorUnits returns how many decimal places code's minor unit
+// has, e.g. 2 for USD, 0 for JPY, 3 for BHD. Unknown codes default to 2.
+func CurrencyMinorUnits(code string) int {
+	if units, ok := currencyMinorUnits[code]; ok {
+		return units
+	}
+	return defaultMinorUnits
+}
+
+// RoundMinorUnits rounds a fractional minor-unit amount (e.g. the result
+// of an FX conversion or an interest accrual) to the nearest whole minor
+// unit, half rounding away from zero. Every currency's amounts are
+// integers once stored, so any computation that can produce a fraction
+// must go through this before it's persisted or posted.
+func RoundMinorUnits(amount float64) int64 {
+	if amount >= 0 {
+		return int64(amount + 0.5)
+	}
+	return -int64(-amount + 0.5)
+}
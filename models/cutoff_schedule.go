@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// CutoffSchedule is a tenant-configured daily cut-off for a transaction
+// type: a submission at or after CutoffMinute (minutes past midnight UTC)
+// value-dates to the next business day instead of the day it was
+// submitted. A transaction type with no CutoffSchedule configured has no
+// cut-off, and always value-dates to the submission day (or the next
+// business day, if that day isn't one).
+type CutoffSchedule struct {
+	ID           string          `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID     string          `gorm:"type:uuid;not null;uniqueIndex:idx_cutoff_schedules_tenant_type" json:"tenant_id"`
+	Type         TransactionType `gorm:"not null;uniqueIndex:idx_cutoff_schedules_tenant_type" json:"type"`
+	CutoffMinute int             `gorm:"not null" json:"cutoff_minute"`
+	CreatedAt    time.Time       `json:"created_at"`
+	UpdatedAt    time.Time       `json:"updated_at"`
+}
@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// DailySummarySubscription is a user's opt-in to receive an end-of-day
+// spending summary notification. The absence of a row, not a disabled
+// flag, is the default state, but Enabled is kept explicit so a user can
+// pause the notification without losing the subscription row.
+type DailySummarySubscription struct {
+	ID        string    `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID  string    `gorm:"type:uuid;not null;index:idx_daily_summary_subscriptions_tenant" json:"tenant_id"`
+	UserID    string    `gorm:"type:uuid;not null;uniqueIndex:idx_daily_summary_subscriptions_user" json:"user_id"`
+	Enabled   bool      `gorm:"not null;default:true" json:"enabled"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
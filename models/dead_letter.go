@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// DeadLetter records an event that could not be delivered to a downstream
+// consumer after every retry, along with enough diagnostic detail for an
+// operator to figure out what went wrong and whether to replay it.
+type DeadLetter struct {
+	ID             string    `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID       string    `gorm:"type:uuid;not null;index:idx_dead_letters_tenant" json:"tenant_id"`
+	AccountID      string    `gorm:"type:uuid;index:idx_dead_letters_account" json:"account_id,omitempty"`
+	EventType      string    `gorm:"not null;index:idx_dead_letters_event_type" json:"event_type"`
+	Payload        string    `gorm:"type:text;not null" json:"payload"`
+	ErrorType      string    `gorm:"not null;index:idx_dead_letters_error_type" json:"error_type"`
+	Error          string    `gorm:"not null" json:"error"`
+	Attempts       int       `gorm:"not null" json:"attempts"`
+	FirstAttemptAt time.Time `json:"first_attempt_at"`
+	LastAttemptAt  time.Time `json:"last_attempt_at"`
+	CreatedAt      time.Time `json:"created_at"`
+}
@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// DescriptionTemplate is a tenant-configured, localized template for a
+// system-generated transaction's description, keyed by ReasonCode (the
+// same value TransactionService.Post records under Transaction.ReasonCode)
+// and Locale. Template is a text/template body; the variables it can
+// reference are documented per reason code alongside where that reason
+// code is posted (see services.DescriptionTemplateService).
+type DescriptionTemplate struct {
+	ID         string    `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID   string    `gorm:"type:uuid;not null;uniqueIndex:idx_description_templates_tenant_reason_locale" json:"tenant_id"`
+	ReasonCode string    `gorm:"not null;uniqueIndex:idx_description_templates_tenant_reason_locale" json:"reason_code"`
+	Locale     string    `gorm:"not null;uniqueIndex:idx_description_templates_tenant_reason_locale" json:"locale"`
+	Template   string    `gorm:"not null" json:"template"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
@@ -0,0 +1,26 @@
+package models
+
+import "time"
+
+// DeviceChallengeStatus is the lifecycle state of a step-up OTP challenge.
+type DeviceChallengeStatus string
+
+const (
+	DeviceChallengePending  DeviceChallengeStatus = "pending"
+	DeviceChallengeVerified DeviceChallengeStatus = "verified"
+	DeviceChallengeExpired  DeviceChallengeStatus = "expired"
+)
+
+// DeviceChallenge is a one-time code sent to a user to verify a device
+// before it is added to their trusted-devices list.
+type DeviceChallenge struct {
+	ID          string                `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID    string                `gorm:"type:uuid;not null;index:idx_device_challenges_tenant" json:"tenant_id"`
+	UserID      string                `gorm:"type:uuid;not null" json:"user_id"`
+	DeviceID    string                `gorm:"not null" json:"device_id"`
+	Fingerprint string                `json:"fingerprint,omitempty"`
+	Code        string                `gorm:"not null" json:"-"`
+	Status      DeviceChallengeStatus `gorm:"not null;default:pending" json:"status"`
+	ExpiresAt   time.Time             `json:"expires_at"`
+	CreatedAt   time.Time             `json:"created_at"`
+}
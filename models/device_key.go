@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// DeviceKey is a client device's registered Ed25519 public key, used to
+// verify a signature over a high-value transaction's canonical payload.
+// This gives non-repudiation a bearer credential alone can't: only the
+// device holding the matching private key could have produced a valid
+// signature.
+type DeviceKey struct {
+	ID        string     `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID  string     `gorm:"type:uuid;not null;uniqueIndex:idx_device_keys_tenant_user_device" json:"tenant_id"`
+	UserID    string     `gorm:"type:uuid;not null;uniqueIndex:idx_device_keys_tenant_user_device" json:"user_id"`
+	DeviceID  string     `gorm:"not null;uniqueIndex:idx_device_keys_tenant_user_device" json:"device_id"`
+	PublicKey string     `gorm:"not null" json:"public_key"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
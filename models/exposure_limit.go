@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// ExposureLimit is a tenant-configured ceiling on a user's total system
+// exposure — their combined account balances plus pending inbound
+// credits — keyed by KYC level, so a wallet regulation's per-tier caps
+// can be enforced without a code change per tenant. A KYC level with no
+// ExposureLimit configured is unbounded.
+type ExposureLimit struct {
+	ID        string    `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID  string    `gorm:"type:uuid;not null;uniqueIndex:idx_exposure_limits_tenant_kyc" json:"tenant_id"`
+	KYCLevel  int       `gorm:"not null;uniqueIndex:idx_exposure_limits_tenant_kyc" json:"kyc_level"`
+	Ceiling   int64     `gorm:"not null;default:0" json:"ceiling"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
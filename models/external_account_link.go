@@ -0,0 +1,26 @@
+package models
+
+import "time"
+
+// ExternalAccountLinkStatus is the lifecycle state of an
+// ExternalAccountLink.
+type ExternalAccountLinkStatus string
+
+const (
+	ExternalAccountLinkPending  ExternalAccountLinkStatus = "pending"
+	ExternalAccountLinkVerified ExternalAccountLinkStatus = "verified"
+)
+
+// ExternalAccountLink records an aggregator's claim that ExternalAccountID
+// on its side corresponds to AccountID on ours, and tracks whether that
+// claim has been verified via an "account.verified" webhook event.
+type ExternalAccountLink struct {
+	ID                string                    `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID          string                    `gorm:"type:uuid;not null;index:idx_external_account_links_tenant" json:"tenant_id"`
+	AccountID         string                    `gorm:"type:uuid;not null" json:"account_id"`
+	ProviderID        string                    `gorm:"type:uuid;not null" json:"provider_id"`
+	ExternalAccountID string                    `gorm:"not null" json:"external_account_id"`
+	Status            ExternalAccountLinkStatus `gorm:"not null;default:pending" json:"status"`
+	CreatedAt         time.Time                 `json:"created_at"`
+	UpdatedAt         time.Time                 `json:"updated_at"`
+}
@@ -0,0 +1,37 @@
+package models
+
+import "time"
+
+// ExternalTransferStatus is the lifecycle state of an ExternalTransfer.
+type ExternalTransferStatus string
+
+const (
+	ExternalTransferPending  ExternalTransferStatus = "pending"
+	ExternalTransferFiled    ExternalTransferStatus = "filed"
+	ExternalTransferSettled  ExternalTransferStatus = "settled"
+	ExternalTransferRejected ExternalTransferStatus = "rejected"
+)
+
+// ExternalTransfer records a customer transfer leaving the bank to an
+// external counterparty over ACH/wire rails. The debit posts immediately
+// on submission and sits in Pending status until it's swept into a
+// ClearingFile (Filed) and the clearing partner's acknowledgment either
+// confirms it (Settled) or returns it, at which point the original debit
+// is reversed (Rejected).
+type ExternalTransfer struct {
+	ID                        string                 `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID                  string                 `gorm:"type:uuid;not null;index:idx_external_transfers_tenant" json:"tenant_id"`
+	AccountID                 string                 `gorm:"type:uuid;not null;index:idx_external_transfers_account" json:"account_id"`
+	Amount                    int64                  `gorm:"not null" json:"amount"`
+	Currency                  string                 `gorm:"not null" json:"currency"`
+	CounterpartyName          string                 `gorm:"not null" json:"counterparty_name"`
+	CounterpartyRoutingNumber string                 `gorm:"not null" json:"counterparty_routing_number"`
+	CounterpartyAccountNumber string                 `gorm:"not null" json:"counterparty_account_number"`
+	Status                    ExternalTransferStatus `gorm:"not null;default:pending" json:"status"`
+	DebitTransactionID        string                 `gorm:"type:uuid" json:"debit_transaction_id,omitempty"`
+	ReversalTransactionID     string                 `gorm:"type:uuid" json:"reversal_transaction_id,omitempty"`
+	ClearingFileID            string                 `gorm:"type:uuid" json:"clearing_file_id,omitempty"`
+	RejectReason              string                 `json:"reject_reason,omitempty"`
+	CreatedAt                 time.Time              `json:"created_at"`
+	UpdatedAt                 time.Time              `json:"updated_at"`
+}
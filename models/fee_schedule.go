@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+// FeeSchedule is a tenant-configured fee for a transaction type: a flat
+// per-transaction amount plus a basis-point cut of the transaction
+// amount. A transaction type with no FeeSchedule configured is fee-free.
+type FeeSchedule struct {
+	ID          string          `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID    string          `gorm:"type:uuid;not null;uniqueIndex:idx_fee_schedules_tenant_type" json:"tenant_id"`
+	Type        TransactionType `gorm:"not null;uniqueIndex:idx_fee_schedules_tenant_type" json:"type"`
+	FlatFee     int64           `gorm:"not null;default:0" json:"flat_fee"`
+	BasisPoints int             `gorm:"not null;default:0" json:"basis_points"`
+	CreatedAt   time.Time       `json:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+}
+
+// Calculate returns the fee owed on amount under this schedule: the flat
+// fee plus basis-point cut, rounded to the nearest whole minor unit.
+func (f *FeeSchedule) Calculate(amount int64) int64 {
+	return f.FlatFee + RoundMinorUnits(float64(amount)*float64(f.BasisPoints)/10000)
+}
@@ -0,0 +1,36 @@
+package models
+
+import "time"
+
+// FraudDecision is the outcome of evaluating a transaction against the
+// fraud rules engine.
+type FraudDecision string
+
+const (
+	FraudDecisionAllow  FraudDecision = "allow"
+	FraudDecisionReview FraudDecision = "review"
+	FraudDecisionBlock  FraudDecision = "block"
+)
+
+// FraudReviewStatus is the lifecycle state of a FraudReview queue item.
+type FraudReviewStatus string
+
+const (
+	FraudReviewPending  FraudReviewStatus = "pending"
+	FraudReviewApproved FraudReviewStatus = "approved"
+	FraudReviewRejected FraudReviewStatus = "rejected"
+)
+
+// FraudReview is a queue item created whenever a transaction is flagged
+// for manual review by the fraud rules engine.
+type FraudReview struct {
+	ID            string            `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID      string            `gorm:"type:uuid;not null;index:idx_fraud_reviews_tenant" json:"tenant_id"`
+	AccountID     string            `gorm:"type:uuid;not null" json:"account_id"`
+	TransactionID string            `gorm:"type:uuid" json:"transaction_id,omitempty"`
+	Rule          string            `gorm:"not null" json:"rule"`
+	Reason        string            `gorm:"not null" json:"reason"`
+	Status        FraudReviewStatus `gorm:"not null;default:pending" json:"status"`
+	CreatedAt     time.Time         `json:"created_at"`
+	UpdatedAt     time.Time         `json:"updated_at"`
+}
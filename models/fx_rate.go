@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+// FXRate is a tenant-configured conversion rate from BaseCurrency to
+// QuoteCurrency: one unit of BaseCurrency buys Rate units of
+// QuoteCurrency. A currency pair with no FXRate configured cannot be
+// quoted for a cross-currency transfer.
+type FXRate struct {
+	ID            string    `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID      string    `gorm:"type:uuid;not null;uniqueIndex:idx_fx_rates_tenant_pair" json:"tenant_id"`
+	BaseCurrency  string    `gorm:"not null;uniqueIndex:idx_fx_rates_tenant_pair" json:"base_currency"`
+	QuoteCurrency string    `gorm:"not null;uniqueIndex:idx_fx_rates_tenant_pair" json:"quote_currency"`
+	Rate          float64   `gorm:"not null" json:"rate"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// Convert returns amount, denominated in BaseCurrency minor units,
+// converted to QuoteCurrency minor units at this rate, rounded to the
+// nearest whole minor unit.
+func (r *FXRate) Convert(amount int64) int64 {
+	return RoundMinorUnits(float64(amount) * r.Rate)
+}
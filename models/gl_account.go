@@ -0,0 +1,42 @@
+package models
+
+import "time"
+
+// GLAccountType identifies which purpose an internal general-ledger
+// account serves.
+type GLAccountType string
+
+const (
+	// GLAccountFeeIncome is the counterparty for fees the bank collects
+	// from customers.
+	GLAccountFeeIncome GLAccountType = "fee_income"
+
+	// GLAccountInterestExpense is the counterparty for interest the bank
+	// pays out to customer accounts.
+	GLAccountInterestExpense GLAccountType = "interest_expense"
+
+	// GLAccountSuspense holds amounts that have moved but whose final
+	// destination isn't settled yet, so they never sit uncounterpartied
+	// while under investigation.
+	GLAccountSuspense GLAccountType = "suspense"
+
+	// GLAccountSettlement is the counterparty for payouts to external
+	// merchants and other outside parties.
+	GLAccountSettlement GLAccountType = "settlement"
+)
+
+// GLAccount is an internal, non-customer-facing general-ledger account:
+// the offsetting side of a posting the bank itself is party to, so fee
+// income, interest expense, and external settlements post as a proper
+// double-entry instead of crediting or debiting a customer account with
+// nothing on the other side. There is one GLAccount per tenant per type
+// per currency.
+type GLAccount struct {
+	ID        string        `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID  string        `gorm:"type:uuid;not null;uniqueIndex:idx_gl_accounts_tenant_type_currency" json:"tenant_id"`
+	Type      GLAccountType `gorm:"not null;uniqueIndex:idx_gl_accounts_tenant_type_currency" json:"type"`
+	Currency  string        `gorm:"not null;uniqueIndex:idx_gl_accounts_tenant_type_currency" json:"currency"`
+	Balance   int64         `gorm:"not null;default:0" json:"balance"`
+	CreatedAt time.Time     `json:"created_at"`
+	UpdatedAt time.Time     `json:"updated_at"`
+}
@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// GLCodeMapping is a tenant-configured external ERP account code for one
+// of this codebase's internal GLAccountTypes, so the journal export can
+// speak the finance team's chart of accounts instead of this codebase's
+// own GLAccountType strings. A type with no GLCodeMapping configured
+// exports under its GLAccountType string.
+type GLCodeMapping struct {
+	ID           string        `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID     string        `gorm:"type:uuid;not null;uniqueIndex:idx_gl_code_mappings_tenant_type" json:"tenant_id"`
+	Type         GLAccountType `gorm:"not null;uniqueIndex:idx_gl_code_mappings_tenant_type" json:"type"`
+	ExternalCode string        `gorm:"not null" json:"external_code"`
+	CreatedAt    time.Time     `json:"created_at"`
+	UpdatedAt    time.Time     `json:"updated_at"`
+}
@@ -0,0 +1,26 @@
+package models
+
+import "time"
+
+// GLEntryDirection is which side of a GLAccount's balance an entry moves.
+type GLEntryDirection string
+
+const (
+	GLEntryDebit  GLEntryDirection = "debit"
+	GLEntryCredit GLEntryDirection = "credit"
+)
+
+// GLEntry is one leg of a double-entry posting against a GLAccount, tied
+// back to the customer-side Transaction it offsets so the two can be
+// reconciled against each other.
+type GLEntry struct {
+	ID            string           `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID      string           `gorm:"type:uuid;not null;index:idx_gl_entries_tenant" json:"tenant_id"`
+	GLAccountID   string           `gorm:"type:uuid;not null;index:idx_gl_entries_account" json:"gl_account_id"`
+	Direction     GLEntryDirection `gorm:"not null" json:"direction"`
+	Amount        int64            `gorm:"not null" json:"amount"`
+	BalanceAfter  int64            `gorm:"not null" json:"balance_after"`
+	TransactionID string           `gorm:"type:uuid" json:"transaction_id,omitempty"`
+	ReasonCode    string           `json:"reason_code,omitempty"`
+	CreatedAt     time.Time        `json:"created_at"`
+}
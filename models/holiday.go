@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+// Holiday is a non-business date on a tenant's calendar, in addition to
+// the weekends every tenant already observes. CalendarService consults it
+// to decide whether a given date is open for value dating.
+//
+// Region, if set, scopes the holiday to a particular region within the
+// tenant (e.g. a national holiday that only one branch's jurisdiction
+// observes) instead of the whole tenant. This codebase has no
+// region-aware caller yet (accounts, branches, and transactions carry no
+// region of their own), so CalendarService's IsBusinessDay currently
+// treats every holiday on a tenant's calendar as tenant-wide regardless
+// of Region; it's recorded here so a future region-aware caller doesn't
+// need a schema change to use it.
+type Holiday struct {
+	ID          string    `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID    string    `gorm:"type:uuid;not null;uniqueIndex:idx_holidays_tenant_date_region" json:"tenant_id"`
+	Date        time.Time `gorm:"type:date;not null;uniqueIndex:idx_holidays_tenant_date_region" json:"date"`
+	Region      string    `gorm:"not null;default:'';uniqueIndex:idx_holidays_tenant_date_region" json:"region,omitempty"`
+	Description string    `json:"description,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
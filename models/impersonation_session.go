@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+// ImpersonationSession grants a support admin temporary, read-mostly access
+// scoped to a single customer, so agents can see what the customer sees
+// while troubleshooting without needing the customer's own credentials.
+// Every session has a hard expiry and can be ended early by the admin who
+// opened it.
+type ImpersonationSession struct {
+	ID             string     `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID       string     `gorm:"type:uuid;not null;index:idx_impersonation_sessions_tenant" json:"tenant_id"`
+	AdminUserID    string     `gorm:"type:uuid;not null" json:"admin_user_id"`
+	CustomerUserID string     `gorm:"type:uuid;not null" json:"customer_user_id"`
+	ExpiresAt      time.Time  `gorm:"not null" json:"expires_at"`
+	EndedAt        *time.Time `json:"ended_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+// Active reports whether the session can still be used to view data, i.e.
+// it hasn't been ended early and hasn't passed its hard expiry.
+func (s *ImpersonationSession) Active(now time.Time) bool {
+	return s.EndedAt == nil && now.Before(s.ExpiresAt)
+}
@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+// InterestAccrualBreakdown records the inputs and intermediate values
+// behind one InterestService.Accrue calculation, tied back to the
+// Transaction it posted, so a disputed accrual can be explained without
+// recomputing it against terms that may have since changed.
+type InterestAccrualBreakdown struct {
+	ID                 string             `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID           string             `gorm:"type:uuid;not null;index:idx_interest_accrual_breakdowns_tenant" json:"tenant_id"`
+	AccountID          string             `gorm:"type:uuid;not null" json:"account_id"`
+	ProductID          string             `gorm:"type:uuid;not null" json:"product_id"`
+	TransactionID      string             `gorm:"type:uuid;index:idx_interest_accrual_breakdowns_transaction" json:"transaction_id"`
+	Balance            int64              `gorm:"not null" json:"balance"`
+	InterestRateBps    int                `gorm:"not null" json:"interest_rate_bps"`
+	DayCountConvention DayCountConvention `gorm:"not null" json:"day_count_convention"`
+	PeriodDays         int                `gorm:"not null" json:"period_days"`
+	Basis              int                `gorm:"not null" json:"basis"`
+	RawAmount          float64            `gorm:"not null" json:"raw_amount"`
+	RoundedAmount      int64              `gorm:"not null" json:"rounded_amount"`
+	CreatedAt          time.Time          `json:"created_at"`
+}
@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+// IPAllowlistEntry is one CIDR range a tenant has registered as allowed to
+// call the API with its API key. A tenant with no entries is not
+// allowlisted at all, i.e. the feature is opt-in per tenant.
+type IPAllowlistEntry struct {
+	ID        string    `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID  string    `gorm:"type:uuid;not null;index:idx_ip_allowlist_entries_tenant" json:"tenant_id"`
+	CIDR      string    `gorm:"not null" json:"cidr"`
+	CreatedAt time.Time `json:"created_at"`
+}
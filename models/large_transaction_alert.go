@@ -0,0 +1,37 @@
+package models
+
+import "time"
+
+// LargeTransactionAlertRule is a user-configured "notify me above X"
+// threshold, evaluated against both incoming and outgoing transactions on
+// every account the user owns.
+type LargeTransactionAlertRule struct {
+	ID        string    `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID  string    `gorm:"type:uuid;not null;index:idx_large_tx_alert_rules_tenant" json:"tenant_id"`
+	UserID    string    `gorm:"type:uuid;not null;uniqueIndex:idx_large_tx_alert_rules_user" json:"user_id"`
+	Threshold int64     `gorm:"not null;default:0" json:"threshold"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// LargeTransactionAlertDirection is which side of a transaction a
+// LargeTransactionAlertEvent fired for.
+type LargeTransactionAlertDirection string
+
+const (
+	LargeTransactionAlertIncoming LargeTransactionAlertDirection = "incoming"
+	LargeTransactionAlertOutgoing LargeTransactionAlertDirection = "outgoing"
+)
+
+// LargeTransactionAlertEvent records a single firing of a user's large-
+// transaction alert, so it can be shown back in their activity feed.
+type LargeTransactionAlertEvent struct {
+	ID            string                         `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID      string                         `gorm:"type:uuid;not null;index:idx_large_tx_alert_events_tenant" json:"tenant_id"`
+	UserID        string                         `gorm:"type:uuid;not null;index:idx_large_tx_alert_events_user" json:"user_id"`
+	AccountID     string                         `gorm:"type:uuid;not null" json:"account_id"`
+	TransactionID string                         `gorm:"type:uuid;not null" json:"transaction_id"`
+	Amount        int64                          `gorm:"not null" json:"amount"`
+	Direction     LargeTransactionAlertDirection `gorm:"not null" json:"direction"`
+	CreatedAt     time.Time                      `json:"created_at"`
+}
@@ -0,0 +1,34 @@
+package models
+
+import "time"
+
+// LimitScope identifies what a Limit row constrains.
+type LimitScope string
+
+const (
+	// LimitScopeTenant caps activity across an entire tenant.
+	LimitScopeTenant LimitScope = "tenant"
+	// LimitScopeAccount caps activity for a single account.
+	LimitScopeAccount LimitScope = "account"
+)
+
+// Limit configures transaction ceilings for a tenant or one of its
+// accounts. A nil-like zero value for a given field means "no limit".
+type Limit struct {
+	ID                  string     `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID            string     `gorm:"type:uuid;not null;index:idx_limits_tenant" json:"tenant_id"`
+	Scope               LimitScope `gorm:"not null" json:"scope"`
+	AccountID           string     `gorm:"type:uuid;index:idx_limits_account" json:"account_id,omitempty"`
+	PerTransactionLimit int64      `json:"per_transaction_limit"`
+	DailyLimit          int64      `json:"daily_limit"`
+	MonthlyLimit        int64      `json:"monthly_limit"`
+
+	// MaxTransactionsPerMinute throttles how many transactions of any type
+	// an account may submit within a rolling minute, distinct from the
+	// amount-based limits above. It targets runaway client loops and
+	// simple fraud scripts rather than legitimate high-value activity.
+	MaxTransactionsPerMinute int `json:"max_transactions_per_minute"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// LowBalanceAlert is a user-configured threshold that triggers a
+// notification once an account's balance drops below it. Active tracks
+// whether the alert has already fired for the current dip, so it fires
+// once per crossing rather than on every debit that leaves the balance
+// below Threshold; it only resets once the balance recovers past the
+// hysteresis band around Threshold (see lowBalanceResetMultiplier).
+type LowBalanceAlert struct {
+	ID        string    `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID  string    `gorm:"type:uuid;not null;index:idx_low_balance_alerts_tenant" json:"tenant_id"`
+	AccountID string    `gorm:"type:uuid;not null;uniqueIndex:idx_low_balance_alerts_account" json:"account_id"`
+	Threshold int64     `gorm:"not null;default:0" json:"threshold"`
+	Active    bool      `gorm:"not null;default:false" json:"active"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// Merchant identifies a payee whose name appears in Transaction.Merchant on
+// the payments customers make to it, and where the nightly settlement job
+// should pay out the net proceeds.
+type Merchant struct {
+	ID                  string    `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID            string    `gorm:"type:uuid;not null;index:idx_merchants_tenant" json:"tenant_id"`
+	Name                string    `gorm:"not null" json:"name"`
+	SettlementAccountID string    `gorm:"type:uuid;not null" json:"settlement_account_id"`
+	FeeBps              int       `gorm:"not null;default:0" json:"fee_bps"`
+	Active              bool      `gorm:"not null;default:true" json:"active"`
+	CreatedAt           time.Time `json:"created_at"`
+	UpdatedAt           time.Time `json:"updated_at"`
+}
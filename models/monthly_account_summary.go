@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+// MonthlyAccountSummaryLine is one (type, category) breakdown within a
+// MonthlyAccountSummary.
+type MonthlyAccountSummaryLine struct {
+	Type     TransactionType `bson:"type" json:"type"`
+	Category string          `bson:"category,omitempty" json:"category,omitempty"`
+	Count    int64           `bson:"count" json:"count"`
+	Total    int64           `bson:"total" json:"total"`
+	Min      int64           `bson:"min" json:"min"`
+	Max      int64           `bson:"max" json:"max"`
+}
+
+// MonthlyAccountSummary is a precomputed month of an account's activity,
+// broken down by transaction type and category, persisted to MongoDB so
+// month-view screens can be served without re-aggregating raw transaction
+// log history on every request. ID is deterministic
+// ("tenantID:accountID:month") so a rerun of the job for the same month
+// overwrites rather than duplicates.
+type MonthlyAccountSummary struct {
+	ID         string                      `bson:"_id" json:"id"`
+	TenantID   string                      `bson:"tenant_id" json:"tenant_id"`
+	AccountID  string                      `bson:"account_id" json:"account_id"`
+	Month      string                      `bson:"month" json:"month"`
+	Lines      []MonthlyAccountSummaryLine `bson:"lines" json:"lines"`
+	ComputedAt time.Time                   `bson:"computed_at" json:"computed_at"`
+}
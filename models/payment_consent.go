@@ -0,0 +1,35 @@
+package models
+
+import "time"
+
+// PaymentConsentStatus is the lifecycle state of a PaymentConsent.
+type PaymentConsentStatus string
+
+const (
+	PaymentConsentAwaitingAuthorisation PaymentConsentStatus = "awaiting_authorisation"
+	PaymentConsentAuthorised            PaymentConsentStatus = "authorised"
+	PaymentConsentRejected              PaymentConsentStatus = "rejected"
+	PaymentConsentExpired               PaymentConsentStatus = "expired"
+)
+
+// PaymentConsent is a third party's request, under PSD2's payment
+// initiation model, to move funds out of a customer's account. It is
+// created awaiting the customer's own confirmation and does not become a
+// posted Transaction until that confirmation arrives; it can be
+// confirmed at most once (see PaymentInitiationService.Confirm).
+type PaymentConsent struct {
+	ID                    string               `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID              string               `gorm:"type:uuid;not null;index:idx_payment_consents_tenant" json:"tenant_id"`
+	UserID                string               `gorm:"type:uuid;not null" json:"user_id"`
+	ThirdPartyName        string               `gorm:"not null" json:"third_party_name"`
+	AccountID             string               `gorm:"type:uuid;not null" json:"account_id"`
+	CounterpartyAccountID string               `gorm:"type:uuid;not null" json:"counterparty_account_id"`
+	Amount                int64                `gorm:"not null" json:"amount"`
+	Currency              string               `gorm:"not null" json:"currency"`
+	Reference             string               `json:"reference,omitempty"`
+	Status                PaymentConsentStatus `gorm:"not null;default:awaiting_authorisation" json:"status"`
+	TransactionID         string               `gorm:"type:uuid" json:"transaction_id,omitempty"`
+	ExpiresAt             time.Time            `json:"expires_at"`
+	CreatedAt             time.Time            `json:"created_at"`
+	UpdatedAt             time.Time            `json:"updated_at"`
+}
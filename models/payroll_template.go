@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// PayrollTemplate is a business account's recurring payroll definition: a
+// fixed list of employee accounts and amounts, run automatically as a
+// bulk transfer on PayDay each month.
+type PayrollTemplate struct {
+	ID        string     `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID  string     `gorm:"type:uuid;not null;index:idx_payroll_templates_tenant" json:"tenant_id"`
+	AccountID string     `gorm:"type:uuid;not null" json:"account_id"`
+	Name      string     `gorm:"not null" json:"name"`
+	PayDay    int        `gorm:"not null" json:"pay_day"`
+	Active    bool       `gorm:"not null;default:true" json:"active"`
+	LastRunAt *time.Time `json:"last_run_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
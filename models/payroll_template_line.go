@@ -0,0 +1,11 @@
+package models
+
+// PayrollTemplateLine is a single employee-account/amount pair within a
+// PayrollTemplate.
+type PayrollTemplateLine struct {
+	ID                string `gorm:"type:uuid;primaryKey" json:"id"`
+	TemplateID        string `gorm:"type:uuid;not null;index:idx_payroll_template_lines_template" json:"template_id"`
+	TenantID          string `gorm:"type:uuid;not null" json:"tenant_id"`
+	EmployeeAccountID string `gorm:"type:uuid;not null" json:"employee_account_id"`
+	Amount            int64  `json:"amount"`
+}
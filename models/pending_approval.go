@@ -0,0 +1,30 @@
+package models
+
+import "time"
+
+// PendingApprovalStatus is the lifecycle state of a maker-checker approval.
+type PendingApprovalStatus string
+
+const (
+	PendingApprovalPending  PendingApprovalStatus = "pending"
+	PendingApprovalApproved PendingApprovalStatus = "approved"
+	PendingApprovalRejected PendingApprovalStatus = "rejected"
+)
+
+// PendingApproval holds a transaction that exceeded the tenant's
+// ApprovalThreshold until a second, different user approves or rejects it.
+type PendingApproval struct {
+	ID                    string                `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID              string                `gorm:"type:uuid;not null;index:idx_pending_approvals_tenant" json:"tenant_id"`
+	AccountID             string                `gorm:"type:uuid;not null" json:"account_id"`
+	CounterpartyAccountID string                `gorm:"type:uuid" json:"counterparty_account_id,omitempty"`
+	Type                  TransactionType       `gorm:"not null" json:"type"`
+	Amount                int64                 `gorm:"not null" json:"amount"`
+	BranchID              string                `gorm:"type:uuid" json:"branch_id,omitempty"`
+	MakerUserID           string                `gorm:"type:uuid;not null" json:"maker_user_id"`
+	CheckerUserID         string                `gorm:"type:uuid" json:"checker_user_id,omitempty"`
+	Status                PendingApprovalStatus `gorm:"not null;default:pending" json:"status"`
+	TransactionID         string                `gorm:"type:uuid" json:"transaction_id,omitempty"`
+	CreatedAt             time.Time             `json:"created_at"`
+	UpdatedAt             time.Time             `json:"updated_at"`
+}
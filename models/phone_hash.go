@@ -0,0 +1,20 @@
+package models
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// HashPhone returns the hex-encoded HMAC-SHA256 digest of a phone number,
+// in the same normalized form (e.g. E.164) it's stored under, keyed by
+// key. A phone number's keyspace is small enough to brute-force
+// entirely, so an unkeyed digest stored as a lookup column could be
+// reversed to real numbers by anyone who read it; keying the digest with
+// a secret only this server holds forecloses that without changing how
+// callers look a row up by phone.
+func HashPhone(phone string, key []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(phone))
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,27 @@
+package models
+
+import "time"
+
+// PhoneLoginStatus is the lifecycle state of a PhoneLoginRequest.
+type PhoneLoginStatus string
+
+const (
+	PhoneLoginPending  PhoneLoginStatus = "pending"
+	PhoneLoginVerified PhoneLoginStatus = "verified"
+)
+
+// PhoneLoginRequest is a one-time code sent to a user's registered phone to
+// authenticate them without a PIN or password. Verifying it consumes one
+// of a limited number of attempts; once those run out, or the request
+// expires, a fresh request must be raised after the cooldown enforced by
+// PhoneLoginService.
+type PhoneLoginRequest struct {
+	ID        string           `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID  string           `gorm:"type:uuid;not null;index:idx_phone_login_requests_tenant" json:"tenant_id"`
+	UserID    string           `gorm:"type:uuid;not null;index:idx_phone_login_requests_tenant_user" json:"user_id"`
+	Code      string           `gorm:"not null" json:"-"`
+	Attempts  int              `gorm:"not null;default:0" json:"attempts"`
+	Status    PhoneLoginStatus `gorm:"not null;default:pending" json:"status"`
+	ExpiresAt time.Time        `json:"expires_at"`
+	CreatedAt time.Time        `json:"created_at"`
+}
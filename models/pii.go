@@ -0,0 +1,11 @@
+package models
+
+// PhoneCodec encrypts and decrypts a User's Phone field for storage,
+// implemented by services.PIIEncryptionService and injected into
+// UserRepository so persistence, not every caller, owns the boundary
+// between the plaintext callers work with and the ciphertext actually
+// stored in Postgres.
+type PhoneCodec interface {
+	EncryptPhone(plaintext string) (ciphertext string, err error)
+	DecryptPhone(ciphertext string) (plaintext string, err error)
+}
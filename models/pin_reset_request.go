@@ -0,0 +1,26 @@
+package models
+
+import "time"
+
+// PINResetStatus is the lifecycle state of a PINResetRequest.
+type PINResetStatus string
+
+const (
+	PINResetPending  PINResetStatus = "pending"
+	PINResetVerified PINResetStatus = "verified"
+)
+
+// PINResetRequest is a one-time code sent to a user's registered phone to
+// authorize setting a new PIN. Verifying it consumes one of a limited
+// number of attempts; once those run out, or the request expires, a fresh
+// request must be raised after the cooldown enforced by PINService.
+type PINResetRequest struct {
+	ID        string         `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID  string         `gorm:"type:uuid;not null;index:idx_pin_reset_requests_tenant" json:"tenant_id"`
+	UserID    string         `gorm:"type:uuid;not null;index:idx_pin_reset_requests_tenant_user" json:"user_id"`
+	Code      string         `gorm:"not null" json:"-"`
+	Attempts  int            `gorm:"not null;default:0" json:"attempts"`
+	Status    PINResetStatus `gorm:"not null;default:pending" json:"status"`
+	ExpiresAt time.Time      `json:"expires_at"`
+	CreatedAt time.Time      `json:"created_at"`
+}
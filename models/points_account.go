@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+// PointsAccount is a user's rewards points balance. There is at most one row
+// per (tenant, user); it is created lazily the first time points are
+// accrued or redeemed.
+type PointsAccount struct {
+	ID        string    `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID  string    `gorm:"type:uuid;not null;uniqueIndex:idx_points_accounts_tenant_user" json:"tenant_id"`
+	UserID    string    `gorm:"type:uuid;not null;uniqueIndex:idx_points_accounts_tenant_user" json:"user_id"`
+	Balance   int64     `gorm:"not null;default:0" json:"balance"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// PointsLedgerEntry is an immutable record of a single change to a user's
+// rewards points balance, mirroring how Transaction records every change to
+// an account's money balance.
+type PointsLedgerEntry struct {
+	ID            string    `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID      string    `gorm:"type:uuid;not null;index:idx_points_ledger_tenant" json:"tenant_id"`
+	UserID        string    `gorm:"type:uuid;not null;index:idx_points_ledger_user" json:"user_id"`
+	TransactionID string    `gorm:"type:uuid" json:"transaction_id,omitempty"`
+	Points        int64     `gorm:"not null" json:"points"`
+	Reason        string    `gorm:"not null" json:"reason"`
+	CreatedAt     time.Time `json:"created_at"`
+}
@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+// ProcessedTransaction records that an idempotency key has already been
+// posted, so a redelivered request for the same key (e.g. a message
+// consumer crashing between commit and ack) can be detected and short-
+// circuited instead of double-applying the transaction.
+type ProcessedTransaction struct {
+	ID             string    `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID       string    `gorm:"type:uuid;not null;uniqueIndex:idx_processed_transactions_tenant_key" json:"tenant_id"`
+	IdempotencyKey string    `gorm:"not null;uniqueIndex:idx_processed_transactions_tenant_key" json:"idempotency_key"`
+	TransactionID  string    `gorm:"type:uuid;not null" json:"transaction_id"`
+	CreatedAt      time.Time `json:"created_at"`
+}
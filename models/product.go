@@ -0,0 +1,57 @@
+package models
+
+import "time"
+
+// CompoundingFrequency is how often interest compounds on a Product's
+// balance.
+type CompoundingFrequency string
+
+const (
+	CompoundingDaily     CompoundingFrequency = "daily"
+	CompoundingMonthly   CompoundingFrequency = "monthly"
+	CompoundingQuarterly CompoundingFrequency = "quarterly"
+	CompoundingAnnually  CompoundingFrequency = "annually"
+)
+
+// DayCountConvention is how a Product's InterestRateBps is prorated down
+// to the fraction of a year one compounding period covers.
+type DayCountConvention string
+
+const (
+	// DayCountActual365 prorates by the period's actual day count over a
+	// 365-day year.
+	DayCountActual365 DayCountConvention = "actual_365"
+	// DayCountActual360 prorates by the period's actual day count over a
+	// 360-day year, the money-market convention.
+	DayCountActual360 DayCountConvention = "actual_360"
+	// DayCount30360 prorates using the bond-market convention of treating
+	// every month as exactly 30 days over a 360-day year.
+	DayCount30360 DayCountConvention = "30_360"
+)
+
+// Product is a savings/deposit product an admin has defined for a tenant.
+// Accounts reference a Product by ID so changing its terms (rate,
+// penalties) applies to every account on it without a code change.
+//
+// Products are versioned: revising a product's terms never mutates the row
+// an existing account references. Instead it deactivates that row and
+// inserts a new one sharing the same FamilyID with Version incremented, so
+// existing accounts keep the exact terms they signed up under while new
+// accounts open against the latest active version.
+type Product struct {
+	ID                   string               `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID             string               `gorm:"type:uuid;not null;index:idx_products_tenant" json:"tenant_id"`
+	FamilyID             string               `gorm:"type:uuid;not null;index:idx_products_family" json:"family_id"`
+	Version              int                  `gorm:"not null;default:1" json:"version"`
+	Active               bool                 `gorm:"not null;default:true" json:"active"`
+	Name                 string               `gorm:"not null" json:"name"`
+	InterestRateBps      int                  `gorm:"not null;default:0" json:"interest_rate_bps"`
+	CompoundingFrequency CompoundingFrequency `gorm:"not null;default:monthly" json:"compounding_frequency"`
+	DayCountConvention   DayCountConvention   `gorm:"not null;default:actual_365" json:"day_count_convention"`
+	MinimumBalance       int64                `gorm:"not null;default:0" json:"minimum_balance"`
+	WithdrawalPenaltyBps int                  `gorm:"not null;default:0" json:"withdrawal_penalty_bps"`
+	MinimumTier          UserTier             `gorm:"not null;default:basic" json:"minimum_tier"`
+	MinimumKYCLevel      int                  `gorm:"not null;default:0" json:"minimum_kyc_level"`
+	CreatedAt            time.Time            `json:"created_at"`
+	UpdatedAt            time.Time            `json:"updated_at"`
+}
@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+// QueueGlobal is the QueuePauseState key that pauses every transaction
+// type's queue at once, regardless of what other queues are individually
+// paused or resumed.
+const QueueGlobal = "global"
+
+// QueuePauseState is a persisted, platform-wide pause flag for outbound
+// transaction event publishing, either QueueGlobal or scoped to one
+// transaction type (e.g. "withdrawal"), so an operator can pause
+// withdrawals during a fraud incident while deposits keep flowing. There
+// is no separate consumer process in this codebase to pause directly;
+// pausing here gates PausableEventPublisher's publish attempts, which
+// then fail into the same dead-letter path a broker outage would.
+type QueuePauseState struct {
+	Queue     string    `gorm:"primaryKey" json:"queue"`
+	Paused    bool      `gorm:"not null;default:false" json:"paused"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -0,0 +1,25 @@
+package models
+
+import "time"
+
+// ReferralStatus is the lifecycle state of a Referral.
+type ReferralStatus string
+
+const (
+	ReferralPending  ReferralStatus = "pending"
+	ReferralRewarded ReferralStatus = "rewarded"
+)
+
+// Referral attributes a new signup to the referrer whose code they supplied,
+// and tracks whether the signup bonus has been paid out yet. The bonus is
+// only paid once the referred user completes a qualifying transaction, so a
+// signup alone never earns a reward.
+type Referral struct {
+	ID             string         `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID       string         `gorm:"type:uuid;not null;index:idx_referrals_tenant" json:"tenant_id"`
+	ReferrerUserID string         `gorm:"type:uuid;not null;index:idx_referrals_referrer" json:"referrer_user_id"`
+	ReferredUserID string         `gorm:"type:uuid;not null;uniqueIndex:idx_referrals_referred" json:"referred_user_id"`
+	Status         ReferralStatus `gorm:"not null;default:pending" json:"status"`
+	CreatedAt      time.Time      `json:"created_at"`
+	RewardedAt     *time.Time     `json:"rewarded_at,omitempty"`
+}
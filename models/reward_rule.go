@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// RewardRule configures how many points a qualifying transaction earns.
+// Category and Merchant are matchers: an empty value matches anything, so a
+// tenant can define a blanket "earn on everything" rule alongside more
+// specific overrides for particular merchants or categories.
+type RewardRule struct {
+	ID            string    `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID      string    `gorm:"type:uuid;not null;index:idx_reward_rules_tenant" json:"tenant_id"`
+	Category      string    `json:"category,omitempty"`
+	Merchant      string    `json:"merchant,omitempty"`
+	PointsRateBps int       `gorm:"not null;default:0" json:"points_rate_bps"`
+	Active        bool      `gorm:"not null;default:true" json:"active"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+// SecurityEventType enumerates the kinds of security-relevant occurrences
+// surfaced at /admin/security.
+type SecurityEventType string
+
+const (
+	// SecurityEventIPBlocked is recorded when a request is rejected
+	// because its IP address fell outside the calling tenant's allowlist.
+	SecurityEventIPBlocked SecurityEventType = "ip_blocked"
+)
+
+// SecurityEvent is a platform-wide, append-only record of security-
+// relevant request rejections, for operators to review across tenants.
+type SecurityEvent struct {
+	ID        string            `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID  string            `gorm:"type:uuid;not null;index:idx_security_events_tenant" json:"tenant_id"`
+	Type      SecurityEventType `gorm:"not null" json:"type"`
+	IPAddress string            `gorm:"not null" json:"ip_address"`
+	Detail    string            `json:"detail"`
+	CreatedAt time.Time         `json:"created_at"`
+}
@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// Session records one user/device pair's activity under the interim
+// header-based auth, so a user or admin can see active sessions and, if
+// one looks compromised, revoke it immediately.
+type Session struct {
+	ID         string     `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID   string     `gorm:"type:uuid;not null;uniqueIndex:idx_sessions_tenant_user_device" json:"tenant_id"`
+	UserID     string     `gorm:"type:uuid;not null;uniqueIndex:idx_sessions_tenant_user_device" json:"user_id"`
+	DeviceID   string     `gorm:"not null;uniqueIndex:idx_sessions_tenant_user_device" json:"device_id"`
+	IPAddress  string     `json:"ip_address"`
+	UserAgent  string     `json:"user_agent"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	LastSeenAt time.Time  `json:"last_seen_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
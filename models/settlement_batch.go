@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// SettlementBatch is the report row produced by one nightly settlement
+// run for a single merchant: its gross receipts, the fee withheld, and
+// the net transfer posted to its settlement account.
+type SettlementBatch struct {
+	ID                      string    `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID                string    `gorm:"type:uuid;not null;index:idx_settlement_batches_tenant" json:"tenant_id"`
+	MerchantID              string    `gorm:"type:uuid;not null;index:idx_settlement_batches_merchant" json:"merchant_id"`
+	TransactionCount        int       `gorm:"not null" json:"transaction_count"`
+	GrossAmount             int64     `gorm:"not null" json:"gross_amount"`
+	FeeAmount               int64     `gorm:"not null" json:"fee_amount"`
+	NetAmount               int64     `gorm:"not null" json:"net_amount"`
+	SettlementTransactionID string    `gorm:"type:uuid" json:"settlement_transaction_id,omitempty"`
+	CreatedAt               time.Time `json:"created_at"`
+}
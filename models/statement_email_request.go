@@ -0,0 +1,27 @@
+package models
+
+import "time"
+
+// StatementEmailStatus is the outcome of a statement email delivery
+// attempt.
+type StatementEmailStatus string
+
+const (
+	StatementEmailSent   StatementEmailStatus = "sent"
+	StatementEmailFailed StatementEmailStatus = "failed"
+)
+
+// StatementEmailRequest records a user's request to have a statement for a
+// given period generated and emailed to them, and what happened when
+// delivery was attempted.
+type StatementEmailRequest struct {
+	ID            string               `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID      string               `gorm:"type:uuid;not null;index:idx_statement_email_requests_tenant" json:"tenant_id"`
+	AccountID     string               `gorm:"type:uuid;not null;index:idx_statement_email_requests_account" json:"account_id"`
+	UserID        string               `gorm:"type:uuid;not null" json:"user_id"`
+	From          time.Time            `gorm:"not null" json:"from"`
+	To            time.Time            `gorm:"not null" json:"to"`
+	Status        StatementEmailStatus `gorm:"not null" json:"status"`
+	FailureReason string               `json:"failure_reason,omitempty"`
+	CreatedAt     time.Time            `json:"created_at"`
+}
@@ -0,0 +1,39 @@
+package models
+
+import "time"
+
+// StepUpChallengeStatus is the lifecycle state of a StepUpChallenge.
+type StepUpChallengeStatus string
+
+const (
+	StepUpChallengePending  StepUpChallengeStatus = "pending"
+	StepUpChallengeVerified StepUpChallengeStatus = "verified"
+)
+
+// StepUpChallenge holds a transaction that cleared the tenant's
+// StepUpThreshold until its OTP is verified via POST /transaction/confirm.
+// Its fields mirror what services.TransactionInput needs to post it once
+// verified, so a confirmed transaction is indistinguishable from one that
+// posted immediately.
+type StepUpChallenge struct {
+	ID                    string                `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID              string                `gorm:"type:uuid;not null;index:idx_step_up_challenges_tenant" json:"tenant_id"`
+	AccountID             string                `gorm:"type:uuid;not null" json:"account_id"`
+	CounterpartyAccountID string                `gorm:"type:uuid" json:"counterparty_account_id,omitempty"`
+	Type                  TransactionType       `gorm:"not null" json:"type"`
+	Amount                int64                 `gorm:"not null" json:"amount"`
+	Currency              string                `json:"currency,omitempty"`
+	BranchID              string                `gorm:"type:uuid" json:"branch_id,omitempty"`
+	PerformedByUserID     string                `gorm:"type:uuid" json:"performed_by_user_id,omitempty"`
+	ClientIP              string                `json:"client_ip,omitempty"`
+	Category              string                `json:"category,omitempty"`
+	Merchant              string                `json:"merchant,omitempty"`
+	RequestID             string                `json:"-"`
+	CorrelationID         string                `json:"-"`
+	IdempotencyKey        string                `json:"-"`
+	Code                  string                `gorm:"not null" json:"-"`
+	Attempts              int                   `gorm:"not null;default:0" json:"attempts"`
+	Status                StepUpChallengeStatus `gorm:"not null;default:pending" json:"status"`
+	ExpiresAt             time.Time             `json:"expires_at"`
+	CreatedAt             time.Time             `json:"created_at"`
+}
@@ -0,0 +1,36 @@
+package models
+
+import "time"
+
+// SuspenseItemStatus is the lifecycle of a SuspenseItem.
+type SuspenseItemStatus string
+
+const (
+	SuspenseItemOpen     SuspenseItemStatus = "open"
+	SuspenseItemMatched  SuspenseItemStatus = "matched"
+	SuspenseItemReturned SuspenseItemStatus = "returned"
+)
+
+// SuspenseItem is an inbound external credit that couldn't be matched to
+// a known account when it arrived, so it posted to the suspense GL
+// account instead of failing outright. It sits Open until an admin
+// matches it to the right customer account or returns it to the sender.
+type SuspenseItem struct {
+	ID       string `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID string `gorm:"type:uuid;not null;index:idx_suspense_items_tenant" json:"tenant_id"`
+	Amount   int64  `gorm:"not null" json:"amount"`
+	Currency string `gorm:"not null" json:"currency"`
+	// Reference is whatever account identifier the inbound credit named
+	// that this codebase couldn't resolve, kept for the admin doing the
+	// matching to investigate.
+	Reference       string             `json:"reference,omitempty"`
+	ExternalEventID string             `json:"external_event_id,omitempty"`
+	Status          SuspenseItemStatus `gorm:"not null;default:open" json:"status"`
+
+	MatchedAccountID     string `gorm:"type:uuid" json:"matched_account_id,omitempty"`
+	MatchedTransactionID string `gorm:"type:uuid" json:"matched_transaction_id,omitempty"`
+	ReturnReason         string `json:"return_reason,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -0,0 +1,26 @@
+package models
+
+import "time"
+
+// TellerSessionStatus is the lifecycle state of a TellerSession.
+type TellerSessionStatus string
+
+const (
+	TellerSessionOpen   TellerSessionStatus = "open"
+	TellerSessionClosed TellerSessionStatus = "closed"
+)
+
+// TellerSession tracks a single agent's cash-drawer session at a branch,
+// bounded by an opening and closing balance so the end-of-day report can be
+// reconciled against physical cash counted at close.
+type TellerSession struct {
+	ID             string              `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID       string              `gorm:"type:uuid;not null;index:idx_teller_sessions_tenant" json:"tenant_id"`
+	BranchID       string              `gorm:"type:uuid;not null;index:idx_teller_sessions_branch" json:"branch_id"`
+	AgentID        string              `gorm:"type:uuid;not null;index:idx_teller_sessions_agent" json:"agent_id"`
+	Status         TellerSessionStatus `gorm:"not null;default:open" json:"status"`
+	OpeningBalance int64               `json:"opening_balance"`
+	ClosingBalance int64               `json:"closing_balance"`
+	OpenedAt       time.Time           `json:"opened_at"`
+	ClosedAt       *time.Time          `json:"closed_at,omitempty"`
+}
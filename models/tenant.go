@@ -0,0 +1,30 @@
+package models
+
+import "time"
+
+// Tenant represents a bank brand hosted on the shared platform. Every
+// tenant-scoped row across the schema carries a TenantID that must match
+// one of these records.
+type Tenant struct {
+	ID              string `gorm:"type:uuid;primaryKey" json:"id"`
+	Name            string `gorm:"not null" json:"name"`
+	APIKey          string `gorm:"uniqueIndex;not null" json:"-"`
+	DefaultCurrency string `gorm:"not null;default:USD" json:"default_currency"`
+	BrandingName    string `json:"branding_name"`
+	BrandingLogoURL string `json:"branding_logo_url"`
+	// ApprovalThreshold is the minor-units amount at or above which a
+	// transaction requires maker-checker approval before it posts. Zero
+	// disables dual control for the tenant.
+	ApprovalThreshold int64 `gorm:"not null;default:0" json:"approval_threshold"`
+	// SignatureThreshold is the minor-units amount at or above which a
+	// transfer or withdrawal must carry a signature from a registered
+	// DeviceKey. Zero disables the requirement for the tenant.
+	SignatureThreshold int64 `gorm:"not null;default:0" json:"signature_threshold"`
+	// StepUpThreshold is the minor-units amount at or above which a
+	// self-service transaction is held behind an OTP challenge until
+	// confirmed via POST /transaction/confirm, instead of posting
+	// immediately. Zero disables the requirement for the tenant.
+	StepUpThreshold int64     `gorm:"not null;default:0" json:"step_up_threshold"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
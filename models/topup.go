@@ -0,0 +1,25 @@
+package models
+
+import "time"
+
+// TopupStatus is the outcome of a TopUp purchase attempt.
+type TopupStatus string
+
+const (
+	TopupSucceeded TopupStatus = "succeeded"
+	TopupFailed    TopupStatus = "failed"
+)
+
+// TopUp records an airtime/mobile top-up purchase against an account.
+type TopUp struct {
+	ID            string      `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID      string      `gorm:"type:uuid;not null;index:idx_topups_tenant" json:"tenant_id"`
+	AccountID     string      `gorm:"type:uuid;not null;index:idx_topups_account" json:"account_id"`
+	PhoneNumber   string      `gorm:"not null" json:"phone_number"`
+	Amount        int64       `gorm:"not null" json:"amount"`
+	Status        TopupStatus `gorm:"not null" json:"status"`
+	ProviderRef   string      `json:"provider_ref,omitempty"`
+	FailureReason string      `json:"failure_reason,omitempty"`
+	TransactionID string      `gorm:"type:uuid" json:"transaction_id,omitempty"`
+	CreatedAt     time.Time   `json:"created_at"`
+}
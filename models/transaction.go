@@ -0,0 +1,50 @@
+package models
+
+import "time"
+
+// TransactionStatus is the lifecycle state of a Transaction.
+type TransactionStatus string
+
+const (
+	TransactionStatusPosted   TransactionStatus = "posted"
+	TransactionStatusReversed TransactionStatus = "reversed"
+)
+
+// Transaction is the system-of-record row for a single balance-affecting
+// operation. It is written inside the same DB transaction that updates the
+// account balance, so it can be trusted for reconciliation even if the
+// Mongo-backed TransactionLog write fails.
+type Transaction struct {
+	ID                    string            `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID              string            `gorm:"type:uuid;not null;index:idx_transactions_tenant" json:"tenant_id"`
+	AccountID             string            `gorm:"type:uuid;not null;index:idx_transactions_account" json:"account_id"`
+	CounterpartyAccountID string            `gorm:"type:uuid" json:"counterparty_account_id,omitempty"`
+	Type                  TransactionType   `gorm:"not null" json:"type"`
+	Amount                int64             `gorm:"not null" json:"amount"`
+	BalanceAfter          int64             `gorm:"not null" json:"balance_after"`
+	Currency              string            `gorm:"not null" json:"currency"`
+	Status                TransactionStatus `gorm:"not null;default:posted" json:"status"`
+	BranchID              string            `gorm:"type:uuid;index:idx_transactions_branch" json:"branch_id,omitempty"`
+	PerformedByUserID     string            `gorm:"type:uuid" json:"performed_by_user_id,omitempty"`
+	ClientIP              string            `json:"client_ip,omitempty"`
+	RiskScore             int               `gorm:"not null;default:0" json:"risk_score"`
+	ReasonCode            string            `json:"reason_code,omitempty"`
+	Justification         string            `json:"justification,omitempty"`
+	Category              string            `json:"category,omitempty"`
+	Merchant              string            `json:"merchant,omitempty"`
+	// Description is the human-readable line a system-generated posting
+	// (a fee, interest accrual, reversal, or reward) renders from its
+	// ReasonCode via services.DescriptionTemplateService, in the locale
+	// the request was posted with. Empty for transactions posted before
+	// this system existed, and for ones with no ReasonCode to render
+	// from.
+	Description string `json:"description,omitempty"`
+	Settled     bool   `gorm:"not null;default:false" json:"settled"`
+	// ValueDate is when this transaction's funds are considered to have
+	// moved for interest and statement purposes, which can be later than
+	// CreatedAt if it was submitted after its type's configured cut-off or
+	// on a non-business day. Nil for a transaction posted while no
+	// CutoffService was wired up.
+	ValueDate *time.Time `gorm:"type:date" json:"value_date,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
@@ -0,0 +1,75 @@
+package models
+
+import "time"
+
+// TransactionType enumerates the kinds of ledger movements recorded in the
+// transaction log.
+type TransactionType string
+
+const (
+	TransactionTypeDeposit          TransactionType = "deposit"
+	TransactionTypeWithdrawal       TransactionType = "withdrawal"
+	TransactionTypeTransfer         TransactionType = "transfer"
+	TransactionTypeAdjustmentCredit TransactionType = "adjustment_credit"
+	TransactionTypeAdjustmentDebit  TransactionType = "adjustment_debit"
+)
+
+// TransactionLogDirection is which way a TransactionLog entry moved money
+// relative to the account it's filed under.
+type TransactionLogDirection string
+
+const (
+	TransactionLogDirectionDebit  TransactionLogDirection = "debit"
+	TransactionLogDirectionCredit TransactionLogDirection = "credit"
+)
+
+// TransactionLog is an append-only record of every balance-affecting
+// operation, persisted to MongoDB for cheap high-volume writes and flexible
+// querying. A transfer produces one entry per account involved, so each
+// account's history reads correctly on its own: Direction, relative to
+// AccountID, and CounterpartyAccountID/CounterpartyName describe the other
+// side.
+type TransactionLog struct {
+	ID         string          `bson:"_id" json:"id"`
+	TenantID   string          `bson:"tenant_id" json:"tenant_id"`
+	AccountID  string          `bson:"account_id" json:"account_id"`
+	Type       TransactionType `bson:"type" json:"type"`
+	Amount     int64           `bson:"amount" json:"amount"`
+	Currency   string          `bson:"currency" json:"currency"`
+	Status     string          `bson:"status" json:"status"`
+	RiskScore  int             `bson:"risk_score" json:"risk_score"`
+	ReasonCode string          `bson:"reason_code,omitempty" json:"reason_code,omitempty"`
+
+	// Direction is which way this entry moved money relative to AccountID.
+	Direction TransactionLogDirection `bson:"direction" json:"direction"`
+
+	// CounterpartyAccountID and CounterpartyName identify the other side
+	// of a transfer; both are empty for a deposit, withdrawal, or
+	// adjustment, which have no counterparty account.
+	CounterpartyAccountID string `bson:"counterparty_account_id,omitempty" json:"counterparty_account_id,omitempty"`
+	CounterpartyName      string `bson:"counterparty_name,omitempty" json:"counterparty_name,omitempty"`
+
+	// Category mirrors the posted Transaction's Category, if any, so the
+	// monthly summary job can break spending down by category without
+	// re-reading Postgres.
+	Category string `bson:"category,omitempty" json:"category,omitempty"`
+
+	// Merchant mirrors the posted Transaction's Merchant, if any. Together
+	// with ReasonCode and CorrelationID it's the closest thing this log has
+	// to a free-text description, counterparty name, or reference number,
+	// and is what the full-text search index is built over.
+	Merchant string `bson:"merchant,omitempty" json:"merchant,omitempty"`
+
+	// CorrelationID mirrors the request's X-Correlation-ID header, if any,
+	// so a support engineer can trace a customer's app log line through to
+	// this exact entry.
+	CorrelationID string    `bson:"correlation_id,omitempty" json:"correlation_id,omitempty"`
+	CreatedAt     time.Time `bson:"created_at" json:"created_at"`
+
+	// MerchantCategory and OriginCountry are filled in after the entry is
+	// first written, by the asynchronous enrichment pipeline (see
+	// services.LogEnrichmentService), so both are empty until an
+	// enricher has run and found something to report.
+	MerchantCategory string `bson:"merchant_category,omitempty" json:"merchant_category,omitempty"`
+	OriginCountry    string `bson:"origin_country,omitempty" json:"origin_country,omitempty"`
+}
@@ -0,0 +1,39 @@
+package models
+
+import "time"
+
+// TransferQuoteStatus is the lifecycle state of a TransferQuote.
+type TransferQuoteStatus string
+
+const (
+	TransferQuotePending  TransferQuoteStatus = "pending"
+	TransferQuoteExecuted TransferQuoteStatus = "executed"
+	TransferQuoteExpired  TransferQuoteStatus = "expired"
+)
+
+// TransferQuote locks in the FX rate, fee, and resulting target amount for
+// a cross-currency external transfer, so the customer can see exactly
+// what will be debited and delivered before they commit. Executing the
+// quote must honor these amounts exactly or fail with ErrTransferQuoteExpired,
+// forcing the caller to fetch a fresh quote rather than post at a stale
+// rate; it can be executed at most once (see TransferQuoteService.Execute).
+type TransferQuote struct {
+	ID                        string              `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID                  string              `gorm:"type:uuid;not null;index:idx_transfer_quotes_tenant" json:"tenant_id"`
+	AccountID                 string              `gorm:"type:uuid;not null" json:"account_id"`
+	CounterpartyName          string              `gorm:"not null" json:"counterparty_name"`
+	CounterpartyRoutingNumber string              `gorm:"not null" json:"counterparty_routing_number"`
+	CounterpartyAccountNumber string              `gorm:"not null" json:"counterparty_account_number"`
+	SourceCurrency            string              `gorm:"not null" json:"source_currency"`
+	TargetCurrency            string              `gorm:"not null" json:"target_currency"`
+	SourceAmount              int64               `gorm:"not null" json:"source_amount"`
+	Fee                       int64               `gorm:"not null" json:"fee"`
+	TargetAmount              int64               `gorm:"not null" json:"target_amount"`
+	Rate                      float64             `gorm:"not null" json:"rate"`
+	EstimatedArrival          time.Time           `json:"estimated_arrival"`
+	Status                    TransferQuoteStatus `gorm:"not null;default:pending" json:"status"`
+	ExternalTransferID        string              `gorm:"type:uuid" json:"external_transfer_id,omitempty"`
+	ExpiresAt                 time.Time           `json:"expires_at"`
+	CreatedAt                 time.Time           `json:"created_at"`
+	UpdatedAt                 time.Time           `json:"updated_at"`
+}
@@ -0,0 +1,25 @@
+package models
+
+import "time"
+
+// TrustedDevice records a device that has passed a step-up challenge for a
+// given user and is no longer subject to it.
+type TrustedDevice struct {
+	ID       string `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID string `gorm:"type:uuid;not null;uniqueIndex:idx_trusted_devices_tenant_user_device" json:"tenant_id"`
+	UserID   string `gorm:"type:uuid;not null;uniqueIndex:idx_trusted_devices_tenant_user_device" json:"user_id"`
+	DeviceID string `gorm:"not null;uniqueIndex:idx_trusted_devices_tenant_user_device" json:"device_id"`
+
+	// Fingerprint is the client-computed device fingerprint (e.g. hashed
+	// hardware/browser attributes) supplied at trust time, kept alongside
+	// the caller-chosen DeviceID so a device that's been wiped and re-sent
+	// a new DeviceID can still be recognized as the same physical device.
+	Fingerprint string `json:"fingerprint,omitempty"`
+
+	// PushToken is the device's current push-notification registration
+	// token, set via DeviceController.RegisterPushToken once the client
+	// has one, for delivering step-up and alert pushes to this device.
+	PushToken  string    `json:"-"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	CreatedAt  time.Time `json:"created_at"`
+}
@@ -0,0 +1,67 @@
+package models
+
+import "time"
+
+// Role identifies what a User is allowed to do.
+type Role string
+
+const (
+	// RoleCustomer is a regular banking customer.
+	RoleCustomer Role = "customer"
+	// RoleAgent is a branch teller/agent who can act on behalf of
+	// customers within their branch.
+	RoleAgent Role = "agent"
+	// RoleAdmin is a platform/tenant administrator.
+	RoleAdmin Role = "admin"
+)
+
+// UserTier ranks a customer for product eligibility, from the least to the
+// most privileged.
+type UserTier string
+
+const (
+	UserTierBasic   UserTier = "basic"
+	UserTierPlus    UserTier = "plus"
+	UserTierPremium UserTier = "premium"
+)
+
+// tierRank orders UserTier values for eligibility comparisons.
+var tierRank = map[UserTier]int{
+	UserTierBasic:   0,
+	UserTierPlus:    1,
+	UserTierPremium: 2,
+}
+
+// MeetsTier reports whether u's tier is at or above minimum. An unrecognized
+// tier value ranks below every known tier.
+func (u *User) MeetsTier(minimum UserTier) bool {
+	return tierRank[u.Tier] >= tierRank[minimum]
+}
+
+// User represents a customer, agent, or admin of a tenant bank.
+type User struct {
+	ID       string `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID string `gorm:"type:uuid;not null;uniqueIndex:idx_users_tenant_email;index:idx_users_tenant_referral_code,unique" json:"tenant_id"`
+	Name     string `gorm:"not null" json:"name"`
+	Email    string `gorm:"not null;uniqueIndex:idx_users_tenant_email" json:"email"`
+	// Phone is encrypted at rest when UserRepository is built with a
+	// PhoneCodec; PhoneHash is a deterministic digest of the plaintext
+	// (see HashPhone) stored alongside it so a phone number can still be
+	// looked up by exact match without decrypting every row.
+	Phone        string    `json:"phone"`
+	PhoneHash    string    `gorm:"index:idx_users_phone_hash" json:"-"`
+	Role         Role      `gorm:"not null;default:customer" json:"role"`
+	BranchID     string    `gorm:"type:uuid;index:idx_users_branch" json:"branch_id,omitempty"`
+	Tier         UserTier  `gorm:"not null;default:basic" json:"tier"`
+	KYCLevel     int       `gorm:"not null;default:0" json:"kyc_level"`
+	ReferralCode string    `gorm:"not null;index:idx_users_tenant_referral_code,unique" json:"referral_code"`
+	PINHash      string    `gorm:"column:pin_hash" json:"-"`
+	Locked       bool      `gorm:"not null;default:false" json:"locked"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// IsAgent reports whether the user is a branch agent.
+func (u *User) IsAgent() bool {
+	return u.Role == RoleAgent
+}
@@ -0,0 +1,38 @@
+package models
+
+import "time"
+
+// WebAuthnChallengePurpose is which ceremony a WebAuthnChallenge was
+// issued for; a challenge issued for one may not be consumed by the
+// other.
+type WebAuthnChallengePurpose string
+
+const (
+	WebAuthnChallengeRegistration WebAuthnChallengePurpose = "registration"
+	WebAuthnChallengeAssertion    WebAuthnChallengePurpose = "assertion"
+)
+
+// WebAuthnChallengeStatus is the lifecycle state of a WebAuthnChallenge.
+type WebAuthnChallengeStatus string
+
+const (
+	WebAuthnChallengePending  WebAuthnChallengeStatus = "pending"
+	WebAuthnChallengeVerified WebAuthnChallengeStatus = "verified"
+)
+
+// WebAuthnChallenge is a one-time random nonce a client's authenticator
+// must sign to complete registration or login, proving possession of
+// the credential's private key without it ever leaving the device.
+// Unlike DeviceChallenge and PhoneLoginRequest's codes, the challenge
+// itself is meant to be read back by the client, so it isn't hidden
+// from JSON.
+type WebAuthnChallenge struct {
+	ID        string                   `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID  string                   `gorm:"type:uuid;not null;index:idx_webauthn_challenges_tenant" json:"tenant_id"`
+	UserID    string                   `gorm:"type:uuid;not null" json:"user_id"`
+	Purpose   WebAuthnChallengePurpose `gorm:"not null" json:"purpose"`
+	Challenge string                   `gorm:"not null" json:"challenge"`
+	Status    WebAuthnChallengeStatus  `gorm:"not null;default:pending" json:"-"`
+	ExpiresAt time.Time                `json:"expires_at"`
+	CreatedAt time.Time                `json:"created_at"`
+}
@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// WebAuthnCredential is a registered platform authenticator (passkey)
+// for a user: the public half of a keypair whose private half never
+// leaves the authenticator. See services.WebAuthnService.
+type WebAuthnCredential struct {
+	ID       string `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID string `gorm:"type:uuid;not null;index:idx_webauthn_credentials_tenant_user" json:"tenant_id"`
+	UserID   string `gorm:"type:uuid;not null;index:idx_webauthn_credentials_tenant_user" json:"user_id"`
+	Name     string `gorm:"not null" json:"name"`
+
+	// PublicKey is the base64-encoded Ed25519 public key WebAuthnService
+	// verifies login and registration signatures against.
+	PublicKey  string    `gorm:"not null" json:"-"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+}
@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// WebhookEvent is a dedup ledger row recorded for every inbound webhook
+// delivery accepted from a WebhookProvider. The unique index on
+// (tenant_id, provider_id, external_event_id) lets WebhookService reject
+// a redelivered event without reapplying it.
+type WebhookEvent struct {
+	ID              string    `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID        string    `gorm:"type:uuid;not null;uniqueIndex:idx_webhook_events_dedup" json:"tenant_id"`
+	ProviderID      string    `gorm:"type:uuid;not null;uniqueIndex:idx_webhook_events_dedup" json:"provider_id"`
+	ExternalEventID string    `gorm:"not null;uniqueIndex:idx_webhook_events_dedup" json:"external_event_id"`
+	EventType       string    `gorm:"not null" json:"event_type"`
+	CreatedAt       time.Time `json:"created_at"`
+}
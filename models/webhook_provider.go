@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// WebhookProvider is an external data aggregator or payment processor
+// (e.g. an account-linking aggregator) registered to deliver inbound
+// webhooks to a tenant. Secret is the shared key used to verify the
+// HMAC-SHA256 signature on every delivery.
+type WebhookProvider struct {
+	ID        string    `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID  string    `gorm:"type:uuid;not null;uniqueIndex:idx_webhook_providers_tenant_name" json:"tenant_id"`
+	Name      string    `gorm:"not null;uniqueIndex:idx_webhook_providers_tenant_name" json:"name"`
+	Secret    string    `gorm:"not null" json:"-"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -0,0 +1,27 @@
+package models
+
+import "time"
+
+// WithdrawalCodeStatus is the lifecycle state of a WithdrawalCode.
+type WithdrawalCodeStatus string
+
+const (
+	WithdrawalCodePending  WithdrawalCodeStatus = "pending"
+	WithdrawalCodeRedeemed WithdrawalCodeStatus = "redeemed"
+	WithdrawalCodeExpired  WithdrawalCodeStatus = "expired"
+)
+
+// WithdrawalCode is a short-lived one-time code that lets a customer
+// withdraw cash at an ATM or agent without a card. Generating a code
+// places a hold on the account for Amount; redeeming it converts the hold
+// into a posted withdrawal.
+type WithdrawalCode struct {
+	ID        string               `gorm:"type:uuid;primaryKey" json:"id"`
+	TenantID  string               `gorm:"type:uuid;not null;uniqueIndex:idx_withdrawal_codes_tenant_code" json:"tenant_id"`
+	AccountID string               `gorm:"type:uuid;not null" json:"account_id"`
+	Code      string               `gorm:"not null;uniqueIndex:idx_withdrawal_codes_tenant_code" json:"code"`
+	Amount    int64                `gorm:"not null" json:"amount"`
+	Status    WithdrawalCodeStatus `gorm:"not null;default:pending" json:"status"`
+	ExpiresAt time.Time            `json:"expires_at"`
+	CreatedAt time.Time            `json:"created_at"`
+}
@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// AccountFreezeRepository provides tenant-scoped persistence for
+// AccountFreeze records.
+type AccountFreezeRepository struct {
+	db *gorm.DB
+}
+
+// NewAccountFreezeRepository builds an AccountFreezeRepository backed by
+// db.
+func NewAccountFreezeRepository(db *gorm.DB) *AccountFreezeRepository {
+	return &AccountFreezeRepository{db: db}
+}
+
+// Create persists a new account freeze.
+func (r *AccountFreezeRepository) Create(freeze *models.AccountFreeze) error {
+	return r.db.Create(freeze).Error
+}
+
+// FindActive returns the freeze currently in effect for accountID, scoped
+// to tenantID, or gorm.ErrRecordNotFound if the account isn't frozen.
+func (r *AccountFreezeRepository) FindActive(tenantID, accountID string, now time.Time) (*models.AccountFreeze, error) {
+	var freeze models.AccountFreeze
+	if err := r.db.Where("tenant_id = ? AND account_id = ? AND expires_at > ?", tenantID, accountID, now).
+		Order("expires_at desc").First(&freeze).Error; err != nil {
+		return nil, err
+	}
+	return &freeze, nil
+}
+
+// WithTx returns a copy of the repository bound to dbtx, for chaining
+// multiple tenant-scoped operations inside the same DB transaction.
+func (r *AccountFreezeRepository) WithTx(dbtx *gorm.DB) *AccountFreezeRepository {
+	return &AccountFreezeRepository{db: dbtx}
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// AccountRecoveryRequestRepository provides tenant-scoped persistence for
+// phone-recovery requests.
+type AccountRecoveryRequestRepository struct {
+	db *gorm.DB
+}
+
+// NewAccountRecoveryRequestRepository builds an
+// AccountRecoveryRequestRepository backed by db.
+func NewAccountRecoveryRequestRepository(db *gorm.DB) *AccountRecoveryRequestRepository {
+	return &AccountRecoveryRequestRepository{db: db}
+}
+
+// Create persists a new recovery request.
+func (r *AccountRecoveryRequestRepository) Create(request *models.AccountRecoveryRequest) error {
+	return r.db.Create(request).Error
+}
+
+// FindByID looks up a recovery request by ID, scoped to tenantID.
+func (r *AccountRecoveryRequestRepository) FindByID(tenantID, id string) (*models.AccountRecoveryRequest, error) {
+	var request models.AccountRecoveryRequest
+	if err := r.db.Where("tenant_id = ? AND id = ?", tenantID, id).First(&request).Error; err != nil {
+		return nil, err
+	}
+	return &request, nil
+}
+
+// List returns every recovery request for tenantID, most recent first, so
+// admins have a review queue.
+func (r *AccountRecoveryRequestRepository) List(tenantID string) ([]models.AccountRecoveryRequest, error) {
+	var requests []models.AccountRecoveryRequest
+	if err := r.db.Where("tenant_id = ?", tenantID).
+		Order("created_at desc").Find(&requests).Error; err != nil {
+		return nil, err
+	}
+	return requests, nil
+}
+
+// Deny records an admin's rejection of a request's evidence.
+func (r *AccountRecoveryRequestRepository) Deny(tenantID, id, reviewerUserID, reviewNote string) error {
+	return r.db.Model(&models.AccountRecoveryRequest{}).
+		Where("tenant_id = ? AND id = ?", tenantID, id).
+		Updates(map[string]interface{}{
+			"status":              models.AccountRecoveryDenied,
+			"reviewed_by_user_id": reviewerUserID,
+			"review_note":         reviewNote,
+		}).Error
+}
+
+// Approve records an admin's acceptance of a request's evidence and the
+// step-up code sent to the new phone number, moving the request into
+// AccountRecoveryStepUpSent.
+func (r *AccountRecoveryRequestRepository) Approve(tenantID, id, reviewerUserID, reviewNote, code string, expiresAt time.Time) error {
+	return r.db.Model(&models.AccountRecoveryRequest{}).
+		Where("tenant_id = ? AND id = ?", tenantID, id).
+		Updates(map[string]interface{}{
+			"status":              models.AccountRecoveryStepUpSent,
+			"reviewed_by_user_id": reviewerUserID,
+			"review_note":         reviewNote,
+			"code":                code,
+			"code_expires_at":     expiresAt,
+		}).Error
+}
+
+// MarkVerified marks a recovery request verified once the user proves
+// control of the new phone number.
+func (r *AccountRecoveryRequestRepository) MarkVerified(tenantID, id string) error {
+	return r.db.Model(&models.AccountRecoveryRequest{}).
+		Where("tenant_id = ? AND id = ?", tenantID, id).
+		Update("status", models.AccountRecoveryVerified).Error
+}
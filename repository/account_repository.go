@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// AccountRepository provides tenant-scoped persistence for Account records.
+type AccountRepository struct {
+	db *gorm.DB
+}
+
+// NewAccountRepository builds an AccountRepository backed by db.
+func NewAccountRepository(db *gorm.DB) *AccountRepository {
+	return &AccountRepository{db: db}
+}
+
+// Create persists a new account under the given tenant.
+func (r *AccountRepository) Create(account *models.Account) error {
+	return r.db.Create(account).Error
+}
+
+// FindByID looks up an account by ID, scoped to tenantID.
+func (r *AccountRepository) FindByID(tenantID, id string) (*models.Account, error) {
+	var account models.Account
+	if err := r.db.Where("tenant_id = ? AND id = ?", tenantID, id).First(&account).Error; err != nil {
+		return nil, err
+	}
+	return &account, nil
+}
+
+// ListByUser returns every account owned by userID within tenantID.
+func (r *AccountRepository) ListByUser(tenantID, userID string) ([]models.Account, error) {
+	var accounts []models.Account
+	if err := r.db.Where("tenant_id = ? AND user_id = ?", tenantID, userID).Find(&accounts).Error; err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+// ListByTenant returns every account within tenantID.
+func (r *AccountRepository) ListByTenant(tenantID string) ([]models.Account, error) {
+	var accounts []models.Account
+	if err := r.db.Where("tenant_id = ?", tenantID).Find(&accounts).Error; err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+// ListAll returns every account across every tenant, for background jobs
+// that sweep the whole ledger rather than a single tenant's slice of it.
+func (r *AccountRepository) ListAll() ([]models.Account, error) {
+	var accounts []models.Account
+	if err := r.db.Find(&accounts).Error; err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+// UpdateBalance sets the balance for an account, scoped to tenantID.
+func (r *AccountRepository) UpdateBalance(tenantID, id string, balance int64) error {
+	return r.db.Model(&models.Account{}).
+		Where("tenant_id = ? AND id = ?", tenantID, id).
+		Update("balance", balance).Error
+}
+
+// CreditBatch adds each account's delta to its balance in a single UPDATE
+// statement, for high-volume deposit ingestion where locking and updating
+// one row per Postgres round trip doesn't scale. All accounts must belong
+// to tenantID. Unlike UpdateBalance, the increment is computed in SQL, so
+// the caller doesn't need to hold a FindByIDForUpdate lock first; Postgres
+// serializes the concurrent row updates itself.
+func (r *AccountRepository) CreditBatch(tenantID string, deltas map[string]int64) error {
+	if len(deltas) == 0 {
+		return nil
+	}
+
+	var caseSQL strings.Builder
+	caseSQL.WriteString("CASE id ")
+	args := make([]interface{}, 0, len(deltas)*2+len(deltas)+1)
+	ids := make([]interface{}, 0, len(deltas))
+	for id, delta := range deltas {
+		caseSQL.WriteString("WHEN ? THEN balance + ? ")
+		args = append(args, id, delta)
+		ids = append(ids, id)
+	}
+	caseSQL.WriteString("ELSE balance END")
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(ids)), ",")
+	sql := "UPDATE accounts SET balance = " + caseSQL.String() +
+		" WHERE tenant_id = ? AND id IN (" + placeholders + ")"
+	args = append(args, tenantID)
+	args = append(args, ids...)
+
+	return r.db.Exec(sql, args...).Error
+}
+
+// FindByIDForUpdate looks up an account row-locked, so the caller can
+// safely read-modify-write its balance without a lost update. It is only
+// safe to call within a DB transaction obtained via WithTx.
+func (r *AccountRepository) FindByIDForUpdate(tenantID, id string) (*models.Account, error) {
+	var account models.Account
+	err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("tenant_id = ? AND id = ?", tenantID, id).First(&account).Error
+	if err != nil {
+		return nil, err
+	}
+	return &account, nil
+}
+
+// UpdateHeldAmount sets the held amount for an account, scoped to tenantID.
+func (r *AccountRepository) UpdateHeldAmount(tenantID, id string, heldAmount int64) error {
+	return r.db.Model(&models.Account{}).
+		Where("tenant_id = ? AND id = ?", tenantID, id).
+		Update("held_amount", heldAmount).Error
+}
+
+// WithTx returns a copy of the repository bound to dbtx, for chaining
+// multiple tenant-scoped operations inside the same DB transaction.
+func (r *AccountRepository) WithTx(dbtx *gorm.DB) *AccountRepository {
+	return &AccountRepository{db: dbtx}
+}
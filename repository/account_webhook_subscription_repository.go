@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// AccountWebhookSubscriptionRepository provides tenant-scoped persistence
+// for AccountWebhookSubscription records.
+type AccountWebhookSubscriptionRepository struct {
+	db *gorm.DB
+}
+
+// NewAccountWebhookSubscriptionRepository builds an
+// AccountWebhookSubscriptionRepository backed by db.
+func NewAccountWebhookSubscriptionRepository(db *gorm.DB) *AccountWebhookSubscriptionRepository {
+	return &AccountWebhookSubscriptionRepository{db: db}
+}
+
+// Create persists a new webhook subscription.
+func (r *AccountWebhookSubscriptionRepository) Create(sub *models.AccountWebhookSubscription) error {
+	return r.db.Create(sub).Error
+}
+
+// ListByAccount returns every webhook subscription registered for
+// accountID, scoped to tenantID, active or not.
+func (r *AccountWebhookSubscriptionRepository) ListByAccount(tenantID, accountID string) ([]models.AccountWebhookSubscription, error) {
+	var subs []models.AccountWebhookSubscription
+	if err := r.db.Where("tenant_id = ? AND account_id = ?", tenantID, accountID).Find(&subs).Error; err != nil {
+		return nil, err
+	}
+	return subs, nil
+}
+
+// ListActiveByAccount returns accountID's active webhook subscriptions,
+// scoped to tenantID, for evaluating against a just-posted transaction.
+func (r *AccountWebhookSubscriptionRepository) ListActiveByAccount(tenantID, accountID string) ([]models.AccountWebhookSubscription, error) {
+	var subs []models.AccountWebhookSubscription
+	if err := r.db.Where("tenant_id = ? AND account_id = ? AND active = ?", tenantID, accountID, true).Find(&subs).Error; err != nil {
+		return nil, err
+	}
+	return subs, nil
+}
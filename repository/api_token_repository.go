@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// APITokenRepository provides tenant-scoped persistence for APIToken
+// records.
+type APITokenRepository struct {
+	db *gorm.DB
+}
+
+// NewAPITokenRepository builds an APITokenRepository backed by db.
+func NewAPITokenRepository(db *gorm.DB) *APITokenRepository {
+	return &APITokenRepository{db: db}
+}
+
+// Create persists a new API token.
+func (r *APITokenRepository) Create(token *models.APIToken) error {
+	return r.db.Create(token).Error
+}
+
+// FindByHash looks up an API token by its SHA-256 hash, across every
+// tenant, since the token itself is presented with no other tenant hint.
+func (r *APITokenRepository) FindByHash(hash string) (*models.APIToken, error) {
+	var token models.APIToken
+	if err := r.db.Where("token_hash = ?", hash).First(&token).Error; err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// FindByID looks up an API token by ID, scoped to tenantID.
+func (r *APITokenRepository) FindByID(tenantID, id string) (*models.APIToken, error) {
+	var token models.APIToken
+	if err := r.db.Where("tenant_id = ? AND id = ?", tenantID, id).First(&token).Error; err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// List returns every API token issued under tenantID, most recent first.
+func (r *APITokenRepository) List(tenantID string) ([]models.APIToken, error) {
+	var tokens []models.APIToken
+	if err := r.db.Where("tenant_id = ?", tenantID).
+		Order("created_at desc").Find(&tokens).Error; err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+// Revoke marks a token as no longer usable.
+func (r *APITokenRepository) Revoke(token *models.APIToken) error {
+	return r.db.Save(token).Error
+}
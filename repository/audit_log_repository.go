@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"log"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// AuditLogRepository provides tenant-scoped persistence for AuditLog
+// rows. Every entry written to Postgres is also mirrored, best-effort,
+// into a Mongo collection when one is configured, so the audit trail
+// survives even a wholesale Postgres restore that predates the action
+// being investigated.
+type AuditLogRepository struct {
+	db     *gorm.DB
+	mirror *mongo.Collection
+}
+
+// NewAuditLogRepository builds an AuditLogRepository backed by db.
+// mirror may be nil, in which case entries are only persisted to
+// Postgres.
+func NewAuditLogRepository(db *gorm.DB, mirror *mongo.Collection) *AuditLogRepository {
+	return &AuditLogRepository{db: db, mirror: mirror}
+}
+
+// WithTx returns an AuditLogRepository that runs against dbtx instead of the
+// repository's own connection, for composing into a caller's transaction.
+// The Mongo mirror, which can't participate in that transaction, is
+// preserved unchanged.
+func (r *AuditLogRepository) WithTx(dbtx *gorm.DB) *AuditLogRepository {
+	return &AuditLogRepository{db: dbtx, mirror: r.mirror}
+}
+
+// Create persists a new audit log entry.
+func (r *AuditLogRepository) Create(entry *models.AuditLog) error {
+	if err := r.db.Create(entry).Error; err != nil {
+		return err
+	}
+	if r.mirror != nil {
+		if _, err := r.mirror.InsertOne(context.Background(), entry); err != nil {
+			log.Printf("audit log mongo mirror write failed for %s: %v", entry.ID, err)
+		}
+	}
+	return nil
+}
+
+// List returns every audit log entry for tenantID, most recent first.
+func (r *AuditLogRepository) List(tenantID string) ([]models.AuditLog, error) {
+	var entries []models.AuditLog
+	if err := r.db.Where("tenant_id = ?", tenantID).
+		Order("created_at desc").Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// BalanceMismatchRepository provides persistence for balance drift found by
+// the reconciliation checker. Like DeadLetterRepository, this is a
+// platform-wide operational concern rather than a single tenant's data, so
+// List supports optional cross-tenant filtering instead of the tenant-
+// scoped pattern used elsewhere.
+type BalanceMismatchRepository struct {
+	db *gorm.DB
+}
+
+// NewBalanceMismatchRepository builds a BalanceMismatchRepository backed by db.
+func NewBalanceMismatchRepository(db *gorm.DB) *BalanceMismatchRepository {
+	return &BalanceMismatchRepository{db: db}
+}
+
+// Create persists a new balance mismatch record.
+func (r *BalanceMismatchRepository) Create(m *models.BalanceMismatch) error {
+	return r.db.Create(m).Error
+}
+
+// List returns balance mismatches ordered most-recent-first, optionally
+// filtered by tenant and/or account ID. An empty filter value matches
+// anything.
+func (r *BalanceMismatchRepository) List(tenantID, accountID string) ([]models.BalanceMismatch, error) {
+	query := r.db.Model(&models.BalanceMismatch{})
+	if tenantID != "" {
+		query = query.Where("tenant_id = ?", tenantID)
+	}
+	if accountID != "" {
+		query = query.Where("account_id = ?", accountID)
+	}
+
+	var mismatches []models.BalanceMismatch
+	if err := query.Order("created_at DESC").Find(&mismatches).Error; err != nil {
+		return nil, err
+	}
+	return mismatches, nil
+}
@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// BlocklistRepository provides tenant-scoped persistence for and matching
+// against the internal sanctions/blocklist.
+type BlocklistRepository struct {
+	db *gorm.DB
+}
+
+// NewBlocklistRepository builds a BlocklistRepository backed by db.
+func NewBlocklistRepository(db *gorm.DB) *BlocklistRepository {
+	return &BlocklistRepository{db: db}
+}
+
+// Create persists a new blocklist entry under the given tenant.
+func (r *BlocklistRepository) Create(entry *models.BlocklistEntry) error {
+	return r.db.Create(entry).Error
+}
+
+// List returns every blocklist entry belonging to tenantID.
+func (r *BlocklistRepository) List(tenantID string) ([]models.BlocklistEntry, error) {
+	var entries []models.BlocklistEntry
+	if err := r.db.Where("tenant_id = ?", tenantID).Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// Delete removes a blocklist entry, scoped to tenantID.
+func (r *BlocklistRepository) Delete(tenantID, id string) error {
+	return r.db.Where("tenant_id = ? AND id = ?", tenantID, id).Delete(&models.BlocklistEntry{}).Error
+}
+
+// MatchExact looks up an exact-match entry of entryType for value, scoped
+// to tenantID. Returns gorm.ErrRecordNotFound when nothing matches.
+func (r *BlocklistRepository) MatchExact(tenantID string, entryType models.BlocklistEntryType, value string) (*models.BlocklistEntry, error) {
+	var entry models.BlocklistEntry
+	if err := r.db.Where("tenant_id = ? AND type = ? AND value = ?", tenantID, entryType, value).
+		First(&entry).Error; err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// MatchNamePattern looks up a name_pattern entry whose value LIKEs name,
+// scoped to tenantID. Returns gorm.ErrRecordNotFound when nothing matches.
+func (r *BlocklistRepository) MatchNamePattern(tenantID, name string) (*models.BlocklistEntry, error) {
+	var entry models.BlocklistEntry
+	if err := r.db.Where("tenant_id = ? AND type = ? AND ? LIKE value", tenantID, models.BlocklistEntryNamePattern, name).
+		First(&entry).Error; err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// BranchRepository provides tenant-scoped persistence for Branch records.
+type BranchRepository struct {
+	db *gorm.DB
+}
+
+// NewBranchRepository builds a BranchRepository backed by db.
+func NewBranchRepository(db *gorm.DB) *BranchRepository {
+	return &BranchRepository{db: db}
+}
+
+// Create persists a new branch under the given tenant.
+func (r *BranchRepository) Create(branch *models.Branch) error {
+	return r.db.Create(branch).Error
+}
+
+// FindByID looks up a branch by ID, scoped to tenantID.
+func (r *BranchRepository) FindByID(tenantID, id string) (*models.Branch, error) {
+	var branch models.Branch
+	if err := r.db.Where("tenant_id = ? AND id = ?", tenantID, id).First(&branch).Error; err != nil {
+		return nil, err
+	}
+	return &branch, nil
+}
+
+// List returns every branch belonging to tenantID.
+func (r *BranchRepository) List(tenantID string) ([]models.Branch, error) {
+	var branches []models.Branch
+	if err := r.db.Where("tenant_id = ?", tenantID).Find(&branches).Error; err != nil {
+		return nil, err
+	}
+	return branches, nil
+}
@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// BulkStatementJobRepository provides tenant-scoped persistence for
+// BulkStatementJob records.
+type BulkStatementJobRepository struct {
+	db *gorm.DB
+}
+
+// NewBulkStatementJobRepository builds a BulkStatementJobRepository backed
+// by db.
+func NewBulkStatementJobRepository(db *gorm.DB) *BulkStatementJobRepository {
+	return &BulkStatementJobRepository{db: db}
+}
+
+// Create persists a new bulk statement job.
+func (r *BulkStatementJobRepository) Create(job *models.BulkStatementJob) error {
+	return r.db.Create(job).Error
+}
+
+// FindByID looks up a bulk statement job by ID, scoped to tenantID.
+func (r *BulkStatementJobRepository) FindByID(tenantID, id string) (*models.BulkStatementJob, error) {
+	var job models.BulkStatementJob
+	if err := r.db.Where("tenant_id = ? AND id = ?", tenantID, id).First(&job).Error; err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// UpdateProgress records how many accounts a running job has completed so
+// far, for a caller polling FindByID.
+func (r *BulkStatementJobRepository) UpdateProgress(tenantID, id string, doneAccounts int) error {
+	return r.db.Model(&models.BulkStatementJob{}).
+		Where("tenant_id = ? AND id = ?", tenantID, id).
+		Update("done_accounts", doneAccounts).Error
+}
+
+// UpdateOutcome records a job's final status once generation has finished
+// or failed.
+func (r *BulkStatementJobRepository) UpdateOutcome(tenantID, id string, status models.BulkStatementJobStatus, archiveURL, errMsg string, completedAt time.Time) error {
+	return r.db.Model(&models.BulkStatementJob{}).
+		Where("tenant_id = ? AND id = ?", tenantID, id).
+		Updates(map[string]interface{}{
+			"status":       status,
+			"archive_url":  archiveURL,
+			"error":        errMsg,
+			"completed_at": completedAt,
+		}).Error
+}
@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// BulkTransferBatchRepository provides tenant-scoped persistence for
+// BulkTransferBatch records.
+type BulkTransferBatchRepository struct {
+	db *gorm.DB
+}
+
+// NewBulkTransferBatchRepository builds a BulkTransferBatchRepository
+// backed by db.
+func NewBulkTransferBatchRepository(db *gorm.DB) *BulkTransferBatchRepository {
+	return &BulkTransferBatchRepository{db: db}
+}
+
+// Create persists a new bulk transfer batch.
+func (r *BulkTransferBatchRepository) Create(batch *models.BulkTransferBatch) error {
+	return r.db.Create(batch).Error
+}
+
+// FindByID looks up a bulk transfer batch by ID, scoped to tenantID.
+func (r *BulkTransferBatchRepository) FindByID(tenantID, id string) (*models.BulkTransferBatch, error) {
+	var batch models.BulkTransferBatch
+	if err := r.db.Where("tenant_id = ? AND id = ?", tenantID, id).First(&batch).Error; err != nil {
+		return nil, err
+	}
+	return &batch, nil
+}
+
+// ListByPayrollTemplate returns every batch a payroll template's runs
+// have produced, scoped to tenantID, most recent first.
+func (r *BulkTransferBatchRepository) ListByPayrollTemplate(tenantID, payrollTemplateID string) ([]models.BulkTransferBatch, error) {
+	var batches []models.BulkTransferBatch
+	if err := r.db.Where("tenant_id = ? AND payroll_template_id = ?", tenantID, payrollTemplateID).
+		Order("created_at desc").Find(&batches).Error; err != nil {
+		return nil, err
+	}
+	return batches, nil
+}
+
+// UpdateOutcome records a batch's final status and per-item counts once
+// every line has been attempted.
+func (r *BulkTransferBatchRepository) UpdateOutcome(tenantID, id string, status models.BulkTransferStatus, successCount, failureCount int) error {
+	return r.db.Model(&models.BulkTransferBatch{}).
+		Where("tenant_id = ? AND id = ?", tenantID, id).
+		Updates(map[string]interface{}{
+			"status":        status,
+			"success_count": successCount,
+			"failure_count": failureCount,
+		}).Error
+}
@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// BulkTransferItemRepository provides tenant-scoped persistence for
+// BulkTransferItem records.
+type BulkTransferItemRepository struct {
+	db *gorm.DB
+}
+
+// NewBulkTransferItemRepository builds a BulkTransferItemRepository
+// backed by db.
+func NewBulkTransferItemRepository(db *gorm.DB) *BulkTransferItemRepository {
+	return &BulkTransferItemRepository{db: db}
+}
+
+// Create persists a single bulk transfer line's outcome.
+func (r *BulkTransferItemRepository) Create(item *models.BulkTransferItem) error {
+	return r.db.Create(item).Error
+}
+
+// ListByBatch returns every line belonging to batchID, scoped to
+// tenantID, in the order they were submitted.
+func (r *BulkTransferItemRepository) ListByBatch(tenantID, batchID string) ([]models.BulkTransferItem, error) {
+	var items []models.BulkTransferItem
+	if err := r.db.Where("tenant_id = ? AND batch_id = ?", tenantID, batchID).
+		Order("created_at asc").Find(&items).Error; err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// CardAuthorizationRepository provides tenant-scoped persistence for
+// CardAuthorization records.
+type CardAuthorizationRepository struct {
+	db *gorm.DB
+}
+
+// NewCardAuthorizationRepository builds a CardAuthorizationRepository
+// backed by db.
+func NewCardAuthorizationRepository(db *gorm.DB) *CardAuthorizationRepository {
+	return &CardAuthorizationRepository{db: db}
+}
+
+// Create persists a new card authorization hold.
+func (r *CardAuthorizationRepository) Create(auth *models.CardAuthorization) error {
+	return r.db.Create(auth).Error
+}
+
+// FindByRRN looks up a card authorization by its switch-assigned RRN,
+// scoped to tenantID.
+func (r *CardAuthorizationRepository) FindByRRN(tenantID, rrn string) (*models.CardAuthorization, error) {
+	var auth models.CardAuthorization
+	if err := r.db.Where("tenant_id = ? AND rrn = ?", tenantID, rrn).First(&auth).Error; err != nil {
+		return nil, err
+	}
+	return &auth, nil
+}
+
+// MarkCaptured records that a pending authorization was converted into a
+// posted transaction, scoped to tenantID.
+func (r *CardAuthorizationRepository) MarkCaptured(tenantID, id, transactionID string) error {
+	return r.db.Model(&models.CardAuthorization{}).
+		Where("tenant_id = ? AND id = ?", tenantID, id).
+		Updates(map[string]interface{}{
+			"status":         models.CardAuthorizationCaptured,
+			"transaction_id": transactionID,
+		}).Error
+}
+
+// MarkReversed records that a pending authorization's hold was released
+// without ever posting, scoped to tenantID. The update is guarded by
+// status = pending, so rowsAffected doubles as a compare-and-swap:
+// it comes back 0 if the authorization was already reversed (or
+// captured) by a concurrent sweep, instead of reversing it twice.
+func (r *CardAuthorizationRepository) MarkReversed(tenantID, id string) (rowsAffected int64, err error) {
+	tx := r.db.Model(&models.CardAuthorization{}).
+		Where("tenant_id = ? AND id = ? AND status = ?", tenantID, id, models.CardAuthorizationPending).
+		Update("status", models.CardAuthorizationReversed)
+	return tx.RowsAffected, tx.Error
+}
+
+// FindExpiredPending returns up to limit pending authorizations whose
+// hold expired before cutoff, across every tenant, for the expiry
+// sweeper to release. It is intentionally not tenant-scoped, since the
+// sweeper's whole job is to sweep every tenant's stale holds.
+func (r *CardAuthorizationRepository) FindExpiredPending(cutoff time.Time, limit int) ([]models.CardAuthorization, error) {
+	var authorizations []models.CardAuthorization
+	if err := r.db.
+		Where("status = ? AND expires_at < ?", models.CardAuthorizationPending, cutoff).
+		Order("expires_at asc").
+		Limit(limit).
+		Find(&authorizations).Error; err != nil {
+		return nil, err
+	}
+	return authorizations, nil
+}
+
+// WithTx returns a copy of the repository bound to dbtx.
+func (r *CardAuthorizationRepository) WithTx(dbtx *gorm.DB) *CardAuthorizationRepository {
+	return &CardAuthorizationRepository{db: dbtx}
+}
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// ChequeDepositRepository provides tenant-scoped persistence for
+// ChequeDeposit records.
+type ChequeDepositRepository struct {
+	db *gorm.DB
+}
+
+// NewChequeDepositRepository builds a ChequeDepositRepository backed by db.
+func NewChequeDepositRepository(db *gorm.DB) *ChequeDepositRepository {
+	return &ChequeDepositRepository{db: db}
+}
+
+// Create persists a new cheque deposit.
+func (r *ChequeDepositRepository) Create(deposit *models.ChequeDeposit) error {
+	return r.db.Create(deposit).Error
+}
+
+// FindByID looks up a cheque deposit by ID, scoped to tenantID.
+func (r *ChequeDepositRepository) FindByID(tenantID, id string) (*models.ChequeDeposit, error) {
+	var deposit models.ChequeDeposit
+	if err := r.db.Where("tenant_id = ? AND id = ?", tenantID, id).First(&deposit).Error; err != nil {
+		return nil, err
+	}
+	return &deposit, nil
+}
+
+// ListByAccount returns cheque deposit history for accountID within
+// tenantID.
+func (r *ChequeDepositRepository) ListByAccount(tenantID, accountID string) ([]models.ChequeDeposit, error) {
+	var deposits []models.ChequeDeposit
+	if err := r.db.Where("tenant_id = ? AND account_id = ?", tenantID, accountID).
+		Order("created_at desc").Find(&deposits).Error; err != nil {
+		return nil, err
+	}
+	return deposits, nil
+}
+
+// UpdateStatus transitions a cheque deposit out of pending, optionally
+// recording the resulting transaction ID, scoped to tenantID. The update
+// is guarded by status = pending, so rowsAffected also serves as a
+// compare-and-swap: it comes back 0 if the deposit was already resolved
+// by a concurrent call, instead of silently overwriting it.
+func (r *ChequeDepositRepository) UpdateStatus(tenantID, id string, status models.ChequeDepositStatus, transactionID string) (rowsAffected int64, err error) {
+	tx := r.db.Model(&models.ChequeDeposit{}).
+		Where("tenant_id = ? AND id = ? AND status = ?", tenantID, id, models.ChequeDepositPending).
+		Updates(map[string]interface{}{
+			"status":         status,
+			"transaction_id": transactionID,
+		})
+	return tx.RowsAffected, tx.Error
+}
+
+// SetTransactionID records the transaction a claimed deposit produced,
+// once posting has completed.
+func (r *ChequeDepositRepository) SetTransactionID(tenantID, id, transactionID string) error {
+	return r.db.Model(&models.ChequeDeposit{}).
+		Where("tenant_id = ? AND id = ?", tenantID, id).
+		Update("transaction_id", transactionID).Error
+}
+
+// Reopen puts a claimed deposit back to pending. It's used when
+// UpdateStatus's claim succeeds but the action it was claimed for
+// (posting the deposit) then fails, so the deposit doesn't get stranded
+// cleared with nothing posted against it.
+func (r *ChequeDepositRepository) Reopen(tenantID, id string) error {
+	return r.db.Model(&models.ChequeDeposit{}).
+		Where("tenant_id = ? AND id = ?", tenantID, id).
+		Updates(map[string]interface{}{
+			"status":         models.ChequeDepositPending,
+			"transaction_id": "",
+		}).Error
+}
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// ClearingFileRepository provides platform-wide persistence for
+// ClearingFile records; clearing files aren't tenant-scoped, see
+// models.ClearingFile.
+type ClearingFileRepository struct {
+	db *gorm.DB
+}
+
+// NewClearingFileRepository builds a ClearingFileRepository backed by db.
+func NewClearingFileRepository(db *gorm.DB) *ClearingFileRepository {
+	return &ClearingFileRepository{db: db}
+}
+
+// Create persists a newly generated clearing file.
+func (r *ClearingFileRepository) Create(file *models.ClearingFile) error {
+	return r.db.Create(file).Error
+}
+
+// FindByID looks up a clearing file by ID.
+func (r *ClearingFileRepository) FindByID(id string) (*models.ClearingFile, error) {
+	var file models.ClearingFile
+	if err := r.db.Where("id = ?", id).First(&file).Error; err != nil {
+		return nil, err
+	}
+	return &file, nil
+}
+
+// MarkAcknowledged records that a clearing partner has returned an
+// acknowledgment file for id.
+func (r *ClearingFileRepository) MarkAcknowledged(id string) error {
+	return r.db.Model(&models.ClearingFile{}).
+		Where("id = ?", id).
+		Update("status", models.ClearingFileAcknowledged).Error
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// ComplianceHoldRepository provides tenant-scoped persistence for
+// screening holds awaiting compliance review.
+type ComplianceHoldRepository struct {
+	db *gorm.DB
+}
+
+// NewComplianceHoldRepository builds a ComplianceHoldRepository backed by db.
+func NewComplianceHoldRepository(db *gorm.DB) *ComplianceHoldRepository {
+	return &ComplianceHoldRepository{db: db}
+}
+
+// Create persists a new compliance hold.
+func (r *ComplianceHoldRepository) Create(hold *models.ComplianceHold) error {
+	return r.db.Create(hold).Error
+}
+
+// FindByID looks up a compliance hold by ID, scoped to tenantID.
+func (r *ComplianceHoldRepository) FindByID(tenantID, id string) (*models.ComplianceHold, error) {
+	var hold models.ComplianceHold
+	if err := r.db.Where("tenant_id = ? AND id = ?", tenantID, id).First(&hold).Error; err != nil {
+		return nil, err
+	}
+	return &hold, nil
+}
+
+// List returns every compliance hold for tenantID, most recent first.
+func (r *ComplianceHoldRepository) List(tenantID string) ([]models.ComplianceHold, error) {
+	var holds []models.ComplianceHold
+	if err := r.db.Where("tenant_id = ?", tenantID).
+		Order("created_at desc").Find(&holds).Error; err != nil {
+		return nil, err
+	}
+	return holds, nil
+}
+
+// ListPendingByAccount returns the compliance holds still awaiting
+// release or denial against accountID, oldest first, for projecting a
+// future balance.
+func (r *ComplianceHoldRepository) ListPendingByAccount(tenantID, accountID string) ([]models.ComplianceHold, error) {
+	var holds []models.ComplianceHold
+	if err := r.db.Where("tenant_id = ? AND account_id = ? AND status = ?", tenantID, accountID, models.ComplianceHoldPending).
+		Order("created_at asc").Find(&holds).Error; err != nil {
+		return nil, err
+	}
+	return holds, nil
+}
+
+// Resolve marks a hold released or denied, recording the resulting
+// transaction ID when one was posted.
+func (r *ComplianceHoldRepository) Resolve(tenantID, id string, status models.ComplianceHoldStatus, transactionID string) error {
+	return r.db.Model(&models.ComplianceHold{}).
+		Where("tenant_id = ? AND id = ?", tenantID, id).
+		Updates(map[string]interface{}{
+			"status":         status,
+			"transaction_id": transactionID,
+		}).Error
+}
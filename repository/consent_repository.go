@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// ConsentRepository provides tenant-scoped persistence for Consent
+// records.
+type ConsentRepository struct {
+	db *gorm.DB
+}
+
+// NewConsentRepository builds a ConsentRepository backed by db.
+func NewConsentRepository(db *gorm.DB) *ConsentRepository {
+	return &ConsentRepository{db: db}
+}
+
+// Create persists a new consent.
+func (r *ConsentRepository) Create(consent *models.Consent) error {
+	return r.db.Create(consent).Error
+}
+
+// FindByID looks up a consent by ID, scoped to tenantID.
+func (r *ConsentRepository) FindByID(tenantID, id string) (*models.Consent, error) {
+	var consent models.Consent
+	if err := r.db.Where("tenant_id = ? AND id = ?", tenantID, id).First(&consent).Error; err != nil {
+		return nil, err
+	}
+	return &consent, nil
+}
+
+// FindByAPITokenID looks up the consent a given APIToken was minted for,
+// scoped to tenantID, so the Open Banking endpoints can turn the token a
+// request authenticated with back into the accounts it's allowed to read.
+func (r *ConsentRepository) FindByAPITokenID(tenantID, apiTokenID string) (*models.Consent, error) {
+	var consent models.Consent
+	if err := r.db.Where("tenant_id = ? AND api_token_id = ?", tenantID, apiTokenID).First(&consent).Error; err != nil {
+		return nil, err
+	}
+	return &consent, nil
+}
+
+// ListByUser returns every consent a user has granted, scoped to
+// tenantID, most recent first.
+func (r *ConsentRepository) ListByUser(tenantID, userID string) ([]models.Consent, error) {
+	var consents []models.Consent
+	if err := r.db.Where("tenant_id = ? AND user_id = ?", tenantID, userID).
+		Order("created_at desc").Find(&consents).Error; err != nil {
+		return nil, err
+	}
+	return consents, nil
+}
+
+// Revoke marks a consent as no longer usable.
+func (r *ConsentRepository) Revoke(consent *models.Consent) error {
+	return r.db.Save(consent).Error
+}
@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// CutoffScheduleRepository provides tenant-scoped persistence for
+// CutoffSchedule records.
+type CutoffScheduleRepository struct {
+	db *gorm.DB
+}
+
+// NewCutoffScheduleRepository builds a CutoffScheduleRepository backed by db.
+func NewCutoffScheduleRepository(db *gorm.DB) *CutoffScheduleRepository {
+	return &CutoffScheduleRepository{db: db}
+}
+
+// FindByType looks up the cut-off configured for a transaction type,
+// scoped to tenantID. Returns gorm.ErrRecordNotFound if none is configured.
+func (r *CutoffScheduleRepository) FindByType(tenantID string, txType models.TransactionType) (*models.CutoffSchedule, error) {
+	var schedule models.CutoffSchedule
+	if err := r.db.Where("tenant_id = ? AND type = ?", tenantID, txType).First(&schedule).Error; err != nil {
+		return nil, err
+	}
+	return &schedule, nil
+}
+
+// Upsert creates or replaces the cut-off configured for a transaction
+// type under tenantID.
+func (r *CutoffScheduleRepository) Upsert(schedule *models.CutoffSchedule) error {
+	var existing models.CutoffSchedule
+	err := r.db.Where("tenant_id = ? AND type = ?", schedule.TenantID, schedule.Type).First(&existing).Error
+	if err == nil {
+		schedule.ID = existing.ID
+		return r.db.Model(&existing).Updates(map[string]interface{}{
+			"cutoff_minute": schedule.CutoffMinute,
+		}).Error
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	return r.db.Create(schedule).Error
+}
@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// DailySummarySubscriptionRepository provides tenant-scoped persistence
+// for users' opt-in to the daily spending summary notification.
+type DailySummarySubscriptionRepository struct {
+	db *gorm.DB
+}
+
+// NewDailySummarySubscriptionRepository builds a
+// DailySummarySubscriptionRepository backed by db.
+func NewDailySummarySubscriptionRepository(db *gorm.DB) *DailySummarySubscriptionRepository {
+	return &DailySummarySubscriptionRepository{db: db}
+}
+
+// Create persists a new daily summary subscription.
+func (r *DailySummarySubscriptionRepository) Create(sub *models.DailySummarySubscription) error {
+	return r.db.Create(sub).Error
+}
+
+// FindForUser returns userID's daily summary subscription, if any, scoped
+// to tenantID.
+func (r *DailySummarySubscriptionRepository) FindForUser(tenantID, userID string) (*models.DailySummarySubscription, error) {
+	var sub models.DailySummarySubscription
+	if err := r.db.Where("tenant_id = ? AND user_id = ?", tenantID, userID).First(&sub).Error; err != nil {
+		return nil, err
+	}
+	return &sub, nil
+}
+
+// Update persists changes to an existing daily summary subscription.
+func (r *DailySummarySubscriptionRepository) Update(sub *models.DailySummarySubscription) error {
+	return r.db.Save(sub).Error
+}
+
+// ListEnabled returns every subscription with the daily summary currently
+// turned on, across every tenant, for the nightly job to sweep.
+func (r *DailySummarySubscriptionRepository) ListEnabled() ([]models.DailySummarySubscription, error) {
+	var subs []models.DailySummarySubscription
+	if err := r.db.Where("enabled = ?", true).Find(&subs).Error; err != nil {
+		return nil, err
+	}
+	return subs, nil
+}
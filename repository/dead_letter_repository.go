@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// DeadLetterRepository provides persistence for events that failed to
+// deliver to a downstream consumer after every retry. Dead letters are a
+// platform-wide operational concern rather than a single tenant's data, so
+// List supports optional cross-tenant filtering instead of the tenant-
+// scoped pattern used elsewhere.
+type DeadLetterRepository struct {
+	db *gorm.DB
+}
+
+// NewDeadLetterRepository builds a DeadLetterRepository backed by db.
+func NewDeadLetterRepository(db *gorm.DB) *DeadLetterRepository {
+	return &DeadLetterRepository{db: db}
+}
+
+// Create persists a new dead letter record.
+func (r *DeadLetterRepository) Create(dl *models.DeadLetter) error {
+	return r.db.Create(dl).Error
+}
+
+// List returns dead letters ordered most-recent-first, optionally filtered
+// by error type and/or account ID. An empty filter value matches anything.
+func (r *DeadLetterRepository) List(errorType, accountID string) ([]models.DeadLetter, error) {
+	query := r.db.Model(&models.DeadLetter{})
+	if errorType != "" {
+		query = query.Where("error_type = ?", errorType)
+	}
+	if accountID != "" {
+		query = query.Where("account_id = ?", accountID)
+	}
+
+	var deadLetters []models.DeadLetter
+	if err := query.Order("created_at DESC").Find(&deadLetters).Error; err != nil {
+		return nil, err
+	}
+	return deadLetters, nil
+}
+
+// CountSince returns how many dead letters of errorType were recorded at or
+// after since, for rate-based alerting.
+func (r *DeadLetterRepository) CountSince(errorType string, since time.Time) (int64, error) {
+	var count int64
+	if err := r.db.Model(&models.DeadLetter{}).
+		Where("error_type = ? AND created_at >= ?", errorType, since).
+		Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
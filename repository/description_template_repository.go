@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// DescriptionTemplateRepository provides tenant-scoped persistence for
+// DescriptionTemplate records.
+type DescriptionTemplateRepository struct {
+	db *gorm.DB
+}
+
+// NewDescriptionTemplateRepository builds a DescriptionTemplateRepository
+// backed by db.
+func NewDescriptionTemplateRepository(db *gorm.DB) *DescriptionTemplateRepository {
+	return &DescriptionTemplateRepository{db: db}
+}
+
+// FindByReasonAndLocale looks up the template configured for a reason
+// code and locale, scoped to tenantID. Returns gorm.ErrRecordNotFound if
+// none is configured.
+func (r *DescriptionTemplateRepository) FindByReasonAndLocale(tenantID, reasonCode, locale string) (*models.DescriptionTemplate, error) {
+	var template models.DescriptionTemplate
+	if err := r.db.Where("tenant_id = ? AND reason_code = ? AND locale = ?", tenantID, reasonCode, locale).First(&template).Error; err != nil {
+		return nil, err
+	}
+	return &template, nil
+}
+
+// Upsert creates or replaces the template configured for a reason code
+// and locale under tenantID.
+func (r *DescriptionTemplateRepository) Upsert(template *models.DescriptionTemplate) error {
+	var existing models.DescriptionTemplate
+	err := r.db.Where("tenant_id = ? AND reason_code = ? AND locale = ?", template.TenantID, template.ReasonCode, template.Locale).First(&existing).Error
+	if err == nil {
+		template.ID = existing.ID
+		return r.db.Model(&existing).Update("template", template.Template).Error
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	return r.db.Create(template).Error
+}
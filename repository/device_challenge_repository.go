@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// DeviceChallengeRepository provides tenant-scoped persistence for
+// step-up device verification challenges.
+type DeviceChallengeRepository struct {
+	db *gorm.DB
+}
+
+// NewDeviceChallengeRepository builds a DeviceChallengeRepository backed by db.
+func NewDeviceChallengeRepository(db *gorm.DB) *DeviceChallengeRepository {
+	return &DeviceChallengeRepository{db: db}
+}
+
+// Create persists a new device challenge.
+func (r *DeviceChallengeRepository) Create(challenge *models.DeviceChallenge) error {
+	return r.db.Create(challenge).Error
+}
+
+// FindByID looks up a device challenge by ID, scoped to tenantID.
+func (r *DeviceChallengeRepository) FindByID(tenantID, id string) (*models.DeviceChallenge, error) {
+	var challenge models.DeviceChallenge
+	if err := r.db.Where("tenant_id = ? AND id = ?", tenantID, id).First(&challenge).Error; err != nil {
+		return nil, err
+	}
+	return &challenge, nil
+}
+
+// MarkStatus updates a device challenge's status, scoped to tenantID.
+func (r *DeviceChallengeRepository) MarkStatus(tenantID, id string, status models.DeviceChallengeStatus) error {
+	return r.db.Model(&models.DeviceChallenge{}).
+		Where("tenant_id = ? AND id = ?", tenantID, id).
+		Update("status", status).Error
+}
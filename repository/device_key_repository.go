@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// DeviceKeyRepository provides tenant-scoped persistence for registered
+// device signing keys.
+type DeviceKeyRepository struct {
+	db *gorm.DB
+}
+
+// NewDeviceKeyRepository builds a DeviceKeyRepository backed by db.
+func NewDeviceKeyRepository(db *gorm.DB) *DeviceKeyRepository {
+	return &DeviceKeyRepository{db: db}
+}
+
+// Create persists a newly registered device key.
+func (r *DeviceKeyRepository) Create(key *models.DeviceKey) error {
+	return r.db.Create(key).Error
+}
+
+// FindByUserAndDevice looks up a user's registered key for a given
+// device, scoped to tenantID.
+func (r *DeviceKeyRepository) FindByUserAndDevice(tenantID, userID, deviceID string) (*models.DeviceKey, error) {
+	var key models.DeviceKey
+	if err := r.db.Where("tenant_id = ? AND user_id = ? AND device_id = ?", tenantID, userID, deviceID).
+		First(&key).Error; err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+// ListByUser returns every key registered for userID, scoped to tenantID.
+func (r *DeviceKeyRepository) ListByUser(tenantID, userID string) ([]models.DeviceKey, error) {
+	var keys []models.DeviceKey
+	if err := r.db.Where("tenant_id = ? AND user_id = ?", tenantID, userID).
+		Order("created_at desc").Find(&keys).Error; err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// Revoke removes a device key from userID's registered keys, scoped to
+// tenantID.
+func (r *DeviceKeyRepository) Revoke(tenantID, userID, id string) error {
+	return r.db.Where("tenant_id = ? AND user_id = ? AND id = ?", tenantID, userID, id).
+		Delete(&models.DeviceKey{}).Error
+}
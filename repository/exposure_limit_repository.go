@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// ExposureLimitRepository provides tenant-scoped persistence for
+// ExposureLimit records.
+type ExposureLimitRepository struct {
+	db *gorm.DB
+}
+
+// NewExposureLimitRepository builds an ExposureLimitRepository backed by
+// db.
+func NewExposureLimitRepository(db *gorm.DB) *ExposureLimitRepository {
+	return &ExposureLimitRepository{db: db}
+}
+
+// FindByKYCLevel looks up the exposure ceiling configured for a KYC
+// level, scoped to tenantID. Returns gorm.ErrRecordNotFound if none is
+// configured.
+func (r *ExposureLimitRepository) FindByKYCLevel(tenantID string, kycLevel int) (*models.ExposureLimit, error) {
+	var limit models.ExposureLimit
+	if err := r.db.Where("tenant_id = ? AND kyc_level = ?", tenantID, kycLevel).First(&limit).Error; err != nil {
+		return nil, err
+	}
+	return &limit, nil
+}
+
+// Upsert creates or replaces the exposure ceiling configured for a KYC
+// level under tenantID.
+func (r *ExposureLimitRepository) Upsert(limit *models.ExposureLimit) error {
+	var existing models.ExposureLimit
+	err := r.db.Where("tenant_id = ? AND kyc_level = ?", limit.TenantID, limit.KYCLevel).First(&existing).Error
+	if err == nil {
+		limit.ID = existing.ID
+		return r.db.Model(&existing).Update("ceiling", limit.Ceiling).Error
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	return r.db.Create(limit).Error
+}
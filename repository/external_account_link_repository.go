@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// ExternalAccountLinkRepository provides tenant-scoped persistence for
+// ExternalAccountLink records.
+type ExternalAccountLinkRepository struct {
+	db *gorm.DB
+}
+
+// NewExternalAccountLinkRepository builds an ExternalAccountLinkRepository
+// backed by db.
+func NewExternalAccountLinkRepository(db *gorm.DB) *ExternalAccountLinkRepository {
+	return &ExternalAccountLinkRepository{db: db}
+}
+
+// Create persists a new external account link.
+func (r *ExternalAccountLinkRepository) Create(link *models.ExternalAccountLink) error {
+	return r.db.Create(link).Error
+}
+
+// FindByExternalAccountID looks up a link by the aggregator's own account
+// ID, scoped to tenantID and providerID.
+func (r *ExternalAccountLinkRepository) FindByExternalAccountID(tenantID, providerID, externalAccountID string) (*models.ExternalAccountLink, error) {
+	var link models.ExternalAccountLink
+	if err := r.db.Where("tenant_id = ? AND provider_id = ? AND external_account_id = ?", tenantID, providerID, externalAccountID).
+		First(&link).Error; err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
+
+// MarkVerified transitions a link to verified, scoped to tenantID.
+func (r *ExternalAccountLinkRepository) MarkVerified(tenantID, id string) error {
+	return r.db.Model(&models.ExternalAccountLink{}).
+		Where("tenant_id = ? AND id = ?", tenantID, id).
+		Update("status", models.ExternalAccountLinkVerified).Error
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// ExternalTransferRepository provides tenant-scoped persistence for
+// ExternalTransfer records, plus the cross-tenant ListPending the daily
+// clearing file generator needs to batch every tenant's outbound
+// transfers together.
+type ExternalTransferRepository struct {
+	db *gorm.DB
+}
+
+// NewExternalTransferRepository builds an ExternalTransferRepository
+// backed by db.
+func NewExternalTransferRepository(db *gorm.DB) *ExternalTransferRepository {
+	return &ExternalTransferRepository{db: db}
+}
+
+// Create persists a new external transfer.
+func (r *ExternalTransferRepository) Create(transfer *models.ExternalTransfer) error {
+	return r.db.Create(transfer).Error
+}
+
+// FindByID looks up an external transfer by ID, scoped to tenantID.
+func (r *ExternalTransferRepository) FindByID(tenantID, id string) (*models.ExternalTransfer, error) {
+	var transfer models.ExternalTransfer
+	if err := r.db.Where("tenant_id = ? AND id = ?", tenantID, id).First(&transfer).Error; err != nil {
+		return nil, err
+	}
+	return &transfer, nil
+}
+
+// FindByIDAcrossTenants looks up an external transfer by ID alone, for
+// acknowledgment ingestion where the clearing partner's file doesn't
+// carry tenant context.
+func (r *ExternalTransferRepository) FindByIDAcrossTenants(id string) (*models.ExternalTransfer, error) {
+	var transfer models.ExternalTransfer
+	if err := r.db.Where("id = ?", id).First(&transfer).Error; err != nil {
+		return nil, err
+	}
+	return &transfer, nil
+}
+
+// ListPending returns every transfer, across every tenant, still waiting
+// to be swept into a clearing file.
+func (r *ExternalTransferRepository) ListPending() ([]models.ExternalTransfer, error) {
+	var transfers []models.ExternalTransfer
+	if err := r.db.Where("status = ?", models.ExternalTransferPending).Find(&transfers).Error; err != nil {
+		return nil, err
+	}
+	return transfers, nil
+}
+
+// MarkFiled records that transferID has been swept into clearingFileID.
+func (r *ExternalTransferRepository) MarkFiled(id, clearingFileID string) error {
+	return r.db.Model(&models.ExternalTransfer{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":           models.ExternalTransferFiled,
+			"clearing_file_id": clearingFileID,
+		}).Error
+}
+
+// MarkSettled records that a filed transfer's clearing partner
+// acknowledgment confirmed it.
+func (r *ExternalTransferRepository) MarkSettled(id string) error {
+	return r.db.Model(&models.ExternalTransfer{}).
+		Where("id = ?", id).
+		Update("status", models.ExternalTransferSettled).Error
+}
+
+// MarkRejected records that a filed transfer was returned by the clearing
+// partner, along with its reversal transaction and the reason given.
+func (r *ExternalTransferRepository) MarkRejected(id, reversalTransactionID, reason string) error {
+	return r.db.Model(&models.ExternalTransfer{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":                  models.ExternalTransferRejected,
+			"reversal_transaction_id": reversalTransactionID,
+			"reject_reason":           reason,
+		}).Error
+}
@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// FeeScheduleRepository provides tenant-scoped persistence for
+// FeeSchedule records.
+type FeeScheduleRepository struct {
+	db *gorm.DB
+}
+
+// NewFeeScheduleRepository builds a FeeScheduleRepository backed by db.
+func NewFeeScheduleRepository(db *gorm.DB) *FeeScheduleRepository {
+	return &FeeScheduleRepository{db: db}
+}
+
+// FindByType looks up the fee schedule configured for a transaction type,
+// scoped to tenantID. Returns gorm.ErrRecordNotFound if none is configured.
+func (r *FeeScheduleRepository) FindByType(tenantID string, txType models.TransactionType) (*models.FeeSchedule, error) {
+	var schedule models.FeeSchedule
+	if err := r.db.Where("tenant_id = ? AND type = ?", tenantID, txType).First(&schedule).Error; err != nil {
+		return nil, err
+	}
+	return &schedule, nil
+}
+
+// Upsert creates or replaces the fee schedule configured for a
+// transaction type under tenantID.
+func (r *FeeScheduleRepository) Upsert(schedule *models.FeeSchedule) error {
+	var existing models.FeeSchedule
+	err := r.db.Where("tenant_id = ? AND type = ?", schedule.TenantID, schedule.Type).First(&existing).Error
+	if err == nil {
+		schedule.ID = existing.ID
+		return r.db.Model(&existing).Updates(map[string]interface{}{
+			"flat_fee":     schedule.FlatFee,
+			"basis_points": schedule.BasisPoints,
+		}).Error
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	return r.db.Create(schedule).Error
+}
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// FraudReviewRepository provides tenant-scoped persistence for the fraud
+// review queue.
+type FraudReviewRepository struct {
+	db *gorm.DB
+}
+
+// NewFraudReviewRepository builds a FraudReviewRepository backed by db.
+func NewFraudReviewRepository(db *gorm.DB) *FraudReviewRepository {
+	return &FraudReviewRepository{db: db}
+}
+
+// WithTx returns a copy of the repository bound to dbtx, for chaining
+// alongside a transaction post inside the same DB transaction.
+func (r *FraudReviewRepository) WithTx(dbtx *gorm.DB) *FraudReviewRepository {
+	return &FraudReviewRepository{db: dbtx}
+}
+
+// Create persists a new fraud review queue item.
+func (r *FraudReviewRepository) Create(review *models.FraudReview) error {
+	return r.db.Create(review).Error
+}
+
+// List returns every review item for tenantID, most recent first.
+func (r *FraudReviewRepository) List(tenantID string) ([]models.FraudReview, error) {
+	var reviews []models.FraudReview
+	if err := r.db.Where("tenant_id = ?", tenantID).
+		Order("created_at desc").Find(&reviews).Error; err != nil {
+		return nil, err
+	}
+	return reviews, nil
+}
+
+// FindByID looks up a review item by ID, scoped to tenantID.
+func (r *FraudReviewRepository) FindByID(tenantID, id string) (*models.FraudReview, error) {
+	var review models.FraudReview
+	if err := r.db.Where("tenant_id = ? AND id = ?", tenantID, id).First(&review).Error; err != nil {
+		return nil, err
+	}
+	return &review, nil
+}
+
+// UpdateStatus transitions a review item to status.
+func (r *FraudReviewRepository) UpdateStatus(tenantID, id string, status models.FraudReviewStatus) error {
+	return r.db.Model(&models.FraudReview{}).
+		Where("tenant_id = ? AND id = ?", tenantID, id).
+		Update("status", status).Error
+}
+
+// ListByAccountIDs returns every fraud review raised against any of
+// accountIDs, scoped to tenantID, most recent first. An empty
+// accountIDs returns no rows.
+func (r *FraudReviewRepository) ListByAccountIDs(tenantID string, accountIDs []string) ([]models.FraudReview, error) {
+	if len(accountIDs) == 0 {
+		return nil, nil
+	}
+	var reviews []models.FraudReview
+	if err := r.db.Where("tenant_id = ? AND account_id IN ?", tenantID, accountIDs).
+		Order("created_at desc").Find(&reviews).Error; err != nil {
+		return nil, err
+	}
+	return reviews, nil
+}
@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// FXRateRepository provides tenant-scoped persistence for FXRate records.
+type FXRateRepository struct {
+	db *gorm.DB
+}
+
+// NewFXRateRepository builds an FXRateRepository backed by db.
+func NewFXRateRepository(db *gorm.DB) *FXRateRepository {
+	return &FXRateRepository{db: db}
+}
+
+// FindByPair looks up the configured rate for a currency pair, scoped to
+// tenantID. Returns gorm.ErrRecordNotFound if none is configured.
+func (r *FXRateRepository) FindByPair(tenantID, baseCurrency, quoteCurrency string) (*models.FXRate, error) {
+	var rate models.FXRate
+	if err := r.db.Where("tenant_id = ? AND base_currency = ? AND quote_currency = ?", tenantID, baseCurrency, quoteCurrency).First(&rate).Error; err != nil {
+		return nil, err
+	}
+	return &rate, nil
+}
+
+// Upsert creates or replaces the configured rate for a currency pair
+// under tenantID.
+func (r *FXRateRepository) Upsert(rate *models.FXRate) error {
+	var existing models.FXRate
+	err := r.db.Where("tenant_id = ? AND base_currency = ? AND quote_currency = ?", rate.TenantID, rate.BaseCurrency, rate.QuoteCurrency).First(&existing).Error
+	if err == nil {
+		rate.ID = existing.ID
+		return r.db.Model(&existing).Updates(map[string]interface{}{
+			"rate": rate.Rate,
+		}).Error
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	return r.db.Create(rate).Error
+}
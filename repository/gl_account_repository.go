@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// GLAccountRepository provides tenant-scoped persistence for internal
+// general-ledger accounts.
+type GLAccountRepository struct {
+	db *gorm.DB
+}
+
+// NewGLAccountRepository builds a GLAccountRepository backed by db.
+func NewGLAccountRepository(db *gorm.DB) *GLAccountRepository {
+	return &GLAccountRepository{db: db}
+}
+
+// GetOrCreateForUpdate returns tenantID's GL account of glType and
+// currency, row-locked, creating it with a zero balance under newID if it
+// doesn't exist yet. It is only safe to call within a DB transaction
+// obtained via WithTx, mirroring AccountRepository.FindByIDForUpdate.
+func (r *GLAccountRepository) GetOrCreateForUpdate(tenantID string, glType models.GLAccountType, currency, newID string) (*models.GLAccount, error) {
+	var account models.GLAccount
+	err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("tenant_id = ? AND type = ? AND currency = ?", tenantID, glType, currency).
+		First(&account).Error
+	if err == nil {
+		return &account, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	account = models.GLAccount{
+		ID:       newID,
+		TenantID: tenantID,
+		Type:     glType,
+		Currency: currency,
+	}
+	if err := r.db.Create(&account).Error; err != nil {
+		return nil, err
+	}
+	return &account, nil
+}
+
+// UpdateBalance sets the balance for a GL account.
+func (r *GLAccountRepository) UpdateBalance(id string, balance int64) error {
+	return r.db.Model(&models.GLAccount{}).Where("id = ?", id).Update("balance", balance).Error
+}
+
+// FindByType returns tenantID's GL account of glType and currency, for
+// read-only inspection (e.g. the reconciliation report). It returns
+// gorm.ErrRecordNotFound if none has ever been posted to.
+func (r *GLAccountRepository) FindByType(tenantID string, glType models.GLAccountType, currency string) (*models.GLAccount, error) {
+	var account models.GLAccount
+	if err := r.db.Where("tenant_id = ? AND type = ? AND currency = ?", tenantID, glType, currency).First(&account).Error; err != nil {
+		return nil, err
+	}
+	return &account, nil
+}
+
+// ListByTenant returns every GL account tenantID has posted to, for the
+// admin-facing chart of accounts.
+func (r *GLAccountRepository) ListByTenant(tenantID string) ([]models.GLAccount, error) {
+	var accounts []models.GLAccount
+	if err := r.db.Where("tenant_id = ?", tenantID).Order("type, currency").Find(&accounts).Error; err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+// WithTx returns a copy of the repository bound to dbtx, for chaining
+// multiple operations inside the same DB transaction.
+func (r *GLAccountRepository) WithTx(dbtx *gorm.DB) *GLAccountRepository {
+	return &GLAccountRepository{db: dbtx}
+}
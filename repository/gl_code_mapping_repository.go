@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// GLCodeMappingRepository provides tenant-scoped persistence for
+// GLCodeMapping records.
+type GLCodeMappingRepository struct {
+	db *gorm.DB
+}
+
+// NewGLCodeMappingRepository builds a GLCodeMappingRepository backed by
+// db.
+func NewGLCodeMappingRepository(db *gorm.DB) *GLCodeMappingRepository {
+	return &GLCodeMappingRepository{db: db}
+}
+
+// FindByType looks up the external code mapped for a GL account type,
+// scoped to tenantID. Returns gorm.ErrRecordNotFound if none is
+// configured.
+func (r *GLCodeMappingRepository) FindByType(tenantID string, glType models.GLAccountType) (*models.GLCodeMapping, error) {
+	var mapping models.GLCodeMapping
+	if err := r.db.Where("tenant_id = ? AND type = ?", tenantID, glType).First(&mapping).Error; err != nil {
+		return nil, err
+	}
+	return &mapping, nil
+}
+
+// ListByTenant returns every GL code mapping configured under tenantID,
+// for the journal export to resolve without a lookup per entry.
+func (r *GLCodeMappingRepository) ListByTenant(tenantID string) ([]models.GLCodeMapping, error) {
+	var mappings []models.GLCodeMapping
+	if err := r.db.Where("tenant_id = ?", tenantID).Find(&mappings).Error; err != nil {
+		return nil, err
+	}
+	return mappings, nil
+}
+
+// Upsert creates or replaces the external code mapped for a GL account
+// type under tenantID.
+func (r *GLCodeMappingRepository) Upsert(mapping *models.GLCodeMapping) error {
+	var existing models.GLCodeMapping
+	err := r.db.Where("tenant_id = ? AND type = ?", mapping.TenantID, mapping.Type).First(&existing).Error
+	if err == nil {
+		mapping.ID = existing.ID
+		return r.db.Model(&existing).Update("external_code", mapping.ExternalCode).Error
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	return r.db.Create(mapping).Error
+}
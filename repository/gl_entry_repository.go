@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// GLEntryRepository provides tenant-scoped persistence for GLEntry rows.
+type GLEntryRepository struct {
+	db *gorm.DB
+}
+
+// NewGLEntryRepository builds a GLEntryRepository backed by db.
+func NewGLEntryRepository(db *gorm.DB) *GLEntryRepository {
+	return &GLEntryRepository{db: db}
+}
+
+// Create persists a new GL entry.
+func (r *GLEntryRepository) Create(entry *models.GLEntry) error {
+	return r.db.Create(entry).Error
+}
+
+// ListByAccount returns every entry posted against glAccountID, oldest
+// first, for inspecting a GL account's activity.
+func (r *GLEntryRepository) ListByAccount(tenantID, glAccountID string) ([]models.GLEntry, error) {
+	var entries []models.GLEntry
+	if err := r.db.Where("tenant_id = ? AND gl_account_id = ?", tenantID, glAccountID).
+		Order("created_at asc").Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// ListByTenantBetween returns every entry posted under tenantID between
+// from and to (inclusive), oldest first, for the journal export.
+func (r *GLEntryRepository) ListByTenantBetween(tenantID string, from, to time.Time) ([]models.GLEntry, error) {
+	var entries []models.GLEntry
+	if err := r.db.Where("tenant_id = ? AND created_at BETWEEN ? AND ?", tenantID, from, to).
+		Order("created_at asc").Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// WithTx returns a copy of the repository bound to dbtx, for chaining
+// multiple operations inside the same DB transaction.
+func (r *GLEntryRepository) WithTx(dbtx *gorm.DB) *GLEntryRepository {
+	return &GLEntryRepository{db: dbtx}
+}
@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// HolidayRepository provides tenant-scoped persistence for and matching
+// against a tenant's holiday calendar.
+type HolidayRepository struct {
+	db *gorm.DB
+}
+
+// NewHolidayRepository builds a HolidayRepository backed by db.
+func NewHolidayRepository(db *gorm.DB) *HolidayRepository {
+	return &HolidayRepository{db: db}
+}
+
+// Create adds a holiday to a tenant's calendar.
+func (r *HolidayRepository) Create(holiday *models.Holiday) error {
+	return r.db.Create(holiday).Error
+}
+
+// List returns every holiday on tenantID's calendar.
+func (r *HolidayRepository) List(tenantID string) ([]models.Holiday, error) {
+	var holidays []models.Holiday
+	if err := r.db.Where("tenant_id = ?", tenantID).Order("date asc").Find(&holidays).Error; err != nil {
+		return nil, err
+	}
+	return holidays, nil
+}
+
+// Delete removes a holiday from a tenant's calendar.
+func (r *HolidayRepository) Delete(tenantID, id string) error {
+	return r.db.Where("tenant_id = ? AND id = ?", tenantID, id).Delete(&models.Holiday{}).Error
+}
+
+// ExistsOnDate reports whether tenantID's calendar has a holiday on date.
+func (r *HolidayRepository) ExistsOnDate(tenantID string, date time.Time) (bool, error) {
+	var count int64
+	if err := r.db.Model(&models.Holiday{}).
+		Where("tenant_id = ? AND date = ?", tenantID, date).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// ImpersonationSessionRepository provides tenant-scoped persistence for
+// ImpersonationSession records.
+type ImpersonationSessionRepository struct {
+	db *gorm.DB
+}
+
+// NewImpersonationSessionRepository builds an ImpersonationSessionRepository
+// backed by db.
+func NewImpersonationSessionRepository(db *gorm.DB) *ImpersonationSessionRepository {
+	return &ImpersonationSessionRepository{db: db}
+}
+
+// Create persists a new impersonation session.
+func (r *ImpersonationSessionRepository) Create(session *models.ImpersonationSession) error {
+	return r.db.Create(session).Error
+}
+
+// FindByID looks up an impersonation session by ID, scoped to tenantID.
+func (r *ImpersonationSessionRepository) FindByID(tenantID, id string) (*models.ImpersonationSession, error) {
+	var session models.ImpersonationSession
+	if err := r.db.Where("tenant_id = ? AND id = ?", tenantID, id).First(&session).Error; err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// End marks a session as ended early, before its hard expiry.
+func (r *ImpersonationSessionRepository) End(session *models.ImpersonationSession) error {
+	return r.db.Save(session).Error
+}
@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// InterestAccrualBreakdownRepository provides tenant-scoped persistence
+// for InterestAccrualBreakdown records.
+type InterestAccrualBreakdownRepository struct {
+	db *gorm.DB
+}
+
+// NewInterestAccrualBreakdownRepository builds an
+// InterestAccrualBreakdownRepository backed by db.
+func NewInterestAccrualBreakdownRepository(db *gorm.DB) *InterestAccrualBreakdownRepository {
+	return &InterestAccrualBreakdownRepository{db: db}
+}
+
+// Create persists a new breakdown record.
+func (r *InterestAccrualBreakdownRepository) Create(breakdown *models.InterestAccrualBreakdown) error {
+	return r.db.Create(breakdown).Error
+}
+
+// FindByTransaction looks up the breakdown recorded for the accrual that
+// posted transactionID, scoped to tenantID. Returns gorm.ErrRecordNotFound
+// if none was recorded.
+func (r *InterestAccrualBreakdownRepository) FindByTransaction(tenantID, transactionID string) (*models.InterestAccrualBreakdown, error) {
+	var breakdown models.InterestAccrualBreakdown
+	if err := r.db.Where("tenant_id = ? AND transaction_id = ?", tenantID, transactionID).First(&breakdown).Error; err != nil {
+		return nil, err
+	}
+	return &breakdown, nil
+}
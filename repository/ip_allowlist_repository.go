@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// IPAllowlistRepository provides tenant-scoped persistence for
+// IPAllowlistEntry records.
+type IPAllowlistRepository struct {
+	db *gorm.DB
+}
+
+// NewIPAllowlistRepository builds an IPAllowlistRepository backed by db.
+func NewIPAllowlistRepository(db *gorm.DB) *IPAllowlistRepository {
+	return &IPAllowlistRepository{db: db}
+}
+
+// Create persists a new allowed CIDR range for a tenant.
+func (r *IPAllowlistRepository) Create(entry *models.IPAllowlistEntry) error {
+	return r.db.Create(entry).Error
+}
+
+// ListByTenant returns every CIDR range registered under tenantID. An
+// empty slice means the tenant has not opted into allowlisting.
+func (r *IPAllowlistRepository) ListByTenant(tenantID string) ([]models.IPAllowlistEntry, error) {
+	var entries []models.IPAllowlistEntry
+	if err := r.db.Where("tenant_id = ?", tenantID).Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// Delete removes a CIDR range from a tenant's allowlist.
+func (r *IPAllowlistRepository) Delete(tenantID, id string) error {
+	return r.db.Where("tenant_id = ? AND id = ?", tenantID, id).Delete(&models.IPAllowlistEntry{}).Error
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// LargeTransactionAlertRepository provides tenant-scoped persistence for
+// large-transaction alert rules and the feed of their past firings.
+type LargeTransactionAlertRepository struct {
+	db *gorm.DB
+}
+
+// NewLargeTransactionAlertRepository builds a LargeTransactionAlertRepository
+// backed by db.
+func NewLargeTransactionAlertRepository(db *gorm.DB) *LargeTransactionAlertRepository {
+	return &LargeTransactionAlertRepository{db: db}
+}
+
+// CreateRule persists a new large-transaction alert rule.
+func (r *LargeTransactionAlertRepository) CreateRule(rule *models.LargeTransactionAlertRule) error {
+	return r.db.Create(rule).Error
+}
+
+// FindRuleForUser returns userID's large-transaction alert rule, if any,
+// scoped to tenantID.
+func (r *LargeTransactionAlertRepository) FindRuleForUser(tenantID, userID string) (*models.LargeTransactionAlertRule, error) {
+	var rule models.LargeTransactionAlertRule
+	if err := r.db.Where("tenant_id = ? AND user_id = ?", tenantID, userID).First(&rule).Error; err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+// UpdateRule persists changes to an existing large-transaction alert rule.
+func (r *LargeTransactionAlertRepository) UpdateRule(rule *models.LargeTransactionAlertRule) error {
+	return r.db.Save(rule).Error
+}
+
+// CreateEvent persists a new large-transaction alert firing.
+func (r *LargeTransactionAlertRepository) CreateEvent(event *models.LargeTransactionAlertEvent) error {
+	return r.db.Create(event).Error
+}
+
+// ListEventsForUser returns userID's large-transaction alert firings,
+// most recent first, for their activity feed.
+func (r *LargeTransactionAlertRepository) ListEventsForUser(tenantID, userID string) ([]models.LargeTransactionAlertEvent, error) {
+	var events []models.LargeTransactionAlertEvent
+	if err := r.db.Where("tenant_id = ? AND user_id = ?", tenantID, userID).
+		Order("created_at desc").Find(&events).Error; err != nil {
+		return nil, err
+	}
+	return events, nil
+}
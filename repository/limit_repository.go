@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// LimitRepository provides tenant-scoped persistence for Limit records.
+type LimitRepository struct {
+	db *gorm.DB
+}
+
+// NewLimitRepository builds a LimitRepository backed by db.
+func NewLimitRepository(db *gorm.DB) *LimitRepository {
+	return &LimitRepository{db: db}
+}
+
+// Create persists a new limit under the given tenant.
+func (r *LimitRepository) Create(limit *models.Limit) error {
+	return r.db.Create(limit).Error
+}
+
+// FindForTenant returns the tenant-wide limit configuration, if any.
+func (r *LimitRepository) FindForTenant(tenantID string) (*models.Limit, error) {
+	var limit models.Limit
+	err := r.db.Where("tenant_id = ? AND scope = ?", tenantID, models.LimitScopeTenant).First(&limit).Error
+	if err != nil {
+		return nil, err
+	}
+	return &limit, nil
+}
+
+// FindForAccount returns the account-level limit configuration, if any.
+func (r *LimitRepository) FindForAccount(tenantID, accountID string) (*models.Limit, error) {
+	var limit models.Limit
+	err := r.db.Where("tenant_id = ? AND scope = ? AND account_id = ?", tenantID, models.LimitScopeAccount, accountID).First(&limit).Error
+	if err != nil {
+		return nil, err
+	}
+	return &limit, nil
+}
+
+// Update persists changes to an existing limit row.
+func (r *LimitRepository) Update(limit *models.Limit) error {
+	return r.db.Save(limit).Error
+}
+
+// WithTx returns a copy of the repository bound to dbtx, for chaining
+// multiple tenant-scoped operations inside the same DB transaction.
+func (r *LimitRepository) WithTx(dbtx *gorm.DB) *LimitRepository {
+	return &LimitRepository{db: dbtx}
+}
@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// LowBalanceAlertRepository provides tenant-scoped persistence for
+// LowBalanceAlert records.
+type LowBalanceAlertRepository struct {
+	db *gorm.DB
+}
+
+// NewLowBalanceAlertRepository builds a LowBalanceAlertRepository backed
+// by db.
+func NewLowBalanceAlertRepository(db *gorm.DB) *LowBalanceAlertRepository {
+	return &LowBalanceAlertRepository{db: db}
+}
+
+// Create persists a new low-balance alert configuration.
+func (r *LowBalanceAlertRepository) Create(alert *models.LowBalanceAlert) error {
+	return r.db.Create(alert).Error
+}
+
+// FindForAccount returns accountID's low-balance alert configuration, if
+// any, scoped to tenantID.
+func (r *LowBalanceAlertRepository) FindForAccount(tenantID, accountID string) (*models.LowBalanceAlert, error) {
+	var alert models.LowBalanceAlert
+	if err := r.db.Where("tenant_id = ? AND account_id = ?", tenantID, accountID).First(&alert).Error; err != nil {
+		return nil, err
+	}
+	return &alert, nil
+}
+
+// Update persists changes to an existing low-balance alert row.
+func (r *LowBalanceAlertRepository) Update(alert *models.LowBalanceAlert) error {
+	return r.db.Save(alert).Error
+}
@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// MerchantRepository provides tenant-scoped persistence for Merchant
+// records, plus the cross-tenant listing the nightly settlement job needs
+// to sweep every merchant on the platform.
+type MerchantRepository struct {
+	db *gorm.DB
+}
+
+// NewMerchantRepository builds a MerchantRepository backed by db.
+func NewMerchantRepository(db *gorm.DB) *MerchantRepository {
+	return &MerchantRepository{db: db}
+}
+
+// Create persists a new merchant.
+func (r *MerchantRepository) Create(merchant *models.Merchant) error {
+	return r.db.Create(merchant).Error
+}
+
+// FindByID looks up a merchant by ID, scoped to tenantID.
+func (r *MerchantRepository) FindByID(tenantID, id string) (*models.Merchant, error) {
+	var merchant models.Merchant
+	if err := r.db.Where("tenant_id = ? AND id = ?", tenantID, id).First(&merchant).Error; err != nil {
+		return nil, err
+	}
+	return &merchant, nil
+}
+
+// ListActive returns every active merchant, across every tenant, for the
+// settlement job to sweep. It is deliberately not tenant-scoped, the same
+// way DeadLetterRepository's platform-wide queries aren't: the job runs
+// once for the whole platform, not per tenant.
+func (r *MerchantRepository) ListActive() ([]models.Merchant, error) {
+	var merchants []models.Merchant
+	if err := r.db.Where("active = ?", true).Find(&merchants).Error; err != nil {
+		return nil, err
+	}
+	return merchants, nil
+}
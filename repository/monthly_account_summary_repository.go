@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// MonthlyAccountSummaryRepository provides persistence for precomputed
+// monthly account summaries, backed by MongoDB so month-view screens can
+// be served without re-aggregating raw transaction log history.
+type MonthlyAccountSummaryRepository struct {
+	collection *mongo.Collection
+}
+
+// NewMonthlyAccountSummaryRepository builds a
+// MonthlyAccountSummaryRepository backed by the
+// "monthly_account_summaries" collection in db.
+func NewMonthlyAccountSummaryRepository(db *mongo.Database) *MonthlyAccountSummaryRepository {
+	return &MonthlyAccountSummaryRepository{collection: db.Collection("monthly_account_summaries")}
+}
+
+// Upsert replaces summary's document if one already exists for its ID
+// (tenantID:accountID:month), or inserts it otherwise, so rerunning the
+// summary job for the same month overwrites rather than duplicates.
+func (r *MonthlyAccountSummaryRepository) Upsert(ctx context.Context, summary *models.MonthlyAccountSummary) error {
+	_, err := r.collection.ReplaceOne(ctx,
+		map[string]interface{}{"_id": summary.ID},
+		summary,
+		options.Replace().SetUpsert(true),
+	)
+	return err
+}
+
+// FindByAccountMonth returns accountID's precomputed summary for month
+// ("YYYY-MM"), scoped to tenantID.
+func (r *MonthlyAccountSummaryRepository) FindByAccountMonth(ctx context.Context, tenantID, accountID, month string) (*models.MonthlyAccountSummary, error) {
+	var summary models.MonthlyAccountSummary
+	err := r.collection.FindOne(ctx, map[string]interface{}{
+		"tenant_id":  tenantID,
+		"account_id": accountID,
+		"month":      month,
+	}).Decode(&summary)
+	if err != nil {
+		return nil, err
+	}
+	return &summary, nil
+}
+
+// ListByAccount returns every precomputed summary for accountID, scoped to
+// tenantID, for a month-over-month trend view.
+func (r *MonthlyAccountSummaryRepository) ListByAccount(ctx context.Context, tenantID, accountID string) ([]models.MonthlyAccountSummary, error) {
+	cursor, err := r.collection.Find(ctx, map[string]interface{}{
+		"tenant_id":  tenantID,
+		"account_id": accountID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var summaries []models.MonthlyAccountSummary
+	if err := cursor.All(ctx, &summaries); err != nil {
+		return nil, err
+	}
+	return summaries, nil
+}
@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// PaymentConsentRepository provides tenant-scoped persistence for
+// PaymentConsent records.
+type PaymentConsentRepository struct {
+	db *gorm.DB
+}
+
+// NewPaymentConsentRepository builds a PaymentConsentRepository backed by db.
+func NewPaymentConsentRepository(db *gorm.DB) *PaymentConsentRepository {
+	return &PaymentConsentRepository{db: db}
+}
+
+// Create persists a new payment consent.
+func (r *PaymentConsentRepository) Create(consent *models.PaymentConsent) error {
+	return r.db.Create(consent).Error
+}
+
+// FindByID looks up a payment consent by ID, scoped to tenantID.
+func (r *PaymentConsentRepository) FindByID(tenantID, id string) (*models.PaymentConsent, error) {
+	var consent models.PaymentConsent
+	if err := r.db.Where("tenant_id = ? AND id = ?", tenantID, id).First(&consent).Error; err != nil {
+		return nil, err
+	}
+	return &consent, nil
+}
+
+// MarkStatus transitions a payment consent to status, recording
+// transactionID when the payment was actually posted.
+func (r *PaymentConsentRepository) MarkStatus(tenantID, id string, status models.PaymentConsentStatus, transactionID string) error {
+	updates := map[string]interface{}{"status": status}
+	if transactionID != "" {
+		updates["transaction_id"] = transactionID
+	}
+	return r.db.Model(&models.PaymentConsent{}).
+		Where("tenant_id = ? AND id = ?", tenantID, id).
+		Updates(updates).Error
+}
@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// PayrollTemplateLineRepository provides tenant-scoped persistence for
+// PayrollTemplateLine records.
+type PayrollTemplateLineRepository struct {
+	db *gorm.DB
+}
+
+// NewPayrollTemplateLineRepository builds a PayrollTemplateLineRepository
+// backed by db.
+func NewPayrollTemplateLineRepository(db *gorm.DB) *PayrollTemplateLineRepository {
+	return &PayrollTemplateLineRepository{db: db}
+}
+
+// Create persists a single payroll template line.
+func (r *PayrollTemplateLineRepository) Create(line *models.PayrollTemplateLine) error {
+	return r.db.Create(line).Error
+}
+
+// ListByTemplate returns every line belonging to templateID, scoped to
+// tenantID.
+func (r *PayrollTemplateLineRepository) ListByTemplate(tenantID, templateID string) ([]models.PayrollTemplateLine, error) {
+	var lines []models.PayrollTemplateLine
+	if err := r.db.Where("tenant_id = ? AND template_id = ?", tenantID, templateID).Find(&lines).Error; err != nil {
+		return nil, err
+	}
+	return lines, nil
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// PayrollTemplateRepository provides tenant-scoped persistence for
+// PayrollTemplate records, plus the cross-tenant lookup PayrollService's
+// scheduler needs to find every template due to run.
+type PayrollTemplateRepository struct {
+	db *gorm.DB
+}
+
+// NewPayrollTemplateRepository builds a PayrollTemplateRepository backed
+// by db.
+func NewPayrollTemplateRepository(db *gorm.DB) *PayrollTemplateRepository {
+	return &PayrollTemplateRepository{db: db}
+}
+
+// Create persists a new payroll template.
+func (r *PayrollTemplateRepository) Create(template *models.PayrollTemplate) error {
+	return r.db.Create(template).Error
+}
+
+// FindByID looks up a payroll template by ID, scoped to tenantID.
+func (r *PayrollTemplateRepository) FindByID(tenantID, id string) (*models.PayrollTemplate, error) {
+	var template models.PayrollTemplate
+	if err := r.db.Where("tenant_id = ? AND id = ?", tenantID, id).First(&template).Error; err != nil {
+		return nil, err
+	}
+	return &template, nil
+}
+
+// ListByAccount returns every payroll template defined on accountID,
+// scoped to tenantID.
+func (r *PayrollTemplateRepository) ListByAccount(tenantID, accountID string) ([]models.PayrollTemplate, error) {
+	var templates []models.PayrollTemplate
+	if err := r.db.Where("tenant_id = ? AND account_id = ?", tenantID, accountID).Find(&templates).Error; err != nil {
+		return nil, err
+	}
+	return templates, nil
+}
+
+// ListDue returns every active template, across every tenant, whose
+// PayDay is day and that hasn't already run since sinceLastRun (i.e. not
+// yet today), for the scheduler to execute. It is deliberately not
+// tenant-scoped, the same way DeadLetterRepository's platform-wide
+// queries aren't: the scheduler runs once for the whole platform, not per
+// tenant.
+func (r *PayrollTemplateRepository) ListDue(day int, sinceLastRun time.Time) ([]models.PayrollTemplate, error) {
+	var templates []models.PayrollTemplate
+	if err := r.db.Where("active = ? AND pay_day = ? AND (last_run_at IS NULL OR last_run_at < ?)", true, day, sinceLastRun).
+		Find(&templates).Error; err != nil {
+		return nil, err
+	}
+	return templates, nil
+}
+
+// MarkRun records ranAt as a template's most recent run.
+func (r *PayrollTemplateRepository) MarkRun(tenantID, id string, ranAt time.Time) error {
+	return r.db.Model(&models.PayrollTemplate{}).
+		Where("tenant_id = ? AND id = ?", tenantID, id).
+		Update("last_run_at", ranAt).Error
+}
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// PendingApprovalRepository provides tenant-scoped persistence for
+// maker-checker approvals.
+type PendingApprovalRepository struct {
+	db *gorm.DB
+}
+
+// NewPendingApprovalRepository builds a PendingApprovalRepository backed by db.
+func NewPendingApprovalRepository(db *gorm.DB) *PendingApprovalRepository {
+	return &PendingApprovalRepository{db: db}
+}
+
+// Create persists a new pending approval.
+func (r *PendingApprovalRepository) Create(approval *models.PendingApproval) error {
+	return r.db.Create(approval).Error
+}
+
+// FindByID looks up a pending approval by ID, scoped to tenantID.
+func (r *PendingApprovalRepository) FindByID(tenantID, id string) (*models.PendingApproval, error) {
+	var approval models.PendingApproval
+	if err := r.db.Where("tenant_id = ? AND id = ?", tenantID, id).First(&approval).Error; err != nil {
+		return nil, err
+	}
+	return &approval, nil
+}
+
+// List returns every pending approval for tenantID, most recent first.
+func (r *PendingApprovalRepository) List(tenantID string) ([]models.PendingApproval, error) {
+	var approvals []models.PendingApproval
+	if err := r.db.Where("tenant_id = ?", tenantID).
+		Order("created_at desc").Find(&approvals).Error; err != nil {
+		return nil, err
+	}
+	return approvals, nil
+}
+
+// ListPendingByAccount returns the pending approvals awaiting a checker
+// against accountID, oldest first, for projecting a future balance.
+func (r *PendingApprovalRepository) ListPendingByAccount(tenantID, accountID string) ([]models.PendingApproval, error) {
+	var approvals []models.PendingApproval
+	if err := r.db.Where("tenant_id = ? AND account_id = ? AND status = ?", tenantID, accountID, models.PendingApprovalPending).
+		Order("created_at asc").Find(&approvals).Error; err != nil {
+		return nil, err
+	}
+	return approvals, nil
+}
+
+// Resolve marks a pending approval approved or rejected, recording the
+// checker and the resulting transaction ID when one was posted. The
+// update is guarded by status = pending, so rowsAffected also serves as
+// a compare-and-swap: it comes back 0 if the approval was already
+// resolved by a concurrent call, instead of silently overwriting it.
+func (r *PendingApprovalRepository) Resolve(tenantID, id string, status models.PendingApprovalStatus, checkerUserID, transactionID string) (rowsAffected int64, err error) {
+	tx := r.db.Model(&models.PendingApproval{}).
+		Where("tenant_id = ? AND id = ? AND status = ?", tenantID, id, models.PendingApprovalPending).
+		Updates(map[string]interface{}{
+			"status":          status,
+			"checker_user_id": checkerUserID,
+			"transaction_id":  transactionID,
+		})
+	return tx.RowsAffected, tx.Error
+}
+
+// SetTransactionID records the transaction a claimed approval produced,
+// once posting has completed.
+func (r *PendingApprovalRepository) SetTransactionID(tenantID, id, transactionID string) error {
+	return r.db.Model(&models.PendingApproval{}).
+		Where("tenant_id = ? AND id = ?", tenantID, id).
+		Update("transaction_id", transactionID).Error
+}
+
+// Reopen puts a claimed approval back to pending. It's used when
+// Resolve's claim succeeds but the action it was claimed for (posting
+// the transaction) then fails, so the approval doesn't get stranded
+// approved with nothing posted against it.
+func (r *PendingApprovalRepository) Reopen(tenantID, id string) error {
+	return r.db.Model(&models.PendingApproval{}).
+		Where("tenant_id = ? AND id = ?", tenantID, id).
+		Updates(map[string]interface{}{
+			"status":          models.PendingApprovalPending,
+			"checker_user_id": "",
+		}).Error
+}
@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// PhoneLoginRequestRepository provides tenant-scoped persistence for
+// phone-login OTP requests.
+type PhoneLoginRequestRepository struct {
+	db *gorm.DB
+}
+
+// NewPhoneLoginRequestRepository builds a PhoneLoginRequestRepository
+// backed by db.
+func NewPhoneLoginRequestRepository(db *gorm.DB) *PhoneLoginRequestRepository {
+	return &PhoneLoginRequestRepository{db: db}
+}
+
+// Create persists a new phone-login request.
+func (r *PhoneLoginRequestRepository) Create(request *models.PhoneLoginRequest) error {
+	return r.db.Create(request).Error
+}
+
+// FindByID looks up a phone-login request by ID, scoped to tenantID.
+func (r *PhoneLoginRequestRepository) FindByID(tenantID, id string) (*models.PhoneLoginRequest, error) {
+	var request models.PhoneLoginRequest
+	if err := r.db.Where("tenant_id = ? AND id = ?", tenantID, id).First(&request).Error; err != nil {
+		return nil, err
+	}
+	return &request, nil
+}
+
+// FindLatestByUser returns the most recently created phone-login request
+// for userID, scoped to tenantID, so PhoneLoginService can enforce a
+// cooldown between requests.
+func (r *PhoneLoginRequestRepository) FindLatestByUser(tenantID, userID string) (*models.PhoneLoginRequest, error) {
+	var request models.PhoneLoginRequest
+	if err := r.db.Where("tenant_id = ? AND user_id = ?", tenantID, userID).
+		Order("created_at desc").First(&request).Error; err != nil {
+		return nil, err
+	}
+	return &request, nil
+}
+
+// IncrementAttempts bumps a phone-login request's failed-attempt counter,
+// scoped to tenantID.
+func (r *PhoneLoginRequestRepository) IncrementAttempts(tenantID, id string) error {
+	return r.db.Model(&models.PhoneLoginRequest{}).
+		Where("tenant_id = ? AND id = ?", tenantID, id).
+		UpdateColumn("attempts", gorm.Expr("attempts + 1")).Error
+}
+
+// MarkStatus updates a phone-login request's status, scoped to tenantID.
+func (r *PhoneLoginRequestRepository) MarkStatus(tenantID, id string, status models.PhoneLoginStatus) error {
+	return r.db.Model(&models.PhoneLoginRequest{}).
+		Where("tenant_id = ? AND id = ?", tenantID, id).
+		Update("status", status).Error
+}
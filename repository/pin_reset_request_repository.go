@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// PINResetRequestRepository provides tenant-scoped persistence for
+// PIN-reset OTP requests.
+type PINResetRequestRepository struct {
+	db *gorm.DB
+}
+
+// NewPINResetRequestRepository builds a PINResetRequestRepository backed
+// by db.
+func NewPINResetRequestRepository(db *gorm.DB) *PINResetRequestRepository {
+	return &PINResetRequestRepository{db: db}
+}
+
+// Create persists a new PIN-reset request.
+func (r *PINResetRequestRepository) Create(request *models.PINResetRequest) error {
+	return r.db.Create(request).Error
+}
+
+// FindByID looks up a PIN-reset request by ID, scoped to tenantID.
+func (r *PINResetRequestRepository) FindByID(tenantID, id string) (*models.PINResetRequest, error) {
+	var request models.PINResetRequest
+	if err := r.db.Where("tenant_id = ? AND id = ?", tenantID, id).First(&request).Error; err != nil {
+		return nil, err
+	}
+	return &request, nil
+}
+
+// FindLatestByUser returns the most recently created PIN-reset request for
+// userID, scoped to tenantID, so PINService can enforce a cooldown between
+// requests.
+func (r *PINResetRequestRepository) FindLatestByUser(tenantID, userID string) (*models.PINResetRequest, error) {
+	var request models.PINResetRequest
+	if err := r.db.Where("tenant_id = ? AND user_id = ?", tenantID, userID).
+		Order("created_at desc").First(&request).Error; err != nil {
+		return nil, err
+	}
+	return &request, nil
+}
+
+// IncrementAttempts bumps a PIN-reset request's failed-attempt counter,
+// scoped to tenantID.
+func (r *PINResetRequestRepository) IncrementAttempts(tenantID, id string) error {
+	return r.db.Model(&models.PINResetRequest{}).
+		Where("tenant_id = ? AND id = ?", tenantID, id).
+		UpdateColumn("attempts", gorm.Expr("attempts + 1")).Error
+}
+
+// MarkStatus updates a PIN-reset request's status, scoped to tenantID.
+func (r *PINResetRequestRepository) MarkStatus(tenantID, id string, status models.PINResetStatus) error {
+	return r.db.Model(&models.PINResetRequest{}).
+		Where("tenant_id = ? AND id = ?", tenantID, id).
+		Update("status", status).Error
+}
@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// PointsAccountRepository provides tenant-scoped persistence for a user's
+// rewards points balance.
+type PointsAccountRepository struct {
+	db *gorm.DB
+}
+
+// NewPointsAccountRepository builds a PointsAccountRepository backed by db.
+func NewPointsAccountRepository(db *gorm.DB) *PointsAccountRepository {
+	return &PointsAccountRepository{db: db}
+}
+
+// Create persists a new points account.
+func (r *PointsAccountRepository) Create(account *models.PointsAccount) error {
+	return r.db.Create(account).Error
+}
+
+// FindByUser looks up a user's points account, scoped to tenantID. Returns
+// gorm.ErrRecordNotFound if the user has never accrued or redeemed points.
+func (r *PointsAccountRepository) FindByUser(tenantID, userID string) (*models.PointsAccount, error) {
+	var account models.PointsAccount
+	if err := r.db.Where("tenant_id = ? AND user_id = ?", tenantID, userID).First(&account).Error; err != nil {
+		return nil, err
+	}
+	return &account, nil
+}
+
+// FindByUserForUpdate looks up a user's points account row-locked, so the
+// caller can safely read-modify-write its balance without a lost update. It
+// is only safe to call within a DB transaction obtained via WithTx.
+func (r *PointsAccountRepository) FindByUserForUpdate(tenantID, userID string) (*models.PointsAccount, error) {
+	var account models.PointsAccount
+	err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("tenant_id = ? AND user_id = ?", tenantID, userID).First(&account).Error
+	if err != nil {
+		return nil, err
+	}
+	return &account, nil
+}
+
+// UpdateBalance sets the points balance for an account, scoped to tenantID.
+func (r *PointsAccountRepository) UpdateBalance(tenantID, id string, balance int64) error {
+	return r.db.Model(&models.PointsAccount{}).
+		Where("tenant_id = ? AND id = ?", tenantID, id).
+		Update("balance", balance).Error
+}
+
+// WithTx returns a copy of the repository bound to dbtx, for chaining
+// multiple tenant-scoped operations inside the same DB transaction.
+func (r *PointsAccountRepository) WithTx(dbtx *gorm.DB) *PointsAccountRepository {
+	return &PointsAccountRepository{db: dbtx}
+}
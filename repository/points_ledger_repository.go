@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// PointsLedgerRepository provides tenant-scoped persistence for the
+// append-only history of a user's points balance changes.
+type PointsLedgerRepository struct {
+	db *gorm.DB
+}
+
+// NewPointsLedgerRepository builds a PointsLedgerRepository backed by db.
+func NewPointsLedgerRepository(db *gorm.DB) *PointsLedgerRepository {
+	return &PointsLedgerRepository{db: db}
+}
+
+// Create persists a new points ledger entry.
+func (r *PointsLedgerRepository) Create(entry *models.PointsLedgerEntry) error {
+	return r.db.Create(entry).Error
+}
+
+// ListByUser returns a user's points history, most recent first, scoped to
+// tenantID.
+func (r *PointsLedgerRepository) ListByUser(tenantID, userID string) ([]models.PointsLedgerEntry, error) {
+	var entries []models.PointsLedgerEntry
+	if err := r.db.Where("tenant_id = ? AND user_id = ?", tenantID, userID).
+		Order("created_at DESC").Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// WithTx returns a copy of the repository bound to dbtx, for chaining
+// multiple tenant-scoped operations inside the same DB transaction.
+func (r *PointsLedgerRepository) WithTx(dbtx *gorm.DB) *PointsLedgerRepository {
+	return &PointsLedgerRepository{db: dbtx}
+}
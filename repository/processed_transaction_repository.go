@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// ProcessedTransactionRepository provides tenant-scoped persistence for the
+// idempotency-key dedup table backing exactly-once transaction posting.
+type ProcessedTransactionRepository struct {
+	db *gorm.DB
+}
+
+// NewProcessedTransactionRepository builds a ProcessedTransactionRepository
+// backed by db.
+func NewProcessedTransactionRepository(db *gorm.DB) *ProcessedTransactionRepository {
+	return &ProcessedTransactionRepository{db: db}
+}
+
+// Create persists a new processed-transaction record. It fails with a
+// unique constraint violation if idempotencyKey was already recorded for
+// this tenant.
+func (r *ProcessedTransactionRepository) Create(pt *models.ProcessedTransaction) error {
+	return r.db.Create(pt).Error
+}
+
+// FindByKey looks up a processed-transaction record by idempotency key,
+// scoped to tenantID. Returns gorm.ErrRecordNotFound if the key hasn't been
+// processed before.
+func (r *ProcessedTransactionRepository) FindByKey(tenantID, idempotencyKey string) (*models.ProcessedTransaction, error) {
+	var pt models.ProcessedTransaction
+	if err := r.db.Where("tenant_id = ? AND idempotency_key = ?", tenantID, idempotencyKey).First(&pt).Error; err != nil {
+		return nil, err
+	}
+	return &pt, nil
+}
+
+// WithTx returns a copy of the repository bound to dbtx, for chaining
+// multiple tenant-scoped operations inside the same DB transaction.
+func (r *ProcessedTransactionRepository) WithTx(dbtx *gorm.DB) *ProcessedTransactionRepository {
+	return &ProcessedTransactionRepository{db: dbtx}
+}
@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// ProductRepository provides tenant-scoped persistence for savings/deposit
+// Products.
+type ProductRepository struct {
+	db *gorm.DB
+}
+
+// NewProductRepository builds a ProductRepository backed by db.
+func NewProductRepository(db *gorm.DB) *ProductRepository {
+	return &ProductRepository{db: db}
+}
+
+// Create persists a new product.
+func (r *ProductRepository) Create(product *models.Product) error {
+	return r.db.Create(product).Error
+}
+
+// FindByID looks up a product by ID, scoped to tenantID.
+func (r *ProductRepository) FindByID(tenantID, id string) (*models.Product, error) {
+	var product models.Product
+	if err := r.db.Where("tenant_id = ? AND id = ?", tenantID, id).First(&product).Error; err != nil {
+		return nil, err
+	}
+	return &product, nil
+}
+
+// List returns every active product for tenantID, i.e. the current
+// catalog available for new accounts to open against.
+func (r *ProductRepository) List(tenantID string) ([]models.Product, error) {
+	var products []models.Product
+	if err := r.db.Where("tenant_id = ? AND active = ?", tenantID, true).Find(&products).Error; err != nil {
+		return nil, err
+	}
+	return products, nil
+}
+
+// Deactivate marks a product version as no longer available for new
+// accounts, without touching accounts already referencing it.
+func (r *ProductRepository) Deactivate(tenantID, id string) error {
+	return r.db.Model(&models.Product{}).
+		Where("tenant_id = ? AND id = ?", tenantID, id).
+		Update("active", false).Error
+}
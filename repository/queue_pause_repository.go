@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// QueuePauseRepository provides platform-wide persistence for
+// QueuePauseState records.
+type QueuePauseRepository struct {
+	db *gorm.DB
+}
+
+// NewQueuePauseRepository builds a QueuePauseRepository backed by db.
+func NewQueuePauseRepository(db *gorm.DB) *QueuePauseRepository {
+	return &QueuePauseRepository{db: db}
+}
+
+// FindByQueue looks up queue's pause state. Returns gorm.ErrRecordNotFound
+// if queue has never been paused or resumed, which callers should treat
+// as not paused.
+func (r *QueuePauseRepository) FindByQueue(queue string) (*models.QueuePauseState, error) {
+	var state models.QueuePauseState
+	if err := r.db.Where("queue = ?", queue).First(&state).Error; err != nil {
+		return nil, err
+	}
+	return &state, nil
+}
+
+// List returns every queue with a recorded pause state, paused or not.
+func (r *QueuePauseRepository) List() ([]models.QueuePauseState, error) {
+	var states []models.QueuePauseState
+	if err := r.db.Find(&states).Error; err != nil {
+		return nil, err
+	}
+	return states, nil
+}
+
+// Upsert creates or replaces queue's pause state.
+func (r *QueuePauseRepository) Upsert(state *models.QueuePauseState) error {
+	return r.db.Save(state).Error
+}
@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// ReconciliationRepository provides the cross-tenant account access the
+// balance reconciliation checker needs. It is intentionally not tenant-
+// scoped, since the checker's whole job is to sweep every tenant's
+// accounts looking for drift.
+type ReconciliationRepository struct {
+	db *gorm.DB
+}
+
+// NewReconciliationRepository builds a ReconciliationRepository backed by db.
+func NewReconciliationRepository(db *gorm.DB) *ReconciliationRepository {
+	return &ReconciliationRepository{db: db}
+}
+
+// SampleAccounts returns up to limit accounts to check next, least-recently-
+// updated first, so repeated runs sweep through every account over time
+// instead of re-checking the same ones.
+func (r *ReconciliationRepository) SampleAccounts(limit int) ([]models.Account, error) {
+	var accounts []models.Account
+	if err := r.db.Order("updated_at asc").Limit(limit).Find(&accounts).Error; err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// ReferralRepository provides tenant-scoped persistence for Referral rows.
+type ReferralRepository struct {
+	db *gorm.DB
+}
+
+// NewReferralRepository builds a ReferralRepository backed by db.
+func NewReferralRepository(db *gorm.DB) *ReferralRepository {
+	return &ReferralRepository{db: db}
+}
+
+// Create persists a new referral.
+func (r *ReferralRepository) Create(referral *models.Referral) error {
+	return r.db.Create(referral).Error
+}
+
+// FindPendingByReferredUser looks up the pending referral for a referred
+// user, if any, scoped to tenantID.
+func (r *ReferralRepository) FindPendingByReferredUser(tenantID, referredUserID string) (*models.Referral, error) {
+	var referral models.Referral
+	err := r.db.Where("tenant_id = ? AND referred_user_id = ? AND status = ?", tenantID, referredUserID, models.ReferralPending).
+		First(&referral).Error
+	if err != nil {
+		return nil, err
+	}
+	return &referral, nil
+}
+
+// CountRewardedByReferrer counts how many referrals referrerUserID has
+// already been rewarded for, used to enforce an anti-abuse cap.
+func (r *ReferralRepository) CountRewardedByReferrer(tenantID, referrerUserID string) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.Referral{}).
+		Where("tenant_id = ? AND referrer_user_id = ? AND status = ?", tenantID, referrerUserID, models.ReferralRewarded).
+		Count(&count).Error
+	return count, err
+}
+
+// MarkRewarded flips a referral to rewarded, scoped to tenantID.
+func (r *ReferralRepository) MarkRewarded(tenantID, id string, rewardedAt time.Time) error {
+	return r.db.Model(&models.Referral{}).
+		Where("tenant_id = ? AND id = ?", tenantID, id).
+		Updates(map[string]interface{}{
+			"status":      models.ReferralRewarded,
+			"rewarded_at": rewardedAt,
+		}).Error
+}
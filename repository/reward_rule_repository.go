@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// RewardRuleRepository provides tenant-scoped persistence for RewardRules.
+type RewardRuleRepository struct {
+	db *gorm.DB
+}
+
+// NewRewardRuleRepository builds a RewardRuleRepository backed by db.
+func NewRewardRuleRepository(db *gorm.DB) *RewardRuleRepository {
+	return &RewardRuleRepository{db: db}
+}
+
+// Create persists a new reward rule.
+func (r *RewardRuleRepository) Create(rule *models.RewardRule) error {
+	return r.db.Create(rule).Error
+}
+
+// List returns every reward rule belonging to tenantID, active or not.
+func (r *RewardRuleRepository) List(tenantID string) ([]models.RewardRule, error) {
+	var rules []models.RewardRule
+	if err := r.db.Where("tenant_id = ?", tenantID).Find(&rules).Error; err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// Deactivate marks a reward rule as no longer earning points.
+func (r *RewardRuleRepository) Deactivate(tenantID, id string) error {
+	return r.db.Model(&models.RewardRule{}).
+		Where("tenant_id = ? AND id = ?", tenantID, id).
+		Update("active", false).Error
+}
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// SecurityEventRepository provides persistence for SecurityEvent records.
+// Like BalanceMismatchRepository, this is a platform-wide operational
+// concern rather than a single tenant's data, so List supports optional
+// cross-tenant filtering instead of the tenant-scoped pattern used
+// elsewhere.
+type SecurityEventRepository struct {
+	db *gorm.DB
+}
+
+// NewSecurityEventRepository builds a SecurityEventRepository backed by db.
+func NewSecurityEventRepository(db *gorm.DB) *SecurityEventRepository {
+	return &SecurityEventRepository{db: db}
+}
+
+// Create persists a new security event.
+func (r *SecurityEventRepository) Create(event *models.SecurityEvent) error {
+	return r.db.Create(event).Error
+}
+
+// List returns security events ordered most-recent-first, optionally
+// filtered by tenant. An empty tenantID matches every tenant.
+func (r *SecurityEventRepository) List(tenantID string) ([]models.SecurityEvent, error) {
+	query := r.db.Model(&models.SecurityEvent{})
+	if tenantID != "" {
+		query = query.Where("tenant_id = ?", tenantID)
+	}
+
+	var events []models.SecurityEvent
+	if err := query.Order("created_at DESC").Find(&events).Error; err != nil {
+		return nil, err
+	}
+	return events, nil
+}
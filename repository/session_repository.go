@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// SessionRepository provides tenant-scoped persistence for Session
+// records.
+type SessionRepository struct {
+	db *gorm.DB
+}
+
+// NewSessionRepository builds a SessionRepository backed by db.
+func NewSessionRepository(db *gorm.DB) *SessionRepository {
+	return &SessionRepository{db: db}
+}
+
+// Create persists a newly seen session.
+func (r *SessionRepository) Create(session *models.Session) error {
+	return r.db.Create(session).Error
+}
+
+// FindByUserAndDevice looks up a user's session for a given device, scoped
+// to tenantID. Returns gorm.ErrRecordNotFound if the pair hasn't been seen
+// before.
+func (r *SessionRepository) FindByUserAndDevice(tenantID, userID, deviceID string) (*models.Session, error) {
+	var session models.Session
+	if err := r.db.Where("tenant_id = ? AND user_id = ? AND device_id = ?", tenantID, userID, deviceID).
+		First(&session).Error; err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// FindByID looks up a single session, scoped to tenantID and userID.
+func (r *SessionRepository) FindByID(tenantID, userID, id string) (*models.Session, error) {
+	var session models.Session
+	if err := r.db.Where("tenant_id = ? AND user_id = ? AND id = ?", tenantID, userID, id).
+		First(&session).Error; err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// ListByUser returns every session recorded for userID, scoped to
+// tenantID, most recently active first.
+func (r *SessionRepository) ListByUser(tenantID, userID string) ([]models.Session, error) {
+	var sessions []models.Session
+	if err := r.db.Where("tenant_id = ? AND user_id = ?", tenantID, userID).
+		Order("last_seen_at desc").Find(&sessions).Error; err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+// Touch bumps a session's last-seen metadata.
+func (r *SessionRepository) Touch(tenantID, id, ipAddress, userAgent string, seenAt time.Time) error {
+	return r.db.Model(&models.Session{}).
+		Where("tenant_id = ? AND id = ?", tenantID, id).
+		Updates(map[string]interface{}{
+			"ip_address":   ipAddress,
+			"user_agent":   userAgent,
+			"last_seen_at": seenAt,
+		}).Error
+}
+
+// Revoke persists a session that has had RevokedAt set.
+func (r *SessionRepository) Revoke(session *models.Session) error {
+	return r.db.Save(session).Error
+}
@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// SettlementBatchRepository provides tenant-scoped persistence for
+// SettlementBatch records.
+type SettlementBatchRepository struct {
+	db *gorm.DB
+}
+
+// NewSettlementBatchRepository builds a SettlementBatchRepository backed
+// by db.
+func NewSettlementBatchRepository(db *gorm.DB) *SettlementBatchRepository {
+	return &SettlementBatchRepository{db: db}
+}
+
+// Create persists a new settlement batch.
+func (r *SettlementBatchRepository) Create(batch *models.SettlementBatch) error {
+	return r.db.Create(batch).Error
+}
+
+// ListByMerchant returns every settlement batch produced for merchantID,
+// scoped to tenantID, most recent first.
+func (r *SettlementBatchRepository) ListByMerchant(tenantID, merchantID string) ([]models.SettlementBatch, error) {
+	var batches []models.SettlementBatch
+	if err := r.db.Where("tenant_id = ? AND merchant_id = ?", tenantID, merchantID).
+		Order("created_at desc").Find(&batches).Error; err != nil {
+		return nil, err
+	}
+	return batches, nil
+}
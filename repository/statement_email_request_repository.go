@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// StatementEmailRequestRepository provides tenant-scoped persistence for
+// StatementEmailRequest records.
+type StatementEmailRequestRepository struct {
+	db *gorm.DB
+}
+
+// NewStatementEmailRequestRepository builds a StatementEmailRequestRepository
+// backed by db.
+func NewStatementEmailRequestRepository(db *gorm.DB) *StatementEmailRequestRepository {
+	return &StatementEmailRequestRepository{db: db}
+}
+
+// Create persists a new statement email request.
+func (r *StatementEmailRequestRepository) Create(req *models.StatementEmailRequest) error {
+	return r.db.Create(req).Error
+}
+
+// CountByAccountSince counts statement email requests for accountID posted
+// at or after since, used to rate-limit how often a user can request one.
+func (r *StatementEmailRequestRepository) CountByAccountSince(tenantID, accountID string, since time.Time) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.StatementEmailRequest{}).
+		Where("tenant_id = ? AND account_id = ? AND created_at >= ?", tenantID, accountID, since).
+		Count(&count).Error
+	return count, err
+}
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// StepUpChallengeRepository provides tenant-scoped persistence for
+// large-transaction step-up OTP challenges.
+type StepUpChallengeRepository struct {
+	db *gorm.DB
+}
+
+// NewStepUpChallengeRepository builds a StepUpChallengeRepository backed
+// by db.
+func NewStepUpChallengeRepository(db *gorm.DB) *StepUpChallengeRepository {
+	return &StepUpChallengeRepository{db: db}
+}
+
+// Create persists a new step-up challenge.
+func (r *StepUpChallengeRepository) Create(challenge *models.StepUpChallenge) error {
+	return r.db.Create(challenge).Error
+}
+
+// FindByID looks up a step-up challenge by ID, scoped to tenantID.
+func (r *StepUpChallengeRepository) FindByID(tenantID, id string) (*models.StepUpChallenge, error) {
+	var challenge models.StepUpChallenge
+	if err := r.db.Where("tenant_id = ? AND id = ?", tenantID, id).First(&challenge).Error; err != nil {
+		return nil, err
+	}
+	return &challenge, nil
+}
+
+// IncrementAttempts bumps a step-up challenge's failed-attempt counter,
+// scoped to tenantID.
+func (r *StepUpChallengeRepository) IncrementAttempts(tenantID, id string) error {
+	return r.db.Model(&models.StepUpChallenge{}).
+		Where("tenant_id = ? AND id = ?", tenantID, id).
+		UpdateColumn("attempts", gorm.Expr("attempts + 1")).Error
+}
+
+// MarkStatus moves a step-up challenge from pending to status, scoped to
+// tenantID. The update is guarded by status = pending, so rowsAffected
+// also serves as a compare-and-swap: it comes back 0 if the challenge
+// was already resolved by a concurrent call, instead of transitioning
+// it twice.
+func (r *StepUpChallengeRepository) MarkStatus(tenantID, id string, status models.StepUpChallengeStatus) (rowsAffected int64, err error) {
+	tx := r.db.Model(&models.StepUpChallenge{}).
+		Where("tenant_id = ? AND id = ? AND status = ?", tenantID, id, models.StepUpChallengePending).
+		Update("status", status)
+	return tx.RowsAffected, tx.Error
+}
+
+// Reopen puts a claimed challenge back to pending. It's used when
+// MarkStatus's claim succeeds but the action it was claimed for
+// (posting the transaction) then fails, so the challenge doesn't get
+// stranded verified with nothing posted against it.
+func (r *StepUpChallengeRepository) Reopen(tenantID, id string) error {
+	return r.db.Model(&models.StepUpChallenge{}).
+		Where("tenant_id = ? AND id = ?", tenantID, id).
+		Update("status", models.StepUpChallengePending).Error
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// SuspenseItemRepository provides tenant-scoped persistence for
+// SuspenseItem records.
+type SuspenseItemRepository struct {
+	db *gorm.DB
+}
+
+// NewSuspenseItemRepository builds a SuspenseItemRepository backed by db.
+func NewSuspenseItemRepository(db *gorm.DB) *SuspenseItemRepository {
+	return &SuspenseItemRepository{db: db}
+}
+
+// Create persists a new suspense item.
+func (r *SuspenseItemRepository) Create(item *models.SuspenseItem) error {
+	return r.db.Create(item).Error
+}
+
+// FindByID looks up a suspense item by ID, scoped to tenantID.
+func (r *SuspenseItemRepository) FindByID(tenantID, id string) (*models.SuspenseItem, error) {
+	var item models.SuspenseItem
+	if err := r.db.Where("tenant_id = ? AND id = ?", tenantID, id).First(&item).Error; err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+// ListOpen returns tenantID's unresolved suspense items, oldest first, so
+// the admin workflow works through the longest-outstanding items first.
+func (r *SuspenseItemRepository) ListOpen(tenantID string) ([]models.SuspenseItem, error) {
+	var items []models.SuspenseItem
+	if err := r.db.Where("tenant_id = ? AND status = ?", tenantID, models.SuspenseItemOpen).
+		Order("created_at asc").Find(&items).Error; err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// MarkMatched records that a suspense item was released to accountID via
+// transactionID.
+func (r *SuspenseItemRepository) MarkMatched(tenantID, id, accountID, transactionID string) error {
+	return r.db.Model(&models.SuspenseItem{}).
+		Where("tenant_id = ? AND id = ?", tenantID, id).
+		Updates(map[string]interface{}{
+			"status":                 models.SuspenseItemMatched,
+			"matched_account_id":     accountID,
+			"matched_transaction_id": transactionID,
+		}).Error
+}
+
+// MarkReturned records that a suspense item was returned to the sender.
+func (r *SuspenseItemRepository) MarkReturned(tenantID, id, reason string) error {
+	return r.db.Model(&models.SuspenseItem{}).
+		Where("tenant_id = ? AND id = ?", tenantID, id).
+		Updates(map[string]interface{}{
+			"status":        models.SuspenseItemReturned,
+			"return_reason": reason,
+		}).Error
+}
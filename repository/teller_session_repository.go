@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// TellerSessionRepository provides tenant-scoped persistence for
+// TellerSession records.
+type TellerSessionRepository struct {
+	db *gorm.DB
+}
+
+// NewTellerSessionRepository builds a TellerSessionRepository backed by db.
+func NewTellerSessionRepository(db *gorm.DB) *TellerSessionRepository {
+	return &TellerSessionRepository{db: db}
+}
+
+// Create persists a new teller session.
+func (r *TellerSessionRepository) Create(session *models.TellerSession) error {
+	return r.db.Create(session).Error
+}
+
+// FindOpenForAgent returns the agent's currently open session, if any.
+func (r *TellerSessionRepository) FindOpenForAgent(tenantID, agentID string) (*models.TellerSession, error) {
+	var session models.TellerSession
+	err := r.db.Where("tenant_id = ? AND agent_id = ? AND status = ?", tenantID, agentID, models.TellerSessionOpen).
+		First(&session).Error
+	if err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// Close marks a session closed with the given closing balance.
+func (r *TellerSessionRepository) Close(tenantID, id string, closingBalance int64) error {
+	now := time.Now()
+	return r.db.Model(&models.TellerSession{}).
+		Where("tenant_id = ? AND id = ?", tenantID, id).
+		Updates(map[string]interface{}{
+			"status":          models.TellerSessionClosed,
+			"closing_balance": closingBalance,
+			"closed_at":       now,
+		}).Error
+}
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// TenantRepository provides persistence for Tenant records.
+type TenantRepository struct {
+	db *gorm.DB
+}
+
+// NewTenantRepository builds a TenantRepository backed by db.
+func NewTenantRepository(db *gorm.DB) *TenantRepository {
+	return &TenantRepository{db: db}
+}
+
+// Create persists a new tenant.
+func (r *TenantRepository) Create(tenant *models.Tenant) error {
+	return r.db.Create(tenant).Error
+}
+
+// FindByAPIKey resolves a tenant from its API key, used during request
+// authentication.
+func (r *TenantRepository) FindByAPIKey(apiKey string) (*models.Tenant, error) {
+	var tenant models.Tenant
+	if err := r.db.Where("api_key = ?", apiKey).First(&tenant).Error; err != nil {
+		return nil, err
+	}
+	return &tenant, nil
+}
+
+// FindByID looks up a tenant by its primary key.
+func (r *TenantRepository) FindByID(id string) (*models.Tenant, error) {
+	var tenant models.Tenant
+	if err := r.db.Where("id = ?", id).First(&tenant).Error; err != nil {
+		return nil, err
+	}
+	return &tenant, nil
+}
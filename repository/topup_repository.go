@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// TopUpRepository provides tenant-scoped persistence for TopUp records.
+type TopUpRepository struct {
+	db *gorm.DB
+}
+
+// NewTopUpRepository builds a TopUpRepository backed by db.
+func NewTopUpRepository(db *gorm.DB) *TopUpRepository {
+	return &TopUpRepository{db: db}
+}
+
+// Create persists a new top-up record.
+func (r *TopUpRepository) Create(topup *models.TopUp) error {
+	return r.db.Create(topup).Error
+}
+
+// ListByAccount returns top-up history for accountID within tenantID.
+func (r *TopUpRepository) ListByAccount(tenantID, accountID string) ([]models.TopUp, error) {
+	var topups []models.TopUp
+	if err := r.db.Where("tenant_id = ? AND account_id = ?", tenantID, accountID).
+		Order("created_at desc").Find(&topups).Error; err != nil {
+		return nil, err
+	}
+	return topups, nil
+}
@@ -0,0 +1,275 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// TransactionLogRepository appends to and queries the Mongo-backed
+// transaction log. Every read is scoped to a tenant so that one tenant can
+// never observe another tenant's activity.
+type TransactionLogRepository struct {
+	collection *mongo.Collection
+}
+
+// NewTransactionLogRepository builds a TransactionLogRepository backed by
+// the "transaction_logs" collection in db.
+func NewTransactionLogRepository(db *mongo.Database) *TransactionLogRepository {
+	return &TransactionLogRepository{collection: db.Collection("transaction_logs")}
+}
+
+// Insert appends a new transaction log entry.
+func (r *TransactionLogRepository) Insert(ctx context.Context, entry *models.TransactionLog) error {
+	_, err := r.collection.InsertOne(ctx, entry)
+	return err
+}
+
+// SetFields applies fields as a partial update to the log entry
+// identified by id, for annotating an entry after it's already been
+// written (see services.LogEnrichmentService).
+func (r *TransactionLogRepository) SetFields(ctx context.Context, id string, fields map[string]interface{}) error {
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": fields})
+	return err
+}
+
+// ListByAccount returns transaction log entries for accountID within
+// tenantID, most recent activity is not ordered by this call and should be
+// sorted by the caller if required.
+func (r *TransactionLogRepository) ListByAccount(ctx context.Context, tenantID, accountID string) ([]models.TransactionLog, error) {
+	cursor, err := r.collection.Find(ctx, map[string]interface{}{
+		"tenant_id":  tenantID,
+		"account_id": accountID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var entries []models.TransactionLog
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// TransactionLogFilter narrows an Export cursor to a subset of the log.
+// Zero-value fields are not applied as filters, so an empty filter exports
+// every entry across every tenant.
+type TransactionLogFilter struct {
+	TenantID  string
+	AccountID string
+	Type      string
+	From      *time.Time
+	To        *time.Time
+}
+
+// Export returns a cursor over every transaction log entry matching
+// filter, for the caller to stream out rather than buffering the whole
+// result set in memory.
+func (r *TransactionLogRepository) Export(ctx context.Context, filter TransactionLogFilter) (*mongo.Cursor, error) {
+	query := map[string]interface{}{}
+	if filter.TenantID != "" {
+		query["tenant_id"] = filter.TenantID
+	}
+	if filter.AccountID != "" {
+		query["account_id"] = filter.AccountID
+	}
+	if filter.Type != "" {
+		query["type"] = filter.Type
+	}
+	if filter.From != nil || filter.To != nil {
+		createdAt := map[string]interface{}{}
+		if filter.From != nil {
+			createdAt["$gte"] = *filter.From
+		}
+		if filter.To != nil {
+			createdAt["$lte"] = *filter.To
+		}
+		query["created_at"] = createdAt
+	}
+	return r.collection.Find(ctx, query)
+}
+
+// DailyAccountAggregate is one account's totals for a single day, as
+// computed by AggregateDaily.
+type DailyAccountAggregate struct {
+	AccountID          string `bson:"_id"`
+	TotalIn            int64  `bson:"total_in"`
+	TotalOut           int64  `bson:"total_out"`
+	LargestTransaction int64  `bson:"largest_transaction"`
+}
+
+// AggregateDaily runs a Mongo aggregation pipeline over accountIDs' log
+// entries in [from, to), grouping by account and summing deposits, credit
+// adjustments, and incoming transfers into total_in, withdrawals, debit
+// adjustments, and outbound transfers into total_out, and tracking the
+// single largest transaction amount seen. A transfer is logged once per
+// side (see TransactionService.buildLogEntries), so its entry's direction
+// decides which of the two totals it counts toward.
+func (r *TransactionLogRepository) AggregateDaily(ctx context.Context, tenantID string, accountIDs []string, from, to time.Time) ([]DailyAccountAggregate, error) {
+	if len(accountIDs) == 0 {
+		return nil, nil
+	}
+
+	pipeline := mongo.Pipeline{
+		bson.D{{Key: "$match", Value: bson.D{
+			{Key: "tenant_id", Value: tenantID},
+			{Key: "account_id", Value: bson.D{{Key: "$in", Value: accountIDs}}},
+			{Key: "created_at", Value: bson.D{{Key: "$gte", Value: from}, {Key: "$lt", Value: to}}},
+		}}},
+		bson.D{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$account_id"},
+			{Key: "total_in", Value: bson.D{{Key: "$sum", Value: bson.D{{Key: "$cond", Value: bson.A{
+				bson.D{{Key: "$or", Value: bson.A{
+					bson.D{{Key: "$in", Value: bson.A{"$type", bson.A{
+						models.TransactionTypeDeposit,
+						models.TransactionTypeAdjustmentCredit,
+					}}}},
+					transferDirectionMatch(models.TransactionLogDirectionCredit),
+				}}},
+				"$amount", 0,
+			}}}}}},
+			{Key: "total_out", Value: bson.D{{Key: "$sum", Value: bson.D{{Key: "$cond", Value: bson.A{
+				bson.D{{Key: "$or", Value: bson.A{
+					bson.D{{Key: "$in", Value: bson.A{"$type", bson.A{
+						models.TransactionTypeWithdrawal,
+						models.TransactionTypeAdjustmentDebit,
+					}}}},
+					transferDirectionMatch(models.TransactionLogDirectionDebit),
+				}}},
+				"$amount", 0,
+			}}}}}},
+			{Key: "largest_transaction", Value: bson.D{{Key: "$max", Value: "$amount"}}},
+		}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []DailyAccountAggregate
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// transferDirectionMatch is an aggregation expression matching a transfer
+// entry logged with direction.
+func transferDirectionMatch(direction models.TransactionLogDirection) bson.D {
+	return bson.D{{Key: "$and", Value: bson.A{
+		bson.D{{Key: "$eq", Value: bson.A{"$type", models.TransactionTypeTransfer}}},
+		bson.D{{Key: "$eq", Value: bson.A{"$direction", direction}}},
+	}}}
+}
+
+// MonthlyAccountTypeCategoryAggregate is one account's totals for a single
+// (type, category) pair over a month, as computed by AggregateMonthly.
+type MonthlyAccountTypeCategoryAggregate struct {
+	AccountID string `bson:"account_id"`
+	Type      string `bson:"type"`
+	Category  string `bson:"category"`
+	Count     int64  `bson:"count"`
+	Total     int64  `bson:"total"`
+	Min       int64  `bson:"min"`
+	Max       int64  `bson:"max"`
+}
+
+// AggregateMonthly runs a Mongo aggregation pipeline over accountIDs' log
+// entries in [from, to), grouping by account, type, and category, and
+// computing the count, sum, min, and max transaction amount within each
+// group. Entries with no category group under an empty category.
+func (r *TransactionLogRepository) AggregateMonthly(ctx context.Context, tenantID string, accountIDs []string, from, to time.Time) ([]MonthlyAccountTypeCategoryAggregate, error) {
+	if len(accountIDs) == 0 {
+		return nil, nil
+	}
+
+	pipeline := mongo.Pipeline{
+		bson.D{{Key: "$match", Value: bson.D{
+			{Key: "tenant_id", Value: tenantID},
+			{Key: "account_id", Value: bson.D{{Key: "$in", Value: accountIDs}}},
+			{Key: "created_at", Value: bson.D{{Key: "$gte", Value: from}, {Key: "$lt", Value: to}}},
+		}}},
+		bson.D{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: bson.D{
+				{Key: "account_id", Value: "$account_id"},
+				{Key: "type", Value: "$type"},
+				{Key: "category", Value: bson.D{{Key: "$ifNull", Value: bson.A{"$category", ""}}}},
+			}},
+			{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+			{Key: "total", Value: bson.D{{Key: "$sum", Value: "$amount"}}},
+			{Key: "min", Value: bson.D{{Key: "$min", Value: "$amount"}}},
+			{Key: "max", Value: bson.D{{Key: "$max", Value: "$amount"}}},
+		}}},
+		bson.D{{Key: "$project", Value: bson.D{
+			{Key: "_id", Value: 0},
+			{Key: "account_id", Value: "$_id.account_id"},
+			{Key: "type", Value: "$_id.type"},
+			{Key: "category", Value: "$_id.category"},
+			{Key: "count", Value: 1},
+			{Key: "total", Value: 1},
+			{Key: "min", Value: 1},
+			{Key: "max", Value: 1},
+		}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []MonthlyAccountTypeCategoryAggregate
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// TransactionLogSearchResult is one hit from Search, decorated with its
+// full-text relevance score for the caller to rank/display by.
+type TransactionLogSearchResult struct {
+	models.TransactionLog `bson:",inline"`
+	Score                 float64 `bson:"score" json:"score"`
+}
+
+// Search runs a full-text query over the log's Mongo text index (see
+// database.EnsureTransactionLogTextIndex), scoped to tenantID and
+// accountID, and returns matches ranked by relevance, most relevant
+// first. page is 1-indexed.
+func (r *TransactionLogRepository) Search(ctx context.Context, tenantID, accountID, query string, page, pageSize int) ([]TransactionLogSearchResult, error) {
+	pipeline := mongo.Pipeline{
+		bson.D{{Key: "$match", Value: bson.D{
+			{Key: "tenant_id", Value: tenantID},
+			{Key: "account_id", Value: accountID},
+			{Key: "$text", Value: bson.D{{Key: "$search", Value: query}}},
+		}}},
+		bson.D{{Key: "$addFields", Value: bson.D{
+			{Key: "score", Value: bson.D{{Key: "$meta", Value: "textScore"}}},
+		}}},
+		bson.D{{Key: "$sort", Value: bson.D{
+			{Key: "score", Value: bson.D{{Key: "$meta", Value: "textScore"}}},
+		}}},
+		bson.D{{Key: "$skip", Value: int64((page - 1) * pageSize)}},
+		bson.D{{Key: "$limit", Value: int64(pageSize)}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []TransactionLogSearchResult
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
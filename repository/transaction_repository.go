@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// TransactionRepository provides tenant-scoped persistence for the
+// Postgres system-of-record Transaction rows.
+type TransactionRepository struct {
+	db *gorm.DB
+}
+
+// NewTransactionRepository builds a TransactionRepository backed by db.
+func NewTransactionRepository(db *gorm.DB) *TransactionRepository {
+	return &TransactionRepository{db: db}
+}
+
+// Create persists a transaction. Callers that need atomicity with a
+// balance update should use WithTx inside their own gorm transaction.
+func (r *TransactionRepository) Create(tx *models.Transaction) error {
+	return r.db.Create(tx).Error
+}
+
+// CreateBatch persists every transaction in a single multi-row INSERT,
+// for high-volume ingestion paths that can't afford one round trip per
+// row.
+func (r *TransactionRepository) CreateBatch(txs []*models.Transaction) error {
+	if len(txs) == 0 {
+		return nil
+	}
+	return r.db.Create(&txs).Error
+}
+
+// WithTx returns a copy of the repository bound to dbtx, for chaining
+// multiple tenant-scoped operations inside the same DB transaction.
+func (r *TransactionRepository) WithTx(dbtx *gorm.DB) *TransactionRepository {
+	return &TransactionRepository{db: dbtx}
+}
+
+// FindByID looks up a transaction by ID, scoped to tenantID.
+func (r *TransactionRepository) FindByID(tenantID, id string) (*models.Transaction, error) {
+	var tx models.Transaction
+	if err := r.db.Where("tenant_id = ? AND id = ?", tenantID, id).First(&tx).Error; err != nil {
+		return nil, err
+	}
+	return &tx, nil
+}
+
+// ListByAccount returns every transaction for accountID within tenantID.
+func (r *TransactionRepository) ListByAccount(tenantID, accountID string) ([]models.Transaction, error) {
+	var txs []models.Transaction
+	if err := r.db.Where("tenant_id = ? AND account_id = ?", tenantID, accountID).
+		Order("created_at desc").Find(&txs).Error; err != nil {
+		return nil, err
+	}
+	return txs, nil
+}
+
+// CountByAccountSince counts transactions of txType for accountID posted
+// at or after since, used by velocity fraud rules.
+func (r *TransactionRepository) CountByAccountSince(tenantID, accountID string, txType models.TransactionType, since time.Time) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.Transaction{}).
+		Where("tenant_id = ? AND account_id = ? AND type = ? AND created_at >= ?", tenantID, accountID, txType, since).
+		Count(&count).Error
+	return count, err
+}
+
+// CountAllTypesByAccountSince counts transactions of any type for
+// accountID posted at or after since, used to throttle transaction
+// submission rate independent of type.
+func (r *TransactionRepository) CountAllTypesByAccountSince(tenantID, accountID string, since time.Time) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.Transaction{}).
+		Where("tenant_id = ? AND account_id = ? AND created_at >= ?", tenantID, accountID, since).
+		Count(&count).Error
+	return count, err
+}
+
+// SumAmountByAccountSince sums the amount of transactions of txType for
+// accountID posted at or after since, used by velocity fraud rules.
+func (r *TransactionRepository) SumAmountByAccountSince(tenantID, accountID string, txType models.TransactionType, since time.Time) (int64, error) {
+	var sum int64
+	err := r.db.Model(&models.Transaction{}).
+		Where("tenant_id = ? AND account_id = ? AND type = ? AND created_at >= ?", tenantID, accountID, txType, since).
+		Select("COALESCE(SUM(amount), 0)").Scan(&sum).Error
+	return sum, err
+}
+
+// ListUnsettledByMerchant returns every posted transaction tagged with
+// merchant, scoped to tenantID, that hasn't yet been swept into a
+// settlement batch.
+func (r *TransactionRepository) ListUnsettledByMerchant(tenantID, merchant string) ([]models.Transaction, error) {
+	var txs []models.Transaction
+	if err := r.db.Where("tenant_id = ? AND merchant = ? AND settled = ?", tenantID, merchant, false).
+		Find(&txs).Error; err != nil {
+		return nil, err
+	}
+	return txs, nil
+}
+
+// MarkSettled flags every transaction in ids, scoped to tenantID, as
+// settled so it isn't swept into a later settlement batch.
+func (r *TransactionRepository) MarkSettled(tenantID string, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return r.db.Model(&models.Transaction{}).
+		Where("tenant_id = ? AND id IN ?", tenantID, ids).
+		Update("settled", true).Error
+}
+
+// LastByAccount returns the most recently posted transaction for
+// accountID, scoped to tenantID. Used by risk scoring to compare the
+// client IP of consecutive transactions.
+func (r *TransactionRepository) LastByAccount(tenantID, accountID string) (*models.Transaction, error) {
+	var tx models.Transaction
+	if err := r.db.Where("tenant_id = ? AND account_id = ?", tenantID, accountID).
+		Order("created_at desc").First(&tx).Error; err != nil {
+		return nil, err
+	}
+	return &tx, nil
+}
+
+// ListByAccountBetween returns every transaction for accountID posted at or
+// after from and at or before to, oldest first, for statement generation.
+func (r *TransactionRepository) ListByAccountBetween(tenantID, accountID string, from, to time.Time) ([]models.Transaction, error) {
+	var txs []models.Transaction
+	if err := r.db.Where("tenant_id = ? AND account_id = ? AND created_at >= ? AND created_at <= ?", tenantID, accountID, from, to).
+		Order("created_at asc").Find(&txs).Error; err != nil {
+		return nil, err
+	}
+	return txs, nil
+}
+
+// BalanceBefore returns the account's BalanceAfter as of its last
+// transaction strictly before at, or 0 if it has none, for computing a
+// statement's opening balance.
+func (r *TransactionRepository) BalanceBefore(tenantID, accountID string, at time.Time) (int64, error) {
+	var tx models.Transaction
+	err := r.db.Where("tenant_id = ? AND account_id = ? AND created_at < ?", tenantID, accountID, at).
+		Order("created_at desc").First(&tx).Error
+	if err == gorm.ErrRecordNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return tx.BalanceAfter, nil
+}
+
+// HasPriorTransferTo reports whether accountID has ever transferred to
+// counterpartyAccountID before, used to detect first-time beneficiaries.
+func (r *TransactionRepository) HasPriorTransferTo(tenantID, accountID, counterpartyAccountID string) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.Transaction{}).
+		Where("tenant_id = ? AND account_id = ? AND counterparty_account_id = ? AND type = ?",
+			tenantID, accountID, counterpartyAccountID, models.TransactionTypeTransfer).
+		Count(&count).Error
+	return count > 0, err
+}
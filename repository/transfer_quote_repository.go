@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// TransferQuoteRepository provides tenant-scoped persistence for
+// TransferQuote records.
+type TransferQuoteRepository struct {
+	db *gorm.DB
+}
+
+// NewTransferQuoteRepository builds a TransferQuoteRepository backed by db.
+func NewTransferQuoteRepository(db *gorm.DB) *TransferQuoteRepository {
+	return &TransferQuoteRepository{db: db}
+}
+
+// Create persists a new transfer quote.
+func (r *TransferQuoteRepository) Create(quote *models.TransferQuote) error {
+	return r.db.Create(quote).Error
+}
+
+// FindByID looks up a transfer quote by ID, scoped to tenantID.
+func (r *TransferQuoteRepository) FindByID(tenantID, id string) (*models.TransferQuote, error) {
+	var quote models.TransferQuote
+	if err := r.db.Where("tenant_id = ? AND id = ?", tenantID, id).First(&quote).Error; err != nil {
+		return nil, err
+	}
+	return &quote, nil
+}
+
+// MarkExpired transitions a transfer quote from pending to expired. The
+// update is guarded by status = pending, so it can't clobber a quote a
+// concurrent Execute call already claimed.
+func (r *TransferQuoteRepository) MarkExpired(tenantID, id string) error {
+	return r.db.Model(&models.TransferQuote{}).
+		Where("tenant_id = ? AND id = ? AND status = ?", tenantID, id, models.TransferQuotePending).
+		Update("status", models.TransferQuoteExpired).Error
+}
+
+// MarkExecuted transitions a transfer quote from pending to executed,
+// scoped to tenantID. The update is guarded by status = pending, so
+// rowsAffected also serves as a compare-and-swap: it comes back 0 if the
+// quote was already executed or expired by a concurrent call, instead of
+// executing the external transfer twice.
+func (r *TransferQuoteRepository) MarkExecuted(tenantID, id string) (rowsAffected int64, err error) {
+	tx := r.db.Model(&models.TransferQuote{}).
+		Where("tenant_id = ? AND id = ? AND status = ?", tenantID, id, models.TransferQuotePending).
+		Update("status", models.TransferQuoteExecuted)
+	return tx.RowsAffected, tx.Error
+}
+
+// SetExternalTransferID records the external transfer a claimed quote
+// produced, once execution has completed.
+func (r *TransferQuoteRepository) SetExternalTransferID(tenantID, id, externalTransferID string) error {
+	return r.db.Model(&models.TransferQuote{}).
+		Where("tenant_id = ? AND id = ?", tenantID, id).
+		Update("external_transfer_id", externalTransferID).Error
+}
+
+// Reopen puts a claimed quote back to pending. It's used when
+// MarkExecuted's claim succeeds but the action it was claimed for
+// (submitting the external transfer) then fails, so the quote doesn't
+// get stranded executed with nothing filed against it.
+func (r *TransferQuoteRepository) Reopen(tenantID, id string) error {
+	return r.db.Model(&models.TransferQuote{}).
+		Where("tenant_id = ? AND id = ?", tenantID, id).
+		Update("status", models.TransferQuotePending).Error
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// TrustedDeviceRepository provides tenant-scoped persistence for a user's
+// trusted devices.
+type TrustedDeviceRepository struct {
+	db *gorm.DB
+}
+
+// NewTrustedDeviceRepository builds a TrustedDeviceRepository backed by db.
+func NewTrustedDeviceRepository(db *gorm.DB) *TrustedDeviceRepository {
+	return &TrustedDeviceRepository{db: db}
+}
+
+// Create persists a newly trusted device.
+func (r *TrustedDeviceRepository) Create(device *models.TrustedDevice) error {
+	return r.db.Create(device).Error
+}
+
+// FindByUserAndDevice looks up a trusted device for userID, scoped to
+// tenantID. Returns gorm.ErrRecordNotFound if the device isn't trusted.
+func (r *TrustedDeviceRepository) FindByUserAndDevice(tenantID, userID, deviceID string) (*models.TrustedDevice, error) {
+	var device models.TrustedDevice
+	if err := r.db.Where("tenant_id = ? AND user_id = ? AND device_id = ?", tenantID, userID, deviceID).
+		First(&device).Error; err != nil {
+		return nil, err
+	}
+	return &device, nil
+}
+
+// ListByUser returns every device trusted for userID, scoped to tenantID.
+func (r *TrustedDeviceRepository) ListByUser(tenantID, userID string) ([]models.TrustedDevice, error) {
+	var devices []models.TrustedDevice
+	if err := r.db.Where("tenant_id = ? AND user_id = ?", tenantID, userID).
+		Order("last_seen_at desc").Find(&devices).Error; err != nil {
+		return nil, err
+	}
+	return devices, nil
+}
+
+// Touch bumps a trusted device's last-seen timestamp.
+func (r *TrustedDeviceRepository) Touch(tenantID, id string) error {
+	return r.db.Model(&models.TrustedDevice{}).
+		Where("tenant_id = ? AND id = ?", tenantID, id).
+		Update("last_seen_at", time.Now()).Error
+}
+
+// UpdatePushToken sets a trusted device's current push-notification
+// token, scoped to tenantID and userID.
+func (r *TrustedDeviceRepository) UpdatePushToken(tenantID, userID, id, token string) error {
+	return r.db.Model(&models.TrustedDevice{}).
+		Where("tenant_id = ? AND user_id = ? AND id = ?", tenantID, userID, id).
+		Update("push_token", token).Error
+}
+
+// Delete revokes a trusted device, scoped to tenantID and userID.
+func (r *TrustedDeviceRepository) Delete(tenantID, userID, id string) error {
+	return r.db.Where("tenant_id = ? AND user_id = ? AND id = ?", tenantID, userID, id).
+		Delete(&models.TrustedDevice{}).Error
+}
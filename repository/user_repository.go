@@ -0,0 +1,262 @@
+package repository
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// UserRepository provides tenant-scoped persistence for User records.
+// Every method requires a tenantID and never returns rows belonging to a
+// different tenant.
+type UserRepository struct {
+	db           *gorm.DB
+	codec        models.PhoneCodec
+	phoneHashKey []byte
+}
+
+// NewUserRepository builds a UserRepository backed by db. codec may be
+// nil, in which case Phone is stored and read back as plain text,
+// matching prior behavior; when set, every method transparently encrypts
+// Phone before writing it and decrypts it after reading, so callers keep
+// working with plaintext throughout. phoneHashKey keys the HMAC stored in
+// PhoneHash (see models.HashPhone); it only needs to be non-nil for
+// methods that hash or look up by phone.
+func NewUserRepository(db *gorm.DB, codec models.PhoneCodec, phoneHashKey []byte) *UserRepository {
+	return &UserRepository{db: db, codec: codec, phoneHashKey: phoneHashKey}
+}
+
+// HashPhone returns phone's PhoneHash digest, keyed the same way every
+// other UserRepository method keys it, so callers outside this package
+// (e.g. ContactService) can compute a comparable digest without handling
+// the underlying key themselves.
+func (r *UserRepository) HashPhone(phone string) string {
+	return models.HashPhone(phone, r.phoneHashKey)
+}
+
+// encryptPhone sets user.PhoneHash from its current Phone and, if a codec
+// is configured, overwrites Phone with its ciphertext, returning the
+// original plaintext so the caller's in-memory struct can be restored
+// after the write.
+func (r *UserRepository) encryptPhone(user *models.User) (plaintext string, err error) {
+	plaintext = user.Phone
+	if plaintext == "" {
+		return plaintext, nil
+	}
+	user.PhoneHash = r.HashPhone(plaintext)
+	if r.codec == nil {
+		return plaintext, nil
+	}
+	ciphertext, err := r.codec.EncryptPhone(plaintext)
+	if err != nil {
+		return "", err
+	}
+	user.Phone = ciphertext
+	return plaintext, nil
+}
+
+// decryptPhone reverses encryptPhone's ciphertext substitution in place,
+// a no-op when no codec is configured or the row has no phone recorded.
+func (r *UserRepository) decryptPhone(user *models.User) error {
+	if r.codec == nil || user.Phone == "" {
+		return nil
+	}
+	plaintext, err := r.codec.DecryptPhone(user.Phone)
+	if err != nil {
+		return err
+	}
+	user.Phone = plaintext
+	return nil
+}
+
+// Create persists a new user under the given tenant.
+func (r *UserRepository) Create(user *models.User) error {
+	plaintext, err := r.encryptPhone(user)
+	if err != nil {
+		return err
+	}
+	if err := r.db.Create(user).Error; err != nil {
+		return err
+	}
+	user.Phone = plaintext
+	return nil
+}
+
+// FindByID looks up a user by ID, scoped to tenantID.
+func (r *UserRepository) FindByID(tenantID, id string) (*models.User, error) {
+	var user models.User
+	if err := r.db.Where("tenant_id = ? AND id = ?", tenantID, id).First(&user).Error; err != nil {
+		return nil, err
+	}
+	if err := r.decryptPhone(&user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// FindByEmail looks up a user by email, scoped to tenantID.
+func (r *UserRepository) FindByEmail(tenantID, email string) (*models.User, error) {
+	var user models.User
+	if err := r.db.Where("tenant_id = ? AND email = ?", tenantID, email).First(&user).Error; err != nil {
+		return nil, err
+	}
+	if err := r.decryptPhone(&user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// FindByPhone looks up a user by phone number, scoped to tenantID. Phone
+// numbers aren't guaranteed unique, so this returns whichever registered
+// user matches first. Lookup matches on the deterministic HashPhone
+// digest rather than the (possibly encrypted) Phone column itself, so it
+// works the same whether or not a PhoneCodec is configured.
+func (r *UserRepository) FindByPhone(tenantID, phone string) (*models.User, error) {
+	var user models.User
+	if err := r.db.Where("tenant_id = ? AND phone_hash = ?", tenantID, r.HashPhone(phone)).First(&user).Error; err != nil {
+		return nil, err
+	}
+	if err := r.decryptPhone(&user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// FindByReferralCode looks up a user by their referral code, scoped to
+// tenantID.
+func (r *UserRepository) FindByReferralCode(tenantID, code string) (*models.User, error) {
+	var user models.User
+	if err := r.db.Where("tenant_id = ? AND referral_code = ?", tenantID, code).First(&user).Error; err != nil {
+		return nil, err
+	}
+	if err := r.decryptPhone(&user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// List returns every user belonging to tenantID.
+func (r *UserRepository) List(tenantID string) ([]models.User, error) {
+	var users []models.User
+	if err := r.db.Where("tenant_id = ?", tenantID).Find(&users).Error; err != nil {
+		return nil, err
+	}
+	for i := range users {
+		if err := r.decryptPhone(&users[i]); err != nil {
+			return nil, err
+		}
+	}
+	return users, nil
+}
+
+// UpdatePhone sets a user's phone number, scoped to tenantID.
+func (r *UserRepository) UpdatePhone(tenantID, id, phone string) error {
+	user := &models.User{Phone: phone}
+	if _, err := r.encryptPhone(user); err != nil {
+		return err
+	}
+	return r.db.Model(&models.User{}).
+		Where("tenant_id = ? AND id = ?", tenantID, id).
+		Updates(map[string]interface{}{"phone": user.Phone, "phone_hash": user.PhoneHash}).Error
+}
+
+// UpdatePINHash sets a user's PIN hash, scoped to tenantID.
+func (r *UserRepository) UpdatePINHash(tenantID, id, pinHash string) error {
+	return r.db.Model(&models.User{}).
+		Where("tenant_id = ? AND id = ?", tenantID, id).
+		Update("pin_hash", pinHash).Error
+}
+
+// UpdateLocked sets whether a user is locked out of authenticating,
+// scoped to tenantID.
+func (r *UserRepository) UpdateLocked(tenantID, id string, locked bool) error {
+	return r.db.Model(&models.User{}).
+		Where("tenant_id = ? AND id = ?", tenantID, id).
+		Update("locked", locked).Error
+}
+
+// UpdateTier sets a user's product-eligibility tier, scoped to tenantID.
+func (r *UserRepository) UpdateTier(tenantID, id string, tier models.UserTier) error {
+	return r.db.Model(&models.User{}).
+		Where("tenant_id = ? AND id = ?", tenantID, id).
+		Update("tier", tier).Error
+}
+
+// UserFilter narrows ListFiltered to a subset of a tenant's users.
+// Zero-value fields are not applied as filters.
+type UserFilter struct {
+	// KYCLevel, if set, matches exactly: there's no separate KYC "status"
+	// field on User, only the numeric KYCLevel product-eligibility gate,
+	// so that's what admin filtering searches by.
+	KYCLevel      *int
+	Tier          models.UserTier
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+}
+
+// ListFiltered returns tenantID's users matching filter, for admin
+// server-side search.
+func (r *UserRepository) ListFiltered(tenantID string, filter UserFilter) ([]models.User, error) {
+	query := r.db.Where("tenant_id = ?", tenantID)
+	if filter.KYCLevel != nil {
+		query = query.Where("kyc_level = ?", *filter.KYCLevel)
+	}
+	if filter.Tier != "" {
+		query = query.Where("tier = ?", filter.Tier)
+	}
+	if filter.CreatedAfter != nil {
+		query = query.Where("created_at >= ?", *filter.CreatedAfter)
+	}
+	if filter.CreatedBefore != nil {
+		query = query.Where("created_at <= ?", *filter.CreatedBefore)
+	}
+
+	var users []models.User
+	if err := query.Find(&users).Error; err != nil {
+		return nil, err
+	}
+	for i := range users {
+		if err := r.decryptPhone(&users[i]); err != nil {
+			return nil, err
+		}
+	}
+	return users, nil
+}
+
+// EncryptExistingPhones is the migration path for rows written before a
+// PhoneCodec was configured: it finds up to batchSize users with a phone
+// on file but no PhoneHash yet, encrypts and hashes each, and returns how
+// many it migrated. Callers re-invoke it (e.g. from an operator script or
+// admin endpoint) until it returns 0, since it processes one bounded
+// batch per call rather than the whole table at once. It's a no-op,
+// returning 0, if no codec is configured.
+func (r *UserRepository) EncryptExistingPhones(tenantID string, batchSize int) (int, error) {
+	if r.codec == nil {
+		return 0, nil
+	}
+
+	var users []models.User
+	if err := r.db.Where("tenant_id = ? AND phone <> '' AND phone_hash = ''", tenantID).
+		Limit(batchSize).Find(&users).Error; err != nil {
+		return 0, err
+	}
+
+	for i := range users {
+		user := &users[i]
+		plaintext := user.Phone
+		user.PhoneHash = r.HashPhone(plaintext)
+		ciphertext, err := r.codec.EncryptPhone(plaintext)
+		if err != nil {
+			return 0, err
+		}
+		if err := r.db.Model(&models.User{}).
+			Where("tenant_id = ? AND id = ?", tenantID, user.ID).
+			Updates(map[string]interface{}{"phone": ciphertext, "phone_hash": user.PhoneHash}).Error; err != nil {
+			return 0, err
+		}
+	}
+
+	return len(users), nil
+}
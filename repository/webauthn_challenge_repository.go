@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// WebAuthnChallengeRepository provides tenant-scoped persistence for
+// WebAuthn registration/assertion challenges.
+type WebAuthnChallengeRepository struct {
+	db *gorm.DB
+}
+
+// NewWebAuthnChallengeRepository builds a WebAuthnChallengeRepository
+// backed by db.
+func NewWebAuthnChallengeRepository(db *gorm.DB) *WebAuthnChallengeRepository {
+	return &WebAuthnChallengeRepository{db: db}
+}
+
+// Create persists a newly issued challenge.
+func (r *WebAuthnChallengeRepository) Create(challenge *models.WebAuthnChallenge) error {
+	return r.db.Create(challenge).Error
+}
+
+// FindByID looks up a challenge by ID, scoped to tenantID.
+func (r *WebAuthnChallengeRepository) FindByID(tenantID, id string) (*models.WebAuthnChallenge, error) {
+	var challenge models.WebAuthnChallenge
+	if err := r.db.Where("tenant_id = ? AND id = ?", tenantID, id).First(&challenge).Error; err != nil {
+		return nil, err
+	}
+	return &challenge, nil
+}
+
+// MarkVerified marks a challenge consumed, scoped to tenantID, so it
+// can't be replayed against a second registration or assertion.
+func (r *WebAuthnChallengeRepository) MarkVerified(tenantID, id string) error {
+	return r.db.Model(&models.WebAuthnChallenge{}).
+		Where("tenant_id = ? AND id = ?", tenantID, id).
+		Update("status", models.WebAuthnChallengeVerified).Error
+}
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// WebAuthnCredentialRepository provides tenant-scoped persistence for
+// registered passkeys.
+type WebAuthnCredentialRepository struct {
+	db *gorm.DB
+}
+
+// NewWebAuthnCredentialRepository builds a WebAuthnCredentialRepository
+// backed by db.
+func NewWebAuthnCredentialRepository(db *gorm.DB) *WebAuthnCredentialRepository {
+	return &WebAuthnCredentialRepository{db: db}
+}
+
+// Create persists a newly registered credential.
+func (r *WebAuthnCredentialRepository) Create(credential *models.WebAuthnCredential) error {
+	return r.db.Create(credential).Error
+}
+
+// FindByID looks up a credential by ID, scoped to tenantID.
+func (r *WebAuthnCredentialRepository) FindByID(tenantID, id string) (*models.WebAuthnCredential, error) {
+	var credential models.WebAuthnCredential
+	if err := r.db.Where("tenant_id = ? AND id = ?", tenantID, id).First(&credential).Error; err != nil {
+		return nil, err
+	}
+	return &credential, nil
+}
+
+// ListByUser returns every passkey registered to userID, scoped to
+// tenantID.
+func (r *WebAuthnCredentialRepository) ListByUser(tenantID, userID string) ([]models.WebAuthnCredential, error) {
+	var credentials []models.WebAuthnCredential
+	if err := r.db.Where("tenant_id = ? AND user_id = ?", tenantID, userID).Find(&credentials).Error; err != nil {
+		return nil, err
+	}
+	return credentials, nil
+}
+
+// Touch records a successful assertion against a credential, scoped to
+// tenantID.
+func (r *WebAuthnCredentialRepository) Touch(tenantID, id string) error {
+	return r.db.Model(&models.WebAuthnCredential{}).
+		Where("tenant_id = ? AND id = ?", tenantID, id).
+		Update("last_used_at", time.Now()).Error
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// WebhookEventRepository provides tenant-scoped persistence for the
+// dedup ledger backing exactly-once webhook processing.
+type WebhookEventRepository struct {
+	db *gorm.DB
+}
+
+// NewWebhookEventRepository builds a WebhookEventRepository backed by db.
+func NewWebhookEventRepository(db *gorm.DB) *WebhookEventRepository {
+	return &WebhookEventRepository{db: db}
+}
+
+// Create records a new webhook event. It fails with a unique constraint
+// violation if this provider already delivered externalEventID for this
+// tenant.
+func (r *WebhookEventRepository) Create(event *models.WebhookEvent) error {
+	return r.db.Create(event).Error
+}
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// WebhookProviderRepository provides tenant-scoped persistence for
+// WebhookProvider records.
+type WebhookProviderRepository struct {
+	db *gorm.DB
+}
+
+// NewWebhookProviderRepository builds a WebhookProviderRepository backed by db.
+func NewWebhookProviderRepository(db *gorm.DB) *WebhookProviderRepository {
+	return &WebhookProviderRepository{db: db}
+}
+
+// Create registers a new webhook provider.
+func (r *WebhookProviderRepository) Create(provider *models.WebhookProvider) error {
+	return r.db.Create(provider).Error
+}
+
+// FindByName looks up a webhook provider by name, scoped to tenantID.
+func (r *WebhookProviderRepository) FindByName(tenantID, name string) (*models.WebhookProvider, error) {
+	var provider models.WebhookProvider
+	if err := r.db.Where("tenant_id = ? AND name = ?", tenantID, name).First(&provider).Error; err != nil {
+		return nil, err
+	}
+	return &provider, nil
+}
@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// WithdrawalCodeRepository provides tenant-scoped persistence for
+// WithdrawalCode records.
+type WithdrawalCodeRepository struct {
+	db *gorm.DB
+}
+
+// NewWithdrawalCodeRepository builds a WithdrawalCodeRepository backed by db.
+func NewWithdrawalCodeRepository(db *gorm.DB) *WithdrawalCodeRepository {
+	return &WithdrawalCodeRepository{db: db}
+}
+
+// Create persists a new withdrawal code.
+func (r *WithdrawalCodeRepository) Create(code *models.WithdrawalCode) error {
+	return r.db.Create(code).Error
+}
+
+// FindByCode looks up a withdrawal code, scoped to tenantID.
+func (r *WithdrawalCodeRepository) FindByCode(tenantID, code string) (*models.WithdrawalCode, error) {
+	var wc models.WithdrawalCode
+	if err := r.db.Where("tenant_id = ? AND code = ?", tenantID, code).First(&wc).Error; err != nil {
+		return nil, err
+	}
+	return &wc, nil
+}
+
+// MarkStatus moves a withdrawal code from pending to status, scoped to
+// tenantID. The update is guarded by status = pending, so rowsAffected
+// doubles as a compare-and-swap: it comes back 0 if the code was already
+// redeemed or canceled by a concurrent call, instead of transitioning it
+// twice.
+func (r *WithdrawalCodeRepository) MarkStatus(tenantID, id string, status models.WithdrawalCodeStatus) (rowsAffected int64, err error) {
+	tx := r.db.Model(&models.WithdrawalCode{}).
+		Where("tenant_id = ? AND id = ? AND status = ?", tenantID, id, models.WithdrawalCodePending).
+		Update("status", status)
+	return tx.RowsAffected, tx.Error
+}
+
+// WithTx returns a copy of the repository bound to dbtx.
+func (r *WithdrawalCodeRepository) WithTx(dbtx *gorm.DB) *WithdrawalCodeRepository {
+	return &WithdrawalCodeRepository{db: dbtx}
+}
@@ -0,0 +1,709 @@
+// Package routes wires controllers, middleware and the gin engine together.
+package routes
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/mongo"
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/config"
+	"github.com/divzzrk/go_bank_api/controllers"
+	"github.com/divzzrk/go_bank_api/middleware"
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+	"github.com/divzzrk/go_bank_api/services"
+)
+
+// Setup builds the gin engine with every route group registered.
+func Setup(cfg *config.Config, db *gorm.DB, mongodb *mongo.Database, events services.EventPublisher) *gin.Engine {
+	router := gin.Default()
+
+	// Fault injection is only ever armable outside production; wrapping
+	// events here means every publish TransactionService and
+	// LowBalanceAlertService make already goes through it.
+	chaosService := services.NewChaosService(!cfg.IsProduction())
+	chaosController := controllers.NewChaosController(chaosService)
+	events = services.NewChaosEventPublisher(events, chaosService)
+
+	// Wrapping events here too means every publish goes through the
+	// operator-controlled pause gate before it goes through chaos, so a
+	// paused queue's events land in the dead letter table the same way a
+	// broker outage would, ready to replay (see EventReplayService) once
+	// resumed.
+	queuePauseRepo := repository.NewQueuePauseRepository(db)
+	queuePauseService := services.NewQueuePauseService(queuePauseRepo)
+	queuePauseController := controllers.NewQueuePauseController(queuePauseService)
+	events = services.NewPausableEventPublisher(events, queuePauseService)
+
+	requestTimeout, err := time.ParseDuration(cfg.RequestTimeout)
+	if err != nil {
+		log.Printf("invalid request timeout %q, defaulting to 30s: %v", cfg.RequestTimeout, err)
+		requestTimeout = 30 * time.Second
+	}
+	router.Use(middleware.RequestTimeout(requestTimeout))
+
+	apiTokenRepo := repository.NewAPITokenRepository(db)
+	router.Use(middleware.APITokenAuth(apiTokenRepo))
+
+	// Phone encryption is opt-in: without a configured key, phone numbers
+	// are stored and read back as plain text exactly as before.
+	var phoneCodec models.PhoneCodec
+	if cfg.PIIEncryptionKey != "" {
+		phoneCodec = services.NewPIIEncryptionService(services.NewLocalKMSProvider([]byte(cfg.PIIEncryptionKey)))
+	}
+
+	tenantRepo := repository.NewTenantRepository(db)
+	userRepo := repository.NewUserRepository(db, phoneCodec, []byte(cfg.PhoneHashKey))
+	accountRepo := repository.NewAccountRepository(db)
+	branchRepo := repository.NewBranchRepository(db)
+	txLogRepo := repository.NewTransactionLogRepository(mongodb)
+	tellerSessionRepo := repository.NewTellerSessionRepository(db)
+	fraudReviewRepo := repository.NewFraudReviewRepository(db)
+	fraudEngine := services.NewFraudEngine(repository.NewTransactionRepository(db), services.NoopGeoProvider{})
+	trustedDeviceRepo := repository.NewTrustedDeviceRepository(db)
+	deviceChallengeRepo := repository.NewDeviceChallengeRepository(db)
+
+	auditLogRepo := repository.NewAuditLogRepository(db, mongodb.Collection("audit_logs"))
+	auditLogController := controllers.NewAuditLogController(auditLogRepo)
+	deadLetterRepo := repository.NewDeadLetterRepository(db)
+	processedTransactionRepo := repository.NewProcessedTransactionRepository(db)
+	limitRepo := repository.NewLimitRepository(db)
+	accountFreezeRepo := repository.NewAccountFreezeRepository(db)
+	eventMetrics := services.NewEventMetrics()
+	publicStatusService := services.NewPublicStatusService(eventMetrics)
+	statusController := controllers.NewStatusController(publicStatusService)
+	router.GET("/status", middleware.RateLimitPerMinute(int(cfg.StatusRateLimitPerMinute)), statusController.Get)
+	errorCatalogController := controllers.NewErrorCatalogController()
+	router.GET("/errors", errorCatalogController.List)
+	feeScheduleRepo := repository.NewFeeScheduleRepository(db)
+	feeService := services.NewFeeService(feeScheduleRepo)
+	largeTransactionAlertRepo := repository.NewLargeTransactionAlertRepository(db)
+	largeTransactionAlertService := services.NewLargeTransactionAlertService(largeTransactionAlertRepo, accountRepo, services.NoopNotificationProvider{})
+	accountWebhookSubscriptionRepo := repository.NewAccountWebhookSubscriptionRepository(db)
+	accountWebhookService := services.NewAccountWebhookService(accountWebhookSubscriptionRepo, services.NewHTTPWebhookDispatcher())
+	glAccountRepo := repository.NewGLAccountRepository(db)
+	glEntryRepo := repository.NewGLEntryRepository(db)
+	glService := services.NewGLService(db, glAccountRepo, glEntryRepo)
+	glAccountController := controllers.NewGLAccountController(glService)
+	glCodeMappingRepo := repository.NewGLCodeMappingRepository(db)
+	glCodeMappingService := services.NewGLCodeMappingService(glCodeMappingRepo)
+	glCodeMappingController := controllers.NewGLCodeMappingController(glCodeMappingService)
+	journalExportService := services.NewJournalExportService(glAccountRepo, glEntryRepo, glCodeMappingService)
+	journalExportController := controllers.NewJournalExportController(journalExportService)
+	holidayRepo := repository.NewHolidayRepository(db)
+	calendarService := services.NewCalendarService(holidayRepo)
+	holidayController := controllers.NewHolidayController(holidayRepo)
+	cutoffScheduleRepo := repository.NewCutoffScheduleRepository(db)
+	cutoffService := services.NewCutoffService(cutoffScheduleRepo, calendarService)
+	cutoffScheduleController := controllers.NewCutoffScheduleController(cutoffService)
+	logEnrichmentService := services.NewLogEnrichmentService(txLogRepo, services.NewMerchantCategoryEnricher(services.NoopMerchantCategoryProvider{}), services.NewGeoEnricher(services.NoopGeoProvider{}))
+	descriptionTemplateRepo := repository.NewDescriptionTemplateRepository(db)
+	descriptionTemplateService := services.NewDescriptionTemplateService(descriptionTemplateRepo)
+	descriptionTemplateController := controllers.NewDescriptionTemplateController(descriptionTemplateService)
+	pendingApprovalRepo := repository.NewPendingApprovalRepository(db)
+	exposureLimitRepo := repository.NewExposureLimitRepository(db)
+	exposureService := services.NewExposureService(accountRepo, pendingApprovalRepo, exposureLimitRepo)
+	exposureLimitController := controllers.NewExposureLimitController(exposureService)
+	txService := services.NewTransactionService(db, txLogRepo, fraudEngine, fraudReviewRepo, auditLogRepo, events, deadLetterRepo, services.NoopAlertProvider{}, processedTransactionRepo, limitRepo, accountFreezeRepo, eventMetrics, feeService, largeTransactionAlertService, accountWebhookService, glService, cutoffService, logEnrichmentService, descriptionTemplateService, chaosService, exposureService)
+	dailySummarySubscriptionRepo := repository.NewDailySummarySubscriptionRepository(db)
+	dailySummaryService := services.NewDailySummaryService(dailySummarySubscriptionRepo, accountRepo, txLogRepo, services.NoopNotificationProvider{})
+	monthlyAccountSummaryRepo := repository.NewMonthlyAccountSummaryRepository(mongodb)
+	monthlySummaryService := services.NewMonthlySummaryService(accountRepo, txLogRepo, monthlyAccountSummaryRepo)
+	deviceService := services.NewDeviceService(trustedDeviceRepo, deviceChallengeRepo, services.NoopOTPProvider{})
+	pinResetRequestRepo := repository.NewPINResetRequestRepository(db)
+	pinService := services.NewPINService(pinResetRequestRepo, userRepo, accountRepo, accountFreezeRepo, services.NoopOTPProvider{})
+	pinController := controllers.NewPINController(pinService, userRepo)
+	accountRecoveryRequestRepo := repository.NewAccountRecoveryRequestRepository(db)
+	accountRecoveryService := services.NewAccountRecoveryService(accountRecoveryRequestRepo, userRepo, auditLogRepo, services.NoopOTPProvider{})
+	accountRecoveryController := controllers.NewAccountRecoveryController(accountRecoveryRequestRepo, accountRecoveryService)
+	tellerService := services.NewTellerService(txService, branchRepo, tellerSessionRepo)
+	withdrawalCodeService := services.NewWithdrawalCodeService(db, txService)
+	chequeDepositRepo := repository.NewChequeDepositRepository(db)
+	chequeDepositService := services.NewChequeDepositService(chequeDepositRepo, txService)
+	topupRepo := repository.NewTopUpRepository(db)
+	topupService := services.NewTopUpService(txService, topupRepo, services.NoopTopupProvider{})
+	transactionRepo := repository.NewTransactionRepository(db)
+	receiptService := services.NewReceiptService(transactionRepo, accountRepo, []byte(cfg.ReceiptSigningKey))
+	statementEmailRequestRepo := repository.NewStatementEmailRequestRepository(db)
+	statementService := services.NewStatementService(transactionRepo, accountRepo, services.NoopEventPublisher{}, statementEmailRequestRepo, services.NoopNotificationProvider{}, cfg.PublicBaseURL)
+	bulkStatementJobRepo := repository.NewBulkStatementJobRepository(db)
+	bulkStatementJobService := services.NewBulkStatementJobService(bulkStatementJobRepo, accountRepo, statementService, services.NoopObjectStorageProvider{}, services.NewHTTPWebhookDispatcher())
+	bulkStatementJobController := controllers.NewBulkStatementJobController(bulkStatementJobService)
+	productRepo := repository.NewProductRepository(db)
+	accountService := services.NewAccountService(accountRepo, productRepo, userRepo)
+	referralRepo := repository.NewReferralRepository(db)
+	referralService := services.NewReferralService(userRepo, accountRepo, referralRepo, txService)
+	blocklistRepo := repository.NewBlocklistRepository(db)
+	complianceHoldRepo := repository.NewComplianceHoldRepository(db)
+	screeningService := services.NewScreeningService(blocklistRepo, accountRepo, userRepo, services.NoopScreeningProvider{})
+	complianceService := services.NewComplianceService(complianceHoldRepo, screeningService, txService)
+	approvalService := services.NewApprovalService(pendingApprovalRepo, txService, complianceService, services.NoopNotificationProvider{})
+	rewardRuleRepo := repository.NewRewardRuleRepository(db)
+	pointsAccountRepo := repository.NewPointsAccountRepository(db)
+	pointsLedgerRepo := repository.NewPointsLedgerRepository(db)
+	rewardsService := services.NewRewardsService(rewardRuleRepo, pointsAccountRepo, pointsLedgerRepo, accountRepo, txService, db)
+	contactService := services.NewContactService(userRepo)
+
+	tenantController := controllers.NewTenantController(tenantRepo)
+	userController := controllers.NewUserController(userRepo, referralService)
+	adminUserService := services.NewAdminUserService(userRepo, accountRepo, fraudReviewRepo, pinService)
+	adminUserController := controllers.NewAdminUserController(adminUserService)
+	accountController := controllers.NewAccountController(accountRepo, accountService)
+	branchController := controllers.NewBranchController(branchRepo, accountRepo)
+	deviceKeyRepo := repository.NewDeviceKeyRepository(db)
+	deviceKeyService := services.NewDeviceKeyService(deviceKeyRepo)
+	deviceKeyController := controllers.NewDeviceKeyController(deviceKeyService)
+	stepUpChallengeRepo := repository.NewStepUpChallengeRepository(db)
+	stepUpChallengeService := services.NewStepUpChallengeService(stepUpChallengeRepo, txService, services.NoopOTPProvider{})
+	transactionController := controllers.NewTransactionController(txService, approvalService, deviceService, deviceKeyService, userRepo, accountRepo, referralService, rewardsService, stepUpChallengeService)
+	deviceController := controllers.NewDeviceController(deviceService)
+	tellerController := controllers.NewTellerController(tellerService)
+	withdrawalCodeController := controllers.NewWithdrawalCodeController(withdrawalCodeService)
+	chequeDepositController := controllers.NewChequeDepositController(chequeDepositRepo, chequeDepositService)
+	topupController := controllers.NewTopUpController(topupService)
+	receiptController := controllers.NewReceiptController(receiptService)
+	statementController := controllers.NewStatementController(statementService)
+	productController := controllers.NewProductController(productRepo)
+	fraudReviewController := controllers.NewFraudReviewController(fraudReviewRepo)
+	blocklistController := controllers.NewBlocklistController(blocklistRepo)
+	complianceHoldController := controllers.NewComplianceHoldController(complianceHoldRepo, complianceService)
+	approvalController := controllers.NewApprovalController(pendingApprovalRepo, approvalService)
+	adjustmentService := services.NewAdjustmentService(txService)
+	adjustmentController := controllers.NewAdjustmentController(adjustmentService)
+	interestAccrualBreakdownRepo := repository.NewInterestAccrualBreakdownRepository(db)
+	interestService := services.NewInterestService(accountRepo, productRepo, txService, calendarService, interestAccrualBreakdownRepo)
+	interestController := controllers.NewInterestController(interestService, interestAccrualBreakdownRepo)
+	limitService := services.NewLimitService(limitRepo, auditLogRepo)
+	limitController := controllers.NewLimitController(limitService)
+	feeScheduleController := controllers.NewFeeScheduleController(feeService)
+	rewardRuleController := controllers.NewRewardRuleController(rewardRuleRepo)
+	rewardsController := controllers.NewRewardsController(rewardsService)
+	deadLetterController := controllers.NewDeadLetterController(deadLetterRepo)
+	reconciliationRepo := repository.NewReconciliationRepository(db)
+	balanceMismatchRepo := repository.NewBalanceMismatchRepository(db)
+	reconciliationService := services.NewReconciliationService(reconciliationRepo, transactionRepo, balanceMismatchRepo, services.NoopAlertProvider{})
+	balanceMismatchController := controllers.NewBalanceMismatchController(balanceMismatchRepo)
+	reconciliationReportService := services.NewReconciliationReportService(accountRepo, transactionRepo, feeService)
+	reconciliationReportController := controllers.NewReconciliationReportController(reconciliationReportService)
+	impersonationSessionRepo := repository.NewImpersonationSessionRepository(db)
+	impersonationService := services.NewImpersonationService(impersonationSessionRepo, userRepo, auditLogRepo)
+	impersonationController := controllers.NewImpersonationController(impersonationService)
+	supportController := controllers.NewSupportController(accountRepo, transactionRepo, statementService, impersonationService)
+	contactController := controllers.NewContactController(contactService)
+	transactionLogController := controllers.NewTransactionLogController(txLogRepo, accountRepo)
+	eventReplayService := services.NewEventReplayService(txLogRepo, events, accountWebhookService)
+	eventReplayController := controllers.NewEventReplayController(eventReplayService)
+	apiTokenService := services.NewAPITokenService(apiTokenRepo)
+	apiTokenController := controllers.NewAPITokenController(apiTokenRepo, apiTokenService)
+	phoneLoginRequestRepo := repository.NewPhoneLoginRequestRepository(db)
+	phoneLoginService := services.NewPhoneLoginService(phoneLoginRequestRepo, userRepo, apiTokenService, services.NoopOTPProvider{})
+	phoneLoginController := controllers.NewPhoneLoginController(phoneLoginService)
+	webAuthnCredentialRepo := repository.NewWebAuthnCredentialRepository(db)
+	webAuthnChallengeRepo := repository.NewWebAuthnChallengeRepository(db)
+	webAuthnService := services.NewWebAuthnService(webAuthnCredentialRepo, webAuthnChallengeRepo, userRepo, apiTokenService)
+	webAuthnController := controllers.NewWebAuthnController(webAuthnService)
+	authService := services.NewAuthService(userRepo, cfg.JWTSigningKey)
+	authController := controllers.NewAuthController(authService)
+	bulkTransferBatchRepo := repository.NewBulkTransferBatchRepository(db)
+	bulkTransferItemRepo := repository.NewBulkTransferItemRepository(db)
+	bulkTransferService := services.NewBulkTransferService(accountRepo, bulkTransferBatchRepo, bulkTransferItemRepo, txService)
+	bulkTransferController := controllers.NewBulkTransferController(bulkTransferService)
+	payrollTemplateRepo := repository.NewPayrollTemplateRepository(db)
+	payrollTemplateLineRepo := repository.NewPayrollTemplateLineRepository(db)
+	payrollService := services.NewPayrollService(payrollTemplateRepo, payrollTemplateLineRepo, accountRepo, bulkTransferBatchRepo, bulkTransferService, services.NoopNotificationProvider{})
+	payrollController := controllers.NewPayrollController(payrollService)
+	merchantRepo := repository.NewMerchantRepository(db)
+	settlementBatchRepo := repository.NewSettlementBatchRepository(db)
+	settlementService := services.NewSettlementService(merchantRepo, transactionRepo, settlementBatchRepo, txService, glService)
+	merchantController := controllers.NewMerchantController(merchantRepo, settlementService)
+	externalTransferRepo := repository.NewExternalTransferRepository(db)
+	clearingFileRepo := repository.NewClearingFileRepository(db)
+	clearingService := services.NewClearingService(externalTransferRepo, clearingFileRepo, txService)
+	clearingController := controllers.NewClearingController(clearingService)
+	fxRateRepo := repository.NewFXRateRepository(db)
+	fxRateService := services.NewFXRateService(fxRateRepo)
+	fxRateController := controllers.NewFXRateController(fxRateService)
+	transferQuoteRepo := repository.NewTransferQuoteRepository(db)
+	transferQuoteService := services.NewTransferQuoteService(accountRepo, fxRateService, feeService, transferQuoteRepo, clearingService)
+	transferQuoteController := controllers.NewTransferQuoteController(transferQuoteService)
+	accountProjectionService := services.NewAccountProjectionService(accountRepo, pendingApprovalRepo, complianceHoldRepo)
+	accountProjectionController := controllers.NewAccountProjectionController(accountProjectionService)
+	lowBalanceAlertRepo := repository.NewLowBalanceAlertRepository(db)
+	lowBalanceAlertService := services.NewLowBalanceAlertService(lowBalanceAlertRepo, accountRepo, services.NoopNotificationProvider{}, events)
+	lowBalanceAlertController := controllers.NewLowBalanceAlertController(lowBalanceAlertService)
+	largeTransactionAlertController := controllers.NewLargeTransactionAlertController(largeTransactionAlertService)
+	accountWebhookSubscriptionController := controllers.NewAccountWebhookSubscriptionController(accountWebhookService)
+	dailySummaryController := controllers.NewDailySummaryController(dailySummaryService)
+	monthlySummaryController := controllers.NewMonthlySummaryController(monthlySummaryService)
+	cardSwitchService := services.NewCardSwitchService(db, txService)
+	cardSwitchController := controllers.NewCardSwitchController(cardSwitchService)
+	holdAgingMetrics := services.NewHoldAgingMetrics()
+	holdExpiryService := services.NewHoldExpiryService(db, repository.NewCardAuthorizationRepository(db), accountRepo, services.NoopNotificationProvider{}, holdAgingMetrics)
+	consentRepo := repository.NewConsentRepository(db)
+	consentService := services.NewConsentService(consentRepo, apiTokenService)
+	consentController := controllers.NewConsentController(consentService)
+	openBankingController := controllers.NewOpenBankingController(accountRepo, transactionRepo, consentService)
+
+	paymentConsentRepo := repository.NewPaymentConsentRepository(db)
+	paymentInitiationService := services.NewPaymentInitiationService(paymentConsentRepo, txService)
+	paymentInitiationController := controllers.NewPaymentInitiationController(paymentInitiationService)
+
+	webhookProviderRepo := repository.NewWebhookProviderRepository(db)
+	webhookEventRepo := repository.NewWebhookEventRepository(db)
+	externalAccountLinkRepo := repository.NewExternalAccountLinkRepository(db)
+	suspenseItemRepo := repository.NewSuspenseItemRepository(db)
+	suspenseService := services.NewSuspenseService(suspenseItemRepo, txService, glService)
+	suspenseItemController := controllers.NewSuspenseItemController(suspenseService)
+	webhookService := services.NewWebhookService(webhookProviderRepo, webhookEventRepo, externalAccountLinkRepo, txService, suspenseService)
+	webhookController := controllers.NewWebhookController(webhookService)
+	ipAllowlistRepo := repository.NewIPAllowlistRepository(db)
+	ipAllowlistController := controllers.NewIPAllowlistController(ipAllowlistRepo)
+	securityEventRepo := repository.NewSecurityEventRepository(db)
+	securityEventController := controllers.NewSecurityEventController(securityEventRepo)
+	sessionRepo := repository.NewSessionRepository(db)
+	sessionService := services.NewSessionService(sessionRepo)
+	sessionController := controllers.NewSessionController(sessionService)
+	eventMetricsController := controllers.NewEventMetricsController(eventMetrics, queuePauseService, holdAgingMetrics)
+
+	startReconciliationLoop(cfg.ReconciliationInterval, reconciliationService)
+	startPayrollScheduler(cfg.PayrollRunInterval, payrollService)
+	startSettlementScheduler(cfg.SettlementRunInterval, settlementService)
+	startDailySummaryScheduler(cfg.DailySummaryRunInterval, dailySummaryService)
+	startMonthlySummaryScheduler(cfg.MonthlySummaryRunInterval, monthlySummaryService)
+	startHoldExpirySweeper(cfg.HoldExpiryInterval, holdExpiryService)
+
+	// Platform-admin routes are not tenant-scoped: they manage tenants
+	// themselves.
+	admin := router.Group("/admin")
+	admin.Use(middleware.RequireScope(models.ScopeAdminAll))
+	admin.Use(middleware.MaxBodyBytes(cfg.MaxBulkRequestBodyBytes))
+	{
+		admin.POST("/tenants", tenantController.Create)
+		admin.GET("/dlq", deadLetterController.List)
+		admin.GET("/balance-mismatches", balanceMismatchController.List)
+		admin.GET("/reconciliation/report", reconciliationReportController.Export)
+		admin.GET("/transactions/export", transactionLogController.Export)
+		admin.GET("/security", securityEventController.List)
+		admin.GET("/audit", auditLogController.List)
+		admin.GET("/metrics", eventMetricsController.Get)
+		admin.POST("/events/replay", eventReplayController.Replay)
+		admin.GET("/queues/pauses", queuePauseController.List)
+		admin.PUT("/queues/:queue/pause", queuePauseController.Set)
+		admin.POST("/clearing-files", clearingController.GenerateFile)
+		admin.POST("/clearing-files/ack", clearingController.Acknowledge)
+		admin.GET("/chaos", chaosController.List)
+		admin.POST("/chaos/:fault", chaosController.Arm)
+	}
+
+	// Every other route requires a resolved tenant and must scope all data
+	// access to it.
+	api := router.Group("/")
+	api.Use(middleware.TenantResolver(tenantRepo))
+	api.Use(middleware.IPAllowlist(ipAllowlistRepo, securityEventRepo))
+	api.Use(middleware.SessionTracker(sessionService))
+	api.Use(middleware.MaxBodyBytes(cfg.MaxRequestBodyBytes))
+	api.Use(middleware.JWTAuth(authService, userRepo))
+	{
+		api.POST("/users", userController.Create)
+		api.GET("/users/:id", userController.Get)
+		api.GET("/users", userController.List)
+		api.GET("/users/:id/sessions", sessionController.List)
+		api.DELETE("/users/:id/sessions/:session_id", sessionController.Revoke)
+
+		api.GET("/users/:id/large-transaction-alert", largeTransactionAlertController.Get)
+		api.PUT("/users/:id/large-transaction-alert", largeTransactionAlertController.Put)
+		api.GET("/users/:id/activity-feed", largeTransactionAlertController.ActivityFeed)
+
+		api.GET("/users/:id/daily-summary-subscription", dailySummaryController.Get)
+		api.PUT("/users/:id/daily-summary-subscription", dailySummaryController.Put)
+
+		api.POST("/accounts", accountController.Create)
+		api.GET("/accounts/:id", middleware.RequireScope(models.ScopeBalanceRead), accountController.Get)
+
+		api.POST("/branches", branchController.Create)
+		api.GET("/branches", branchController.List)
+
+		api.GET("/accounts/:id/projection", middleware.RequireScope(models.ScopeBalanceRead), accountProjectionController.Get)
+
+		api.GET("/accounts/:id/low-balance-alert", lowBalanceAlertController.Get)
+		api.PUT("/accounts/:id/low-balance-alert", lowBalanceAlertController.Put)
+
+		api.POST("/accounts/:id/webhooks", accountWebhookSubscriptionController.Create)
+		api.GET("/accounts/:id/webhooks", accountWebhookSubscriptionController.List)
+
+		api.POST("/transactions", middleware.RateLimitTransactions(int(cfg.TransactionRateLimitPerMinute)), middleware.RequireScope(models.ScopeTransactionCreate, models.ScopeDepositCreate), transactionController.Create)
+		api.POST("/transaction/preview", transactionController.Preview)
+		api.POST("/transaction/confirm", transactionController.Confirm)
+		api.POST("/transfers/bulk", middleware.RequireScope(models.ScopeTransactionCreate), bulkTransferController.Create)
+
+		api.POST("/atm/withdrawal-codes", withdrawalCodeController.Generate)
+		api.POST("/atm/withdrawal-codes/redeem", withdrawalCodeController.Redeem)
+
+		api.POST("/cheque-deposits", chequeDepositController.Create)
+		api.GET("/accounts/:id/cheque-deposits", middleware.RequireScope(models.ScopeHistoryRead), chequeDepositController.ListByAccount)
+
+		api.POST("/topup", topupController.Create)
+
+		api.GET("/transaction/:id/receipt", middleware.RequireScope(models.ScopeHistoryRead), receiptController.Get)
+		api.GET("/transaction/:id/search", middleware.RequireScope(models.ScopeHistoryRead), transactionLogController.Search)
+		api.GET("/transaction/:id", middleware.RequireScope(models.ScopeHistoryRead), transactionLogController.History)
+		api.GET("/accounts/:id/statement", middleware.RequireScope(models.ScopeHistoryRead), statementController.Get)
+		api.POST("/accounts/:id/statement/email", statementController.Email)
+		api.GET("/accounts/:id/monthly-summary", middleware.RequireScope(models.ScopeHistoryRead), monthlySummaryController.Get)
+		api.GET("/accounts/:id/monthly-summaries", middleware.RequireScope(models.ScopeHistoryRead), monthlySummaryController.List)
+
+		api.GET("/products", productController.List)
+
+		api.GET("/points/balance", rewardsController.Balance)
+		api.POST("/points/redeem", rewardsController.Redeem)
+
+		api.GET("/devices", deviceController.List)
+		api.DELETE("/devices/:id", deviceController.Revoke)
+		api.PUT("/devices/:id/push-token", deviceController.RegisterPushToken)
+		api.POST("/devices/challenges/:id/verify", deviceController.VerifyChallenge)
+		api.POST("/devices/keys", deviceKeyController.Register)
+
+		api.POST("/contacts/match", contactController.Match)
+
+		api.POST("/pin/reset", pinController.RequestReset)
+		api.POST("/pin/reset/:id/verify", pinController.VerifyReset)
+
+		api.POST("/account-recovery", accountRecoveryController.Submit)
+		api.POST("/account-recovery/:id/verify", accountRecoveryController.VerifyStepUp)
+
+		api.POST("/auth/phone/login", phoneLoginController.Request)
+		api.POST("/auth/phone/login/:id/verify", phoneLoginController.Verify)
+		api.POST("/auth/webauthn/register", webAuthnController.BeginRegistration)
+		api.POST("/auth/webauthn/register/:id/verify", webAuthnController.FinishRegistration)
+		api.POST("/auth/webauthn/login", webAuthnController.BeginAssertion)
+		api.POST("/auth/webauthn/login/:id/verify", webAuthnController.FinishAssertion)
+		api.POST("/auth/login", authController.Login)
+
+		api.POST("/payroll/templates", payrollController.Create)
+		api.GET("/payroll/templates/:id/history", payrollController.History)
+
+		api.POST("/transfers/external", middleware.RequireScope(models.ScopeTransactionCreate), clearingController.Create)
+
+		api.POST("/transfers/quote", middleware.RequireScope(models.ScopeTransactionCreate), transferQuoteController.Quote)
+		api.POST("/transfers/quote/:id/execute", middleware.RequireScope(models.ScopeTransactionCreate), transferQuoteController.Execute)
+
+		api.POST("/card-switch/messages", middleware.RequireScope(models.ScopeTransactionCreate), cardSwitchController.HandleMessage)
+
+		api.POST("/consents", consentController.Grant)
+		api.POST("/consents/:id/revoke", consentController.Revoke)
+
+		api.POST("/payment-consents/:id/confirm", paymentInitiationController.Confirm)
+		api.POST("/payment-consents/:id/reject", paymentInitiationController.Reject)
+	}
+
+	// Open Banking routes are a read-only account-information surface for
+	// third parties acting under an explicit customer Consent; they must
+	// always authenticate with the scoped APIToken minted for that
+	// consent, never the interim X-User-ID header.
+	openBanking := router.Group("/open-banking")
+	openBanking.Use(middleware.TenantResolver(tenantRepo))
+	openBanking.Use(middleware.RequireAPIToken())
+	openBanking.Use(middleware.RequireScope(models.ScopeBalanceRead))
+	openBanking.Use(middleware.MaxBodyBytes(cfg.MaxRequestBodyBytes))
+	{
+		openBanking.GET("/accounts", openBankingController.Accounts)
+		openBanking.GET("/accounts/:id/balance", openBankingController.Balance)
+		openBanking.GET("/accounts/:id/transactions", middleware.RequireScope(models.ScopeHistoryRead), openBankingController.Transactions)
+	}
+
+	// Payment initiation lets a third party request a payment (PSD2's PIS
+	// role) without needing account-read access, so it's scoped
+	// separately from the account-information group above; the transfer
+	// itself is only enqueued once the customer confirms it via the
+	// self-service payment-consents routes.
+	paymentInitiation := router.Group("/open-banking")
+	paymentInitiation.Use(middleware.TenantResolver(tenantRepo))
+	paymentInitiation.Use(middleware.RequireAPIToken())
+	paymentInitiation.Use(middleware.RequireScope(models.ScopeTransactionCreate))
+	paymentInitiation.Use(middleware.MaxBodyBytes(cfg.MaxRequestBodyBytes))
+	{
+		paymentInitiation.POST("/payment-consents", paymentInitiationController.Initiate)
+	}
+
+	// Webhook routes receive inbound deliveries from external
+	// account-linking aggregators and payment processors. Authentication
+	// is the per-provider HMAC signature WebhookService verifies, not a
+	// user credential, so the only middleware needed is tenant resolution.
+	webhooks := router.Group("/webhooks")
+	webhooks.Use(middleware.TenantResolver(tenantRepo))
+	webhooks.Use(middleware.MaxBodyBytes(cfg.MaxBulkRequestBodyBytes))
+	{
+		webhooks.POST("/:provider", webhookController.Receive)
+	}
+
+	// Back-office routes are tenant-admin-only operational actions, such as
+	// clearing callbacks from the cheque processing partner.
+	backoffice := router.Group("/backoffice")
+	backoffice.Use(middleware.TenantResolver(tenantRepo))
+	backoffice.Use(middleware.RequireRole(userRepo, models.RoleAdmin))
+	backoffice.Use(middleware.RequireScope(models.ScopeAdminAll))
+	backoffice.Use(middleware.MaxBodyBytes(cfg.MaxBulkRequestBodyBytes))
+	{
+		backoffice.POST("/cheque-deposits/:id/clear", chequeDepositController.Clear)
+		backoffice.POST("/cheque-deposits/:id/bounce", chequeDepositController.Bounce)
+
+		backoffice.GET("/fraud-reviews", fraudReviewController.List)
+		backoffice.POST("/fraud-reviews/:id/resolve", fraudReviewController.Resolve)
+
+		backoffice.GET("/account-recovery", accountRecoveryController.List)
+		backoffice.POST("/account-recovery/:id/approve", accountRecoveryController.Approve)
+		backoffice.POST("/account-recovery/:id/deny", accountRecoveryController.Deny)
+
+		backoffice.POST("/blocklist", blocklistController.Create)
+		backoffice.GET("/blocklist", blocklistController.List)
+		backoffice.DELETE("/blocklist/:id", blocklistController.Delete)
+
+		backoffice.GET("/compliance-holds", complianceHoldController.List)
+		backoffice.POST("/compliance-holds/:id/release", complianceHoldController.Release)
+		backoffice.POST("/compliance-holds/:id/deny", complianceHoldController.Deny)
+
+		backoffice.GET("/approvals", approvalController.List)
+		backoffice.POST("/approvals/:id/approve", approvalController.Approve)
+		backoffice.POST("/approvals/:id/reject", approvalController.Reject)
+
+		backoffice.POST("/transactions/batch", transactionController.CreateBatch)
+
+		backoffice.POST("/accounts/:id/adjust", adjustmentController.Adjust)
+		backoffice.POST("/accounts/:id/accrue-interest", interestController.Accrue)
+		backoffice.GET("/transactions/:transactionId/interest-breakdown", interestController.Breakdown)
+
+		backoffice.GET("/accounts/:id/limits", limitController.Get)
+		backoffice.PUT("/accounts/:id/limits", limitController.Put)
+
+		backoffice.GET("/fee-schedules/:type", feeScheduleController.Get)
+		backoffice.PUT("/fee-schedules/:type", feeScheduleController.Put)
+
+		backoffice.GET("/fx-rates/:base/:quote", fxRateController.Get)
+		backoffice.PUT("/fx-rates/:base/:quote", fxRateController.Put)
+
+		backoffice.GET("/description-templates/:reason_code/:locale", descriptionTemplateController.Get)
+		backoffice.PUT("/description-templates/:reason_code/:locale", descriptionTemplateController.Put)
+
+		backoffice.GET("/cutoff-schedules/:type", cutoffScheduleController.Get)
+		backoffice.PUT("/cutoff-schedules/:type", cutoffScheduleController.Put)
+
+		backoffice.GET("/exposure-limits/:kycLevel", exposureLimitController.Get)
+		backoffice.PUT("/exposure-limits/:kycLevel", exposureLimitController.Put)
+
+		backoffice.POST("/holidays", holidayController.Create)
+		backoffice.GET("/holidays", holidayController.List)
+		backoffice.DELETE("/holidays/:id", holidayController.Delete)
+
+		backoffice.GET("/gl-accounts", glAccountController.List)
+		backoffice.GET("/gl-code-mappings/:type", glCodeMappingController.Get)
+		backoffice.PUT("/gl-code-mappings/:type", glCodeMappingController.Put)
+		backoffice.GET("/journal-export", journalExportController.Export)
+
+		backoffice.GET("/suspense-items", suspenseItemController.List)
+		backoffice.POST("/suspense-items/:id/match", suspenseItemController.Match)
+		backoffice.POST("/suspense-items/:id/return", suspenseItemController.Return)
+
+		backoffice.POST("/products", productController.Create)
+		backoffice.POST("/products/:id/revise", productController.Revise)
+
+		backoffice.GET("/users", adminUserController.List)
+		backoffice.POST("/users/encrypt-phones", userController.EncryptPhones)
+		backoffice.POST("/users/:id/lock", adminUserController.Lock)
+		backoffice.POST("/users/:id/unlock", adminUserController.Unlock)
+		backoffice.PUT("/users/:id/tier", adminUserController.SetTier)
+		backoffice.POST("/users/:id/force-pin-reset", adminUserController.ForcePINReset)
+		backoffice.GET("/users/:id/accounts", adminUserController.Accounts)
+
+		backoffice.POST("/reward-rules", rewardRuleController.Create)
+		backoffice.GET("/reward-rules", rewardRuleController.List)
+		backoffice.POST("/reward-rules/:id/deactivate", rewardRuleController.Deactivate)
+
+		backoffice.POST("/merchants", merchantController.Create)
+		backoffice.GET("/merchants/:id/settlements", merchantController.SettlementHistory)
+
+		backoffice.POST("/impersonation-sessions", impersonationController.Start)
+		backoffice.POST("/impersonation-sessions/:id/end", impersonationController.End)
+
+		backoffice.POST("/api-tokens", apiTokenController.Create)
+		backoffice.GET("/api-tokens", apiTokenController.List)
+		backoffice.DELETE("/api-tokens/:id", apiTokenController.Revoke)
+
+		backoffice.POST("/ip-allowlist", ipAllowlistController.Create)
+		backoffice.GET("/ip-allowlist", ipAllowlistController.List)
+		backoffice.DELETE("/ip-allowlist/:id", ipAllowlistController.Delete)
+
+		backoffice.POST("/bulk-statements", bulkStatementJobController.Create)
+		backoffice.GET("/bulk-statements/:id", bulkStatementJobController.Get)
+	}
+
+	// Support routes let an admin who has opened an ImpersonationSession
+	// view (never move money for) a single customer's data, with every
+	// read stamped into the audit log under both identities.
+	support := router.Group("/support")
+	support.Use(middleware.TenantResolver(tenantRepo))
+	support.Use(middleware.RequireRole(userRepo, models.RoleAdmin))
+	support.Use(middleware.ImpersonationRequired(impersonationService))
+	support.Use(middleware.MaxBodyBytes(cfg.MaxRequestBodyBytes))
+	{
+		support.GET("/accounts/:id", supportController.ViewAccount)
+		support.GET("/accounts/:id/transactions", supportController.ViewTransactions)
+		support.GET("/accounts/:id/statement", supportController.ViewStatement)
+	}
+
+	// Agent routes let branch staff act on behalf of customers; every
+	// resulting account or transaction is attributed to the agent's branch.
+	agent := router.Group("/agent")
+	agent.Use(middleware.TenantResolver(tenantRepo))
+	agent.Use(middleware.RequireRole(userRepo, models.RoleAgent))
+	{
+		agent.POST("/accounts", accountController.Create)
+		agent.POST("/transactions", transactionController.Create)
+	}
+
+	// Teller routes are agent-only cash-drawer operations, reconciled
+	// against a branch's internal cash account.
+	teller := router.Group("/teller")
+	teller.Use(middleware.TenantResolver(tenantRepo))
+	teller.Use(middleware.RequireRole(userRepo, models.RoleAgent))
+	{
+		teller.POST("/cash-in", tellerController.CashIn)
+		teller.POST("/cash-out", tellerController.CashOut)
+		teller.POST("/session/open", tellerController.OpenSession)
+		teller.POST("/session/close", tellerController.CloseSession)
+	}
+
+	return router
+}
+
+// startReconciliationLoop runs the balance reconciliation checker on a
+// ticker for as long as the process is alive. A malformed interval falls
+// back to a sane default rather than disabling the checker entirely.
+func startReconciliationLoop(interval string, reconciliation *services.ReconciliationService) {
+	d, err := time.ParseDuration(interval)
+	if err != nil {
+		log.Printf("invalid reconciliation interval %q, defaulting to 5m: %v", interval, err)
+		d = 5 * time.Minute
+	}
+
+	go func() {
+		ticker := time.NewTicker(d)
+		defer ticker.Stop()
+		for range ticker.C {
+			found, err := reconciliation.Run()
+			if err != nil {
+				log.Printf("reconciliation run failed: %v", err)
+				continue
+			}
+			if found > 0 {
+				log.Printf("reconciliation run found %d balance mismatch(es)", found)
+			}
+		}
+	}()
+}
+
+// startPayrollScheduler runs the payroll scheduler on a ticker for as long
+// as the process is alive. A malformed interval falls back to a sane
+// default rather than disabling the scheduler entirely.
+func startPayrollScheduler(interval string, payroll *services.PayrollService) {
+	d, err := time.ParseDuration(interval)
+	if err != nil {
+		log.Printf("invalid payroll run interval %q, defaulting to 1h: %v", interval, err)
+		d = time.Hour
+	}
+
+	go func() {
+		ticker := time.NewTicker(d)
+		defer ticker.Stop()
+		for range ticker.C {
+			payroll.RunDue(context.Background(), time.Now())
+		}
+	}()
+}
+
+// startSettlementScheduler runs the merchant settlement job on a ticker
+// for as long as the process is alive. A malformed interval falls back to
+// a sane default rather than disabling the job entirely.
+func startSettlementScheduler(interval string, settlements *services.SettlementService) {
+	d, err := time.ParseDuration(interval)
+	if err != nil {
+		log.Printf("invalid settlement run interval %q, defaulting to 24h: %v", interval, err)
+		d = 24 * time.Hour
+	}
+
+	go func() {
+		ticker := time.NewTicker(d)
+		defer ticker.Stop()
+		for range ticker.C {
+			settlements.RunNightly(context.Background())
+		}
+	}()
+}
+
+// startDailySummaryScheduler runs the daily spending summary job on a
+// ticker for as long as the process is alive. A malformed interval falls
+// back to a sane default rather than disabling the job entirely.
+func startDailySummaryScheduler(interval string, summaries *services.DailySummaryService) {
+	d, err := time.ParseDuration(interval)
+	if err != nil {
+		log.Printf("invalid daily summary run interval %q, defaulting to 24h: %v", interval, err)
+		d = 24 * time.Hour
+	}
+
+	go func() {
+		ticker := time.NewTicker(d)
+		defer ticker.Stop()
+		for range ticker.C {
+			summaries.RunForDay(context.Background(), time.Now())
+		}
+	}()
+}
+
+// startMonthlySummaryScheduler runs the monthly account summary job on a
+// ticker for as long as the process is alive. A malformed interval falls
+// back to a sane default rather than disabling the job entirely.
+func startMonthlySummaryScheduler(interval string, summaries *services.MonthlySummaryService) {
+	d, err := time.ParseDuration(interval)
+	if err != nil {
+		log.Printf("invalid monthly summary run interval %q, defaulting to 24h: %v", interval, err)
+		d = 24 * time.Hour
+	}
+
+	go func() {
+		ticker := time.NewTicker(d)
+		defer ticker.Stop()
+		for range ticker.C {
+			summaries.RunForMonth(context.Background(), time.Now())
+		}
+	}()
+}
+
+// startHoldExpirySweeper runs the card authorization hold expiry sweeper
+// on a ticker for as long as the process is alive. A malformed interval
+// falls back to a sane default rather than disabling the sweeper
+// entirely.
+func startHoldExpirySweeper(interval string, holds *services.HoldExpiryService) {
+	d, err := time.ParseDuration(interval)
+	if err != nil {
+		log.Printf("invalid hold expiry interval %q, defaulting to 15m: %v", interval, err)
+		d = 15 * time.Minute
+	}
+
+	go func() {
+		ticker := time.NewTicker(d)
+		defer ticker.Stop()
+		for range ticker.C {
+			released, err := holds.Sweep()
+			if err != nil {
+				log.Printf("hold expiry sweep failed: %v", err)
+				continue
+			}
+			if released > 0 {
+				log.Printf("hold expiry sweep released %d stale hold(s)", released)
+			}
+		}
+	}()
+}
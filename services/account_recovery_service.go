@@ -0,0 +1,165 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// ErrRecoveryRequestNotPending is returned when a review action targets a
+// recovery request that isn't awaiting review.
+var ErrRecoveryRequestNotPending = errors.New("recovery request is not pending review")
+
+// ErrRecoveryStepUpNotVerifiable is returned when a step-up code is
+// submitted against a request that hasn't had a code sent, has expired,
+// or does not exist.
+var ErrRecoveryStepUpNotVerifiable = errors.New("recovery step-up is not verifiable")
+
+// ErrRecoveryCodeMismatch is returned when the submitted step-up code
+// doesn't match the one sent to the new phone number.
+var ErrRecoveryCodeMismatch = errors.New("recovery step-up code does not match")
+
+// recoveryStepUpTTL is how long a step-up code sent to the new phone
+// number remains valid.
+const recoveryStepUpTTL = 10 * time.Minute
+
+// AccountRecoveryService re-binds a user's account to a new phone number:
+// the user submits identity evidence, an admin reviews it, and only after
+// approval is a step-up code sent to the new number to confirm the user
+// actually controls it before the rebind takes effect.
+type AccountRecoveryService struct {
+	requests  *repository.AccountRecoveryRequestRepository
+	users     *repository.UserRepository
+	auditLogs *repository.AuditLogRepository
+	otp       OTPProvider
+}
+
+// NewAccountRecoveryService builds an AccountRecoveryService.
+func NewAccountRecoveryService(requests *repository.AccountRecoveryRequestRepository, users *repository.UserRepository, auditLogs *repository.AuditLogRepository, otp OTPProvider) *AccountRecoveryService {
+	return &AccountRecoveryService{requests: requests, users: users, auditLogs: auditLogs, otp: otp}
+}
+
+// Submit opens a recovery request for userID, holding evidence for an
+// admin to review before anything about the account changes.
+func (s *AccountRecoveryService) Submit(tenantID, userID, newPhone, evidence string) (*models.AccountRecoveryRequest, error) {
+	request := &models.AccountRecoveryRequest{
+		ID:       newID(),
+		TenantID: tenantID,
+		UserID:   userID,
+		NewPhone: newPhone,
+		Evidence: evidence,
+		Status:   models.AccountRecoveryPending,
+	}
+	if err := s.requests.Create(request); err != nil {
+		return nil, err
+	}
+
+	_ = s.auditLogs.Create(&models.AuditLog{
+		ID:          newID(),
+		TenantID:    tenantID,
+		ActorUserID: userID,
+		Action:      "account_recovery_requested",
+		EntityType:  "account_recovery_request",
+		EntityID:    request.ID,
+		Description: "user submitted evidence to rebind their account to a new phone number",
+	})
+
+	return request, nil
+}
+
+// Approve accepts a pending request's evidence and sends a step-up code to
+// the new phone number; the rebind only takes effect once VerifyStepUp
+// confirms the user controls that number.
+func (s *AccountRecoveryService) Approve(tenantID, id, reviewerUserID, reviewNote string) error {
+	request, err := s.requests.FindByID(tenantID, id)
+	if err != nil {
+		return err
+	}
+	if request.Status != models.AccountRecoveryPending {
+		return ErrRecoveryRequestNotPending
+	}
+
+	code := generateNumericCode()
+	if err := s.requests.Approve(tenantID, id, reviewerUserID, reviewNote, code, time.Now().Add(recoveryStepUpTTL)); err != nil {
+		return err
+	}
+	if err := s.otp.Send(request.NewPhone, code); err != nil {
+		return err
+	}
+
+	_ = s.auditLogs.Create(&models.AuditLog{
+		ID:          newID(),
+		TenantID:    tenantID,
+		ActorUserID: reviewerUserID,
+		Action:      "account_recovery_approved",
+		EntityType:  "account_recovery_request",
+		EntityID:    id,
+		Description: reviewNote,
+	})
+
+	return nil
+}
+
+// Deny rejects a pending request's evidence; the account is left bound to
+// its current phone number.
+func (s *AccountRecoveryService) Deny(tenantID, id, reviewerUserID, reviewNote string) error {
+	request, err := s.requests.FindByID(tenantID, id)
+	if err != nil {
+		return err
+	}
+	if request.Status != models.AccountRecoveryPending {
+		return ErrRecoveryRequestNotPending
+	}
+
+	if err := s.requests.Deny(tenantID, id, reviewerUserID, reviewNote); err != nil {
+		return err
+	}
+
+	_ = s.auditLogs.Create(&models.AuditLog{
+		ID:          newID(),
+		TenantID:    tenantID,
+		ActorUserID: reviewerUserID,
+		Action:      "account_recovery_denied",
+		EntityType:  "account_recovery_request",
+		EntityID:    id,
+		Description: reviewNote,
+	})
+
+	return nil
+}
+
+// VerifyStepUp checks code against the one sent to the request's new phone
+// number and, on success, rebinds the user's account to it.
+func (s *AccountRecoveryService) VerifyStepUp(tenantID, id, code string) error {
+	request, err := s.requests.FindByID(tenantID, id)
+	if err != nil {
+		return ErrRecoveryStepUpNotVerifiable
+	}
+	if request.Status != models.AccountRecoveryStepUpSent || time.Now().After(request.CodeExpiresAt) {
+		return ErrRecoveryStepUpNotVerifiable
+	}
+	if request.Code != code {
+		return ErrRecoveryCodeMismatch
+	}
+
+	if err := s.users.UpdatePhone(tenantID, request.UserID, request.NewPhone); err != nil {
+		return err
+	}
+	if err := s.requests.MarkVerified(tenantID, id); err != nil {
+		return err
+	}
+
+	_ = s.auditLogs.Create(&models.AuditLog{
+		ID:          newID(),
+		TenantID:    tenantID,
+		ActorUserID: request.UserID,
+		Action:      "account_recovery_completed",
+		EntityType:  "account_recovery_request",
+		EntityID:    id,
+		Description: "account rebound to new phone number after step-up verification",
+	})
+
+	return nil
+}
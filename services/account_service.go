@@ -0,0 +1,75 @@
+package services
+
+import (
+	"errors"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// ErrProductNotActive is returned when an account is opened against a
+// product version that has been superseded and is no longer offered.
+var ErrProductNotActive = errors.New("product is not open to new accounts")
+
+// ErrProductNotEligible is returned when the opening user doesn't meet a
+// product's minimum tier or KYC level.
+var ErrProductNotEligible = errors.New("user does not meet the product's eligibility requirements")
+
+// ErrUnsupportedCurrency is returned when an account is opened with a
+// currency code that isn't in the supported-currency registry.
+var ErrUnsupportedCurrency = errors.New("unsupported currency")
+
+// AccountService opens accounts, enforcing product eligibility rules when
+// the account is opened against a specific product.
+type AccountService struct {
+	accounts *repository.AccountRepository
+	products *repository.ProductRepository
+	users    *repository.UserRepository
+}
+
+// NewAccountService builds an AccountService.
+func NewAccountService(accounts *repository.AccountRepository, products *repository.ProductRepository, users *repository.UserRepository) *AccountService {
+	return &AccountService{accounts: accounts, products: products, users: users}
+}
+
+// Open creates a new account for userID. If productID is set, the account
+// is opened against that exact product version: the version must still be
+// active and the user must meet its minimum tier and KYC level.
+func (s *AccountService) Open(tenantID, userID, accountNumber, currency, productID string) (*models.Account, error) {
+	if !models.ValidCurrency(currency) {
+		return nil, ErrUnsupportedCurrency
+	}
+
+	account := &models.Account{
+		ID:            newID(),
+		TenantID:      tenantID,
+		UserID:        userID,
+		AccountNumber: accountNumber,
+		Currency:      currency,
+	}
+
+	if productID != "" {
+		product, err := s.products.FindByID(tenantID, productID)
+		if err != nil {
+			return nil, err
+		}
+		if !product.Active {
+			return nil, ErrProductNotActive
+		}
+
+		user, err := s.users.FindByID(tenantID, userID)
+		if err != nil {
+			return nil, err
+		}
+		if !user.MeetsTier(product.MinimumTier) || user.KYCLevel < product.MinimumKYCLevel {
+			return nil, ErrProductNotEligible
+		}
+
+		account.ProductID = product.ID
+	}
+
+	if err := s.accounts.Create(account); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
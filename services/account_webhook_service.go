@@ -0,0 +1,109 @@
+package services
+
+import (
+	"encoding/json"
+	"log"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// AccountWebhookEventPayload is the JSON body delivered to a subscriber
+// for a single account event.
+type AccountWebhookEventPayload struct {
+	AccountID     string                         `json:"account_id"`
+	TransactionID string                         `json:"transaction_id,omitempty"`
+	EventType     models.AccountWebhookEventType `json:"event_type"`
+	Amount        int64                          `json:"amount"`
+}
+
+// AccountWebhookService lets an account owner, typically a merchant,
+// register a URL to be notified of activity on one of their accounts,
+// e.g. only when money arrives, and evaluates every posted transaction
+// against each account's active subscriptions, delivering to the ones
+// whose event-type filter and amount threshold match.
+type AccountWebhookService struct {
+	subscriptions *repository.AccountWebhookSubscriptionRepository
+	dispatcher    WebhookDispatcher
+}
+
+// NewAccountWebhookService builds an AccountWebhookService.
+func NewAccountWebhookService(subscriptions *repository.AccountWebhookSubscriptionRepository, dispatcher WebhookDispatcher) *AccountWebhookService {
+	return &AccountWebhookService{subscriptions: subscriptions, dispatcher: dispatcher}
+}
+
+// Subscribe registers a new, active webhook subscription for accountID.
+func (s *AccountWebhookService) Subscribe(sub *models.AccountWebhookSubscription) error {
+	sub.ID = newID()
+	sub.Active = true
+	return s.subscriptions.Create(sub)
+}
+
+// ListForAccount returns accountID's webhook subscriptions.
+func (s *AccountWebhookService) ListForAccount(tenantID, accountID string) ([]models.AccountWebhookSubscription, error) {
+	return s.subscriptions.ListByAccount(tenantID, accountID)
+}
+
+// OnPosted evaluates a just-posted transaction against the debited
+// account's and, for a transfer, the credited counterparty account's
+// active webhook subscriptions. Called best-effort after a transaction
+// posts; a delivery failure must never undo the transaction it followed.
+func (s *AccountWebhookService) OnPosted(tx *models.Transaction) {
+	switch tx.Type {
+	case models.TransactionTypeDeposit, models.TransactionTypeAdjustmentCredit:
+		s.evaluate(tx.TenantID, tx.AccountID, tx.ID, tx.Amount, models.AccountWebhookEventCredit)
+	case models.TransactionTypeWithdrawal, models.TransactionTypeAdjustmentDebit:
+		s.evaluate(tx.TenantID, tx.AccountID, tx.ID, tx.Amount, models.AccountWebhookEventDebit)
+	case models.TransactionTypeTransfer:
+		s.evaluate(tx.TenantID, tx.AccountID, tx.ID, tx.Amount, models.AccountWebhookEventDebit)
+		if tx.CounterpartyAccountID != "" {
+			s.evaluate(tx.TenantID, tx.CounterpartyAccountID, tx.ID, tx.Amount, models.AccountWebhookEventCredit)
+		}
+	}
+}
+
+// OnFailed evaluates a rejected transaction attempt against accountID's
+// active webhook subscriptions filtered to the "failed" event type. Post
+// calls this from its error path, where no Transaction row is ever
+// created.
+func (s *AccountWebhookService) OnFailed(tenantID, accountID string, amount int64) {
+	s.evaluate(tenantID, accountID, "", amount, models.AccountWebhookEventFailed)
+}
+
+// Replay re-evaluates accountID's active webhook subscriptions for an
+// event that already happened, e.g. by EventReplayService reconstructing
+// it from the transaction log. It's the same delivery path OnPosted and
+// OnFailed use, exposed directly since a replay has no Transaction row
+// to build one from.
+func (s *AccountWebhookService) Replay(tenantID, accountID, transactionID string, amount int64, eventType models.AccountWebhookEventType) {
+	s.evaluate(tenantID, accountID, transactionID, amount, eventType)
+}
+
+func (s *AccountWebhookService) evaluate(tenantID, accountID, transactionID string, amount int64, eventType models.AccountWebhookEventType) {
+	subs, err := s.subscriptions.ListActiveByAccount(tenantID, accountID)
+	if err != nil {
+		log.Printf("failed to load webhook subscriptions for account %s: %v", accountID, err)
+		return
+	}
+
+	for _, sub := range subs {
+		if !sub.MatchesEventType(eventType) || amount < sub.MinAmount {
+			continue
+		}
+
+		payload, err := json.Marshal(AccountWebhookEventPayload{
+			AccountID:     accountID,
+			TransactionID: transactionID,
+			EventType:     eventType,
+			Amount:        amount,
+		})
+		if err != nil {
+			log.Printf("failed to marshal webhook payload for subscription %s: %v", sub.ID, err)
+			continue
+		}
+
+		if err := s.dispatcher.Deliver(sub.URL, sub.Secret, payload); err != nil {
+			log.Printf("webhook delivery to %s failed for subscription %s: %v", sub.URL, sub.ID, err)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// ErrJustificationRequired is returned when a manual balance adjustment is
+// submitted without a reason code or free-text justification.
+var ErrJustificationRequired = errors.New("reason code and justification are required")
+
+// ErrZeroAdjustment is returned when a manual balance adjustment's amount
+// is zero, since it wouldn't move the ledger at all.
+var ErrZeroAdjustment = errors.New("adjustment amount must not be zero")
+
+// AdjustmentService lets an admin correct an account's balance by posting a
+// signed transaction rather than mutating the balance column directly, so
+// the correction is fully reflected in the ledger, audit log, and Mongo
+// transaction history.
+type AdjustmentService struct {
+	transactions *TransactionService
+}
+
+// NewAdjustmentService builds an AdjustmentService.
+func NewAdjustmentService(transactions *TransactionService) *AdjustmentService {
+	return &AdjustmentService{transactions: transactions}
+}
+
+// Adjust posts a signed adjustment against accountID: a positive amount
+// credits the account, a negative amount debits it. reasonCode and
+// justification are both required and are recorded on the resulting
+// transaction and audit log entry.
+func (s *AdjustmentService) Adjust(ctx context.Context, tenantID, accountID, adminUserID string, amount int64, reasonCode, justification string) (*models.Transaction, error) {
+	if reasonCode == "" || justification == "" {
+		return nil, ErrJustificationRequired
+	}
+	if amount == 0 {
+		return nil, ErrZeroAdjustment
+	}
+
+	txType := models.TransactionTypeAdjustmentCredit
+	if amount < 0 {
+		txType = models.TransactionTypeAdjustmentDebit
+		amount = -amount
+	}
+
+	return s.transactions.Post(ctx, TransactionInput{
+		TenantID:          tenantID,
+		AccountID:         accountID,
+		Type:              txType,
+		Amount:            amount,
+		PerformedByUserID: adminUserID,
+		ReasonCode:        reasonCode,
+		Justification:     justification,
+	})
+}
@@ -0,0 +1,75 @@
+package services
+
+import (
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// AdminUserService backs the tenant-admin user-management endpoints:
+// locking a user out, forcing a PIN reset, changing tier, and inspecting
+// a user's accounts and fraud flags.
+type AdminUserService struct {
+	users        *repository.UserRepository
+	accounts     *repository.AccountRepository
+	fraudReviews *repository.FraudReviewRepository
+	pins         *PINService
+}
+
+// NewAdminUserService builds an AdminUserService.
+func NewAdminUserService(users *repository.UserRepository, accounts *repository.AccountRepository, fraudReviews *repository.FraudReviewRepository, pins *PINService) *AdminUserService {
+	return &AdminUserService{users: users, accounts: accounts, fraudReviews: fraudReviews, pins: pins}
+}
+
+// List returns tenantID's users matching filter.
+func (s *AdminUserService) List(tenantID string, filter repository.UserFilter) ([]models.User, error) {
+	return s.users.ListFiltered(tenantID, filter)
+}
+
+// Lock prevents userID from authenticating until Unlock is called.
+func (s *AdminUserService) Lock(tenantID, userID string) error {
+	return s.users.UpdateLocked(tenantID, userID, true)
+}
+
+// Unlock reverses Lock.
+func (s *AdminUserService) Unlock(tenantID, userID string) error {
+	return s.users.UpdateLocked(tenantID, userID, false)
+}
+
+// SetTier changes userID's product-eligibility tier.
+func (s *AdminUserService) SetTier(tenantID, userID string, tier models.UserTier) error {
+	return s.users.UpdateTier(tenantID, userID, tier)
+}
+
+// ForcePINReset clears userID's PIN and freezes their accounts, so they
+// must set a new PIN before transacting again.
+func (s *AdminUserService) ForcePINReset(tenantID, userID string) error {
+	return s.pins.ForceReset(tenantID, userID)
+}
+
+// AccountsAndFlags is a user's accounts together with any fraud review
+// flags raised against them, for an admin investigating a user.
+type AccountsAndFlags struct {
+	Accounts []models.Account     `json:"accounts"`
+	Flags    []models.FraudReview `json:"flags"`
+}
+
+// AccountsAndFlags returns userID's accounts and every fraud review
+// raised against any of them.
+func (s *AdminUserService) AccountsAndFlags(tenantID, userID string) (*AccountsAndFlags, error) {
+	accounts, err := s.accounts.ListByUser(tenantID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	accountIDs := make([]string, len(accounts))
+	for i, account := range accounts {
+		accountIDs[i] = account.ID
+	}
+
+	flags, err := s.fraudReviews.ListByAccountIDs(tenantID, accountIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AccountsAndFlags{Accounts: accounts, Flags: flags}, nil
+}
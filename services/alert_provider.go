@@ -0,0 +1,22 @@
+package services
+
+import "log"
+
+// AlertProvider delivers an operational alert to whatever on-call/paging
+// system is configured, so operators learn about systemic failures without
+// having to poll for them.
+type AlertProvider interface {
+	// Alert delivers an operational alert with the given subject and
+	// message.
+	Alert(subject, message string) error
+}
+
+// NoopAlertProvider is a placeholder provider used until a real paging
+// integration is configured. It logs the alert instead of delivering it.
+type NoopAlertProvider struct{}
+
+// Alert implements AlertProvider.
+func (NoopAlertProvider) Alert(subject, message string) error {
+	log.Printf("alert %q (noop provider, not actually delivered): %s", subject, message)
+	return nil
+}
@@ -0,0 +1,335 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// ErrPublishNotConfirmed is returned by AMQPEventPublisher.Publish when the
+// broker negatively acknowledges a message instead of confirming it.
+var ErrPublishNotConfirmed = errors.New("amqp: publish not confirmed by broker")
+
+// ErrMessageNotRouted is returned by AMQPEventPublisher.Publish when the
+// broker returns a mandatory message instead of routing it, which means
+// the transaction events queue's binding is missing or misconfigured.
+var ErrMessageNotRouted = errors.New("amqp: message not routed to any queue")
+
+// transactionEventsExchange is the topic exchange domain events are
+// published to, routed by event type (e.g. "transaction.deposit.completed",
+// "balance.changed"). Binding a queue to "#" still receives every event
+// the way a fanout exchange would, so the notification service, webhook
+// dispatcher, and analytics projections can keep consuming everything
+// independently, while a queue bound to a narrower pattern like
+// "transaction.deposit.#" only sees that transaction type.
+const transactionEventsExchange = "transaction_events"
+
+// delayExchange and delayQueueName implement scheduled delivery with the
+// TTL+dead-letter pattern rather than the rabbitmq_delayed_message_exchange
+// plugin, so a delay doesn't depend on a plugin being installed on the
+// broker. A delayed publish goes to delayExchange with its real routing
+// key; delayQueueName parks it there for the requested delay (via a
+// per-message TTL set in Publishing.Expiration) and, since it declares no
+// x-dead-letter-routing-key, RabbitMQ dead-letters the expired message back
+// into transactionEventsExchange under that same original routing key,
+// delivering to whichever queues would've received it immediately.
+//
+// Because delayQueueName is a single classic-ordered queue, a short delay
+// queued behind a much longer one won't be evicted until the one ahead of
+// it expires; that head-of-line blocking is a known limitation of this
+// pattern and is judged acceptable for the coarse retry/scheduling delays
+// this is used for.
+const (
+	delayExchange  = "transaction_events_delay"
+	delayQueueName = "transaction_events_delay_queue"
+)
+
+// publishConfirmTimeout bounds how long Publish waits for the broker to
+// confirm a message before giving up and reporting the publish as failed.
+const publishConfirmTimeout = 5 * time.Second
+
+// AMQPEventPublisher publishes domain events to a RabbitMQ topic exchange
+// with publisher confirms enabled, so Publish only succeeds once the
+// broker has actually accepted the message.
+type AMQPEventPublisher struct {
+	channel *amqp.Channel
+	kms     KMSProvider
+	returns chan amqp.Return
+
+	// mu serializes Publish calls. The amqp091-go channel isn't safe for
+	// concurrent publishes, and serializing also lets Publish read
+	// p.returns without racing to attribute a return to the wrong message.
+	mu sync.Mutex
+}
+
+// TransactionQueues names the per-transaction-type queues
+// NewAMQPEventPublisher declares, keyed by models.TransactionType. Each is
+// bound to the exchange with a "transaction.<type>.#" pattern, so its
+// consumer can run its own concurrency, retry policy, and priority
+// independent of the other types.
+type TransactionQueues map[models.TransactionType]string
+
+// NewAMQPEventPublisher declares the topic exchange, the catch-all events
+// queue, and a queue per entry in txQueues, binds each to the exchange,
+// puts the channel into confirm mode, and builds an AMQPEventPublisher
+// bound to it.
+//
+// eventsQueueName is bound to "#" and keeps receiving every event, the way
+// consumers did before per-type queues existed. txQueues adds a queue per
+// transaction type, bound to only that type's events.
+//
+// Every declared queue is a quorum queue when quorum is true, trading some
+// throughput for RabbitMQ's replicated, broker-side durability instead of
+// a classic queue.
+//
+// kms is optional: pass nil to publish event payloads as plain JSON, or a
+// KMSProvider to envelope-encrypt them so an account's identifiers and
+// amounts aren't readable by anyone with RabbitMQ management access to the
+// exchange, only by a consumer wired to the same KMSProvider.
+func NewAMQPEventPublisher(channel *amqp.Channel, kms KMSProvider, eventsQueueName string, txQueues TransactionQueues, quorum bool) (*AMQPEventPublisher, error) {
+	if err := channel.ExchangeDeclare(transactionEventsExchange, "topic", true, false, false, false, nil); err != nil {
+		return nil, err
+	}
+
+	var queueArgs amqp.Table
+	if quorum {
+		queueArgs = amqp.Table{"x-queue-type": "quorum"}
+	}
+
+	if _, err := channel.QueueDeclare(eventsQueueName, true, false, false, false, queueArgs); err != nil {
+		return nil, err
+	}
+	if err := channel.QueueBind(eventsQueueName, "#", transactionEventsExchange, false, nil); err != nil {
+		return nil, err
+	}
+
+	for txType, queueName := range txQueues {
+		if _, err := channel.QueueDeclare(queueName, true, false, false, false, queueArgs); err != nil {
+			return nil, err
+		}
+		bindingKey := fmt.Sprintf("transaction.%s.#", txType)
+		if err := channel.QueueBind(queueName, bindingKey, transactionEventsExchange, false, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := channel.ExchangeDeclare(delayExchange, "topic", true, false, false, false, nil); err != nil {
+		return nil, err
+	}
+	delayQueueArgs := amqp.Table{"x-dead-letter-exchange": transactionEventsExchange}
+	if quorum {
+		delayQueueArgs["x-queue-type"] = "quorum"
+	}
+	if _, err := channel.QueueDeclare(delayQueueName, true, false, false, false, delayQueueArgs); err != nil {
+		return nil, err
+	}
+	if err := channel.QueueBind(delayQueueName, "#", delayExchange, false, nil); err != nil {
+		return nil, err
+	}
+
+	if err := channel.Confirm(false); err != nil {
+		return nil, err
+	}
+
+	returns := channel.NotifyReturn(make(chan amqp.Return, 1))
+
+	return &AMQPEventPublisher{channel: channel, kms: kms, returns: returns}, nil
+}
+
+type eventEnvelope struct {
+	Type          string            `json:"type"`
+	CorrelationID string            `json:"correlation_id,omitempty"`
+	Payload       interface{}       `json:"payload,omitempty"`
+	Encrypted     *EncryptedPayload `json:"encrypted,omitempty"`
+}
+
+// EncryptedPayload is the wire format of an envelope-encrypted event
+// payload: a per-message AES-256-GCM data key wrapped by a KMSProvider,
+// the GCM nonce it was sealed with, and the resulting ciphertext. A
+// consumer with access to the same KMSProvider recovers the payload with
+// DecryptPayload.
+type EncryptedPayload struct {
+	WrappedKey string `json:"wrapped_key"`
+	Nonce      string `json:"nonce"`
+	Ciphertext string `json:"ciphertext"`
+}
+
+// Publish encodes eventType, correlationID, and payload as JSON and
+// publishes it to the topic exchange as a mandatory message routed by
+// eventType, waiting up to publishConfirmTimeout for the broker to either
+// confirm the message or return it as unroutable before reporting success.
+// When the publisher was built with a KMSProvider, payload is
+// envelope-encrypted first and carried in the Encrypted field instead of
+// Payload.
+func (p *AMQPEventPublisher) Publish(eventType, correlationID string, payload interface{}) error {
+	body, err := p.buildBody(eventType, correlationID, payload)
+	if err != nil {
+		return err
+	}
+	return p.publish(transactionEventsExchange, eventType, body, nil)
+}
+
+// PublishDelayed is Publish, except the event isn't routed to
+// transactionEventsExchange until delay has elapsed. It's meant for
+// scheduled transactions and retry backoffs that need to be handled
+// broker-side instead of by a process sleeping in memory.
+func (p *AMQPEventPublisher) PublishDelayed(eventType, correlationID string, payload interface{}, delay time.Duration) error {
+	body, err := p.buildBody(eventType, correlationID, payload)
+	if err != nil {
+		return err
+	}
+	expiration := fmt.Sprintf("%d", delay.Milliseconds())
+	return p.publish(delayExchange, eventType, body, &expiration)
+}
+
+// buildBody encodes eventType, correlationID, and payload as JSON,
+// envelope-encrypting payload first when the publisher was built with a
+// KMSProvider.
+func (p *AMQPEventPublisher) buildBody(eventType, correlationID string, payload interface{}) ([]byte, error) {
+	envelope := eventEnvelope{Type: eventType, CorrelationID: correlationID}
+
+	if p.kms != nil {
+		encrypted, err := encryptPayload(p.kms, payload)
+		if err != nil {
+			return nil, err
+		}
+		envelope.Encrypted = encrypted
+	} else {
+		envelope.Payload = payload
+	}
+
+	return json.Marshal(envelope)
+}
+
+// publish sends body to exchange as a mandatory message routed by
+// routingKey, waiting up to publishConfirmTimeout for the broker to either
+// confirm the message or return it as unroutable. expiration, when
+// non-nil, sets the message's per-message TTL in milliseconds.
+func (p *AMQPEventPublisher) publish(exchange, routingKey string, body []byte, expiration *string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), publishConfirmTimeout)
+	defer cancel()
+
+	msg := amqp.Publishing{
+		ContentType: "application/json",
+		Body:        body,
+	}
+	if expiration != nil {
+		msg.Expiration = *expiration
+	}
+
+	confirmation, err := p.channel.PublishWithDeferredConfirmWithContext(ctx, exchange, routingKey, true, false, msg)
+	if err != nil {
+		return err
+	}
+
+	ackCh := make(chan bool, 1)
+	waitErrCh := make(chan error, 1)
+	go func() {
+		acked, err := confirmation.WaitContext(ctx)
+		if err != nil {
+			waitErrCh <- err
+			return
+		}
+		ackCh <- acked
+	}()
+
+	select {
+	case <-p.returns:
+		return ErrMessageNotRouted
+	case err := <-waitErrCh:
+		return err
+	case acked := <-ackCh:
+		if !acked {
+			return ErrPublishNotConfirmed
+		}
+		return nil
+	}
+}
+
+// encryptPayload marshals payload to JSON, seals it with a fresh AES-256
+// data key under AES-GCM, and wraps that data key with kms so the sealed
+// payload can only be opened by something that can unwrap it back.
+func encryptPayload(kms KMSProvider, payload interface{}) (*EncryptedPayload, error) {
+	plaintext, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	dataKey := make([]byte, 32)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, err
+	}
+
+	gcm, err := newGCM(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	ciphertext := gcm.Seal(nil, nonce, plaintext, nil)
+
+	wrappedKey, err := kms.WrapKey(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EncryptedPayload{
+		WrappedKey: base64.StdEncoding.EncodeToString(wrappedKey),
+		Nonce:      base64.StdEncoding.EncodeToString(nonce),
+		Ciphertext: base64.StdEncoding.EncodeToString(ciphertext),
+	}, nil
+}
+
+// DecryptPayload reverses encryptPayload: it unwraps the data key with kms,
+// opens the AES-GCM seal, and unmarshals the result into out. It's the
+// counterpart a queue consumer calls to read an envelope-encrypted event
+// transparently.
+func DecryptPayload(kms KMSProvider, encrypted *EncryptedPayload, out interface{}) error {
+	wrappedKey, err := base64.StdEncoding.DecodeString(encrypted.WrappedKey)
+	if err != nil {
+		return err
+	}
+	nonce, err := base64.StdEncoding.DecodeString(encrypted.Nonce)
+	if err != nil {
+		return err
+	}
+	ciphertext, err := base64.StdEncoding.DecodeString(encrypted.Ciphertext)
+	if err != nil {
+		return err
+	}
+
+	dataKey, err := kms.UnwrapKey(wrappedKey)
+	if err != nil {
+		return err
+	}
+
+	gcm, err := newGCM(dataKey)
+	if err != nil {
+		return err
+	}
+	if len(nonce) != gcm.NonceSize() {
+		return ErrEnvelopeNonceSize
+	}
+
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(plaintext, out)
+}
@@ -0,0 +1,93 @@
+package services
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// apiTokenBytes is the size of the random token issued to callers, before
+// hex encoding.
+const apiTokenBytes = 32
+
+// ErrAPITokenNotFound is returned when a token to revoke doesn't exist
+// under the calling tenant.
+var ErrAPITokenNotFound = errors.New("api token not found")
+
+// ErrInvalidScope is returned when Issue is asked to grant a scope this
+// codebase doesn't know how to enforce.
+var ErrInvalidScope = errors.New("invalid scope")
+
+// APITokenService issues and revokes API tokens.
+type APITokenService struct {
+	tokens *repository.APITokenRepository
+}
+
+// NewAPITokenService builds an APITokenService.
+func NewAPITokenService(tokens *repository.APITokenRepository) *APITokenService {
+	return &APITokenService{tokens: tokens}
+}
+
+// Issue generates a new token for userID granting scopes, returning both
+// the stored record and the plaintext token, which is never recoverable
+// again once this call returns.
+func (s *APITokenService) Issue(tenantID, userID, name string, scopes []string) (*models.APIToken, string, error) {
+	for _, scope := range scopes {
+		if !models.ValidScope(scope) {
+			return nil, "", ErrInvalidScope
+		}
+	}
+
+	plaintext, err := generateAPIToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	token := &models.APIToken{
+		ID:        newID(),
+		TenantID:  tenantID,
+		UserID:    userID,
+		Name:      name,
+		TokenHash: hashAPIToken(plaintext),
+		Scope:     strings.Join(scopes, " "),
+	}
+	if err := s.tokens.Create(token); err != nil {
+		return nil, "", err
+	}
+	return token, plaintext, nil
+}
+
+// Revoke disables tokenID so it can no longer authenticate requests.
+func (s *APITokenService) Revoke(tenantID, tokenID string) error {
+	token, err := s.tokens.FindByID(tenantID, tokenID)
+	if err != nil {
+		return ErrAPITokenNotFound
+	}
+	if token.RevokedAt != nil {
+		return nil
+	}
+	now := time.Now()
+	token.RevokedAt = &now
+	return s.tokens.Revoke(token)
+}
+
+func generateAPIToken() (string, error) {
+	b := make([]byte, apiTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// hashAPIToken returns the SHA-256 hash of an API token's plaintext, the
+// only form ever persisted.
+func hashAPIToken(plaintext string) string {
+	sum := sha256.Sum256([]byte(plaintext))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,157 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// ErrApprovalNotPending is returned when an approve/reject action targets
+// a pending approval that has already been resolved.
+var ErrApprovalNotPending = errors.New("approval is not pending")
+
+// ErrSameMakerChecker is returned when the user approving a transaction is
+// the same user who initiated it.
+var ErrSameMakerChecker = errors.New("checker must be a different user than the maker")
+
+// ApprovalService enforces maker-checker dual control: transactions at or
+// above a tenant's ApprovalThreshold are held until a second user approves
+// them, instead of posting immediately.
+type ApprovalService struct {
+	approvals     *repository.PendingApprovalRepository
+	transactions  *TransactionService
+	compliance    *ComplianceService
+	notifications NotificationProvider
+}
+
+// NewApprovalService builds an ApprovalService.
+func NewApprovalService(approvals *repository.PendingApprovalRepository, transactions *TransactionService, compliance *ComplianceService, notifications NotificationProvider) *ApprovalService {
+	return &ApprovalService{approvals: approvals, transactions: transactions, compliance: compliance, notifications: notifications}
+}
+
+// Submit checks in.Amount against threshold. Below threshold (or threshold
+// disabled at 0) it posts immediately (transfers still pass through
+// compliance screening, which may return a hold instead of a
+// transaction). At or above the threshold it opens a pending approval
+// instead. At most one of tx, hold, and approval is non-nil.
+func (s *ApprovalService) Submit(ctx context.Context, in TransactionInput, threshold int64) (tx *models.Transaction, hold *models.ComplianceHold, approval *models.PendingApproval, err error) {
+	if threshold <= 0 || in.Amount < threshold {
+		tx, hold, err = s.post(ctx, in)
+		return
+	}
+
+	approval = &models.PendingApproval{
+		ID:                    newID(),
+		TenantID:              in.TenantID,
+		AccountID:             in.AccountID,
+		CounterpartyAccountID: in.CounterpartyAccountID,
+		Type:                  in.Type,
+		Amount:                in.Amount,
+		BranchID:              in.BranchID,
+		MakerUserID:           in.PerformedByUserID,
+		Status:                models.PendingApprovalPending,
+		// Recorded up front (rather than only once posted) so a caller who
+		// generated in.RequestID can already correlate this approval to the
+		// transaction it will eventually produce.
+		TransactionID: in.RequestID,
+	}
+	if err = s.approvals.Create(approval); err != nil {
+		approval = nil
+		return
+	}
+	return
+}
+
+// Approve posts the transaction a pending approval was holding. checkerUserID
+// must belong to a different user than the one who submitted it. If the
+// posted transaction is itself a transfer caught by compliance screening,
+// Approve returns the resulting hold instead of a transaction.
+func (s *ApprovalService) Approve(ctx context.Context, tenantID, id, checkerUserID string) (*models.Transaction, *models.ComplianceHold, error) {
+	approval, err := s.approvals.FindByID(tenantID, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if approval.Status != models.PendingApprovalPending {
+		return nil, nil, ErrApprovalNotPending
+	}
+	if approval.MakerUserID != "" && approval.MakerUserID == checkerUserID {
+		return nil, nil, ErrSameMakerChecker
+	}
+
+	// Claim the approval before posting, not after: two concurrent
+	// Approve calls can both read Pending above, and without a claim in
+	// between, both would call post and both post a transaction before
+	// either reached a Resolve call at the end. Resolve's WHERE status =
+	// pending guard means only one of them can win this update; the
+	// loser sees claimed == 0 and reports ErrApprovalNotPending instead
+	// of double-posting.
+	claimed, err := s.approvals.Resolve(tenantID, id, models.PendingApprovalApproved, checkerUserID, "")
+	if err != nil {
+		return nil, nil, err
+	}
+	if claimed == 0 {
+		return nil, nil, ErrApprovalNotPending
+	}
+
+	tx, hold, err := s.post(ctx, TransactionInput{
+		TenantID:              approval.TenantID,
+		AccountID:             approval.AccountID,
+		CounterpartyAccountID: approval.CounterpartyAccountID,
+		Type:                  approval.Type,
+		Amount:                approval.Amount,
+		BranchID:              approval.BranchID,
+		PerformedByUserID:     approval.MakerUserID,
+		RequestID:             approval.TransactionID,
+	})
+	if err != nil {
+		// The claim above already flipped this approval to approved; put
+		// it back to pending so it isn't stranded approved with nothing
+		// posted against it, and can be retried.
+		_ = s.approvals.Reopen(tenantID, id)
+		return nil, nil, err
+	}
+
+	if tx != nil {
+		if err := s.approvals.SetTransactionID(tenantID, id, tx.ID); err != nil {
+			return nil, nil, err
+		}
+	}
+	return tx, hold, nil
+}
+
+// Reject discards the transaction a pending approval was holding and
+// notifies the initiator.
+func (s *ApprovalService) Reject(tenantID, id, checkerUserID string) error {
+	approval, err := s.approvals.FindByID(tenantID, id)
+	if err != nil {
+		return err
+	}
+	if approval.Status != models.PendingApprovalPending {
+		return ErrApprovalNotPending
+	}
+
+	claimed, err := s.approvals.Resolve(tenantID, id, models.PendingApprovalRejected, checkerUserID, "")
+	if err != nil {
+		return err
+	}
+	if claimed == 0 {
+		return ErrApprovalNotPending
+	}
+
+	if s.notifications != nil && approval.MakerUserID != "" {
+		_ = s.notifications.Notify(approval.MakerUserID, "your pending transaction was rejected")
+	}
+	return nil
+}
+
+// post routes a transfer through compliance screening and everything else
+// straight to the transaction service.
+func (s *ApprovalService) post(ctx context.Context, in TransactionInput) (*models.Transaction, *models.ComplianceHold, error) {
+	if in.Type == models.TransactionTypeTransfer && s.compliance != nil {
+		return s.compliance.Submit(ctx, in)
+	}
+	tx, err := s.transactions.Post(ctx, in)
+	return tx, nil, err
+}
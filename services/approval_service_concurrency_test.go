@@ -0,0 +1,75 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// TestApprovalService_ConcurrentApprovesPostOnce fires concurrent Approve
+// calls against the same pending approval and asserts exactly one of
+// them posts a transaction. Before Resolve's guarded update, every
+// caller could read Status == Pending and call post before any of them
+// reached Resolve, so all of them would post.
+func TestApprovalService_ConcurrentApprovesPostOnce(t *testing.T) {
+	db := testDB(t)
+
+	const (
+		tenantID = "44444444-4444-4444-4444-444444444444"
+		amount   = int64(500)
+		checkers = 5
+	)
+
+	accounts := repository.NewAccountRepository(db)
+	account := &models.Account{ID: newID(), TenantID: tenantID, UserID: newID(), Currency: "USD", Balance: 0}
+	if err := accounts.Create(account); err != nil {
+		t.Fatalf("create account: %v", err)
+	}
+
+	approvals := repository.NewPendingApprovalRepository(db)
+	transactions := NewTransactionService(db, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	svc := NewApprovalService(approvals, transactions, nil, nil)
+
+	_, _, approval, err := svc.Submit(context.Background(), TransactionInput{
+		TenantID:  tenantID,
+		AccountID: account.ID,
+		Type:      models.TransactionTypeDeposit,
+		Amount:    amount,
+	}, 1)
+	if err != nil {
+		t.Fatalf("submit: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	posted := make([]bool, checkers)
+	for i := 0; i < checkers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			tx, _, err := svc.Approve(context.Background(), tenantID, approval.ID, newID())
+			posted[i] = err == nil && tx != nil
+		}(i)
+	}
+	wg.Wait()
+
+	successCount := 0
+	for _, ok := range posted {
+		if ok {
+			successCount++
+		}
+	}
+	if successCount != 1 {
+		t.Fatalf("got %d successful Approve calls, want exactly 1", successCount)
+	}
+
+	after, err := accounts.FindByID(tenantID, account.ID)
+	if err != nil {
+		t.Fatalf("find account: %v", err)
+	}
+	if after.Balance != amount {
+		t.Fatalf("account balance = %d, want %d (posted exactly once)", after.Balance, amount)
+	}
+}
@@ -0,0 +1,78 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// balanceChangesChannel is the Postgres NOTIFY channel that
+// database.EnsureBalanceChangeTrigger's trigger publishes to.
+const balanceChangesChannel = "balance_changes"
+
+// BalanceChangePayload is the JSON body of a balance_changes notification.
+type BalanceChangePayload struct {
+	TenantID  string `json:"tenant_id"`
+	AccountID string `json:"account_id"`
+	Balance   int64  `json:"balance"`
+}
+
+// BalanceChangeListener turns Postgres LISTEN/NOTIFY balance-change
+// notifications into balance.changed domain events, decoupling cache
+// invalidation and real-time streams from the request that actually
+// updated the balance. It's also the consumer that evaluates each
+// account's low-balance alert threshold, since it sees every balance
+// write regardless of which code path produced it.
+type BalanceChangeListener struct {
+	conn        *pgx.Conn
+	events      EventPublisher
+	lowBalances *LowBalanceAlertService
+}
+
+// NewBalanceChangeListener opens a dedicated Postgres connection (LISTEN
+// requires holding a session open for its lifetime, so it can't share the
+// pooled gorm connection) and starts listening on the balance_changes
+// channel. lowBalances may be nil to skip low-balance alerting.
+func NewBalanceChangeListener(ctx context.Context, dsn string, events EventPublisher, lowBalances *LowBalanceAlertService) (*BalanceChangeListener, error) {
+	conn, err := pgx.Connect(ctx, dsn)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := conn.Exec(ctx, "LISTEN "+balanceChangesChannel); err != nil {
+		conn.Close(ctx)
+		return nil, err
+	}
+	return &BalanceChangeListener{conn: conn, events: events, lowBalances: lowBalances}, nil
+}
+
+// Run blocks, publishing a balance.changed event for every notification
+// received, until ctx is canceled or the connection fails.
+func (l *BalanceChangeListener) Run(ctx context.Context) {
+	defer l.conn.Close(context.Background())
+
+	for {
+		notification, err := l.conn.WaitForNotification(ctx)
+		if err != nil {
+			log.Printf("balance-change listener stopped: %v", err)
+			return
+		}
+
+		var payload BalanceChangePayload
+		if err := json.Unmarshal([]byte(notification.Payload), &payload); err != nil {
+			log.Printf("balance-change listener received malformed payload: %v", err)
+			continue
+		}
+
+		if err := l.events.Publish("balance.changed", "", payload); err != nil {
+			log.Printf("balance.changed event publish failed for account %s: %v", payload.AccountID, err)
+		}
+
+		if l.lowBalances != nil {
+			if err := l.lowBalances.CheckBalance(payload.TenantID, payload.AccountID, payload.Balance); err != nil {
+				log.Printf("low-balance alert check failed for account %s: %v", payload.AccountID, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// BulkStatementJobCompletedPayload is the JSON body delivered to a job's
+// webhook once its archive is ready (or generation has failed).
+type BulkStatementJobCompletedPayload struct {
+	JobID      string                        `json:"job_id"`
+	Status     models.BulkStatementJobStatus `json:"status"`
+	ArchiveURL string                        `json:"archive_url,omitempty"`
+	Error      string                        `json:"error,omitempty"`
+}
+
+// BulkStatementJobService generates every account's statement for a period
+// into a single zip archive, for an auditor who needs one download rather
+// than one API call per account. Generation runs in the background;
+// Start returns as soon as the job row is created, and a caller polls Get
+// for progress or waits for the completion webhook.
+type BulkStatementJobService struct {
+	jobs       *repository.BulkStatementJobRepository
+	accounts   *repository.AccountRepository
+	statements *StatementService
+	storage    ObjectStorageProvider
+	webhooks   WebhookDispatcher
+}
+
+// NewBulkStatementJobService builds a BulkStatementJobService.
+func NewBulkStatementJobService(jobs *repository.BulkStatementJobRepository, accounts *repository.AccountRepository, statements *StatementService, storage ObjectStorageProvider, webhooks WebhookDispatcher) *BulkStatementJobService {
+	return &BulkStatementJobService{jobs: jobs, accounts: accounts, statements: statements, storage: storage, webhooks: webhooks}
+}
+
+// Start creates a pending job for [from, to] and kicks off generation in
+// the background. accountIDs, if non-empty, limits the run to those
+// accounts; otherwise every account under tenantID is included.
+func (s *BulkStatementJobService) Start(tenantID string, from, to time.Time, accountIDs []string, webhookURL, webhookSecret string) (*models.BulkStatementJob, error) {
+	job := &models.BulkStatementJob{
+		ID:            newID(),
+		TenantID:      tenantID,
+		From:          from,
+		To:            to,
+		AccountIDs:    strings.Join(accountIDs, " "),
+		WebhookURL:    webhookURL,
+		WebhookSecret: webhookSecret,
+		Status:        models.BulkStatementJobPending,
+	}
+	if err := s.jobs.Create(job); err != nil {
+		return nil, err
+	}
+
+	go s.run(job)
+
+	return job, nil
+}
+
+// Get returns a job's current state, for a caller polling for progress.
+func (s *BulkStatementJobService) Get(tenantID, id string) (*models.BulkStatementJob, error) {
+	return s.jobs.FindByID(tenantID, id)
+}
+
+// run builds the archive and records the outcome. It's called on its own
+// goroutine by Start, so any error here can only be surfaced through the
+// job row itself, never returned to a caller directly.
+func (s *BulkStatementJobService) run(job *models.BulkStatementJob) {
+	accountIDs, err := s.resolveAccountIDs(job)
+	if err != nil {
+		s.fail(job, err)
+		return
+	}
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	done := 0
+	for _, accountID := range accountIDs {
+		statement, err := s.statements.Generate(job.TenantID, accountID, job.From, job.To)
+		if err != nil {
+			s.fail(job, err)
+			return
+		}
+
+		data, err := json.Marshal(statement)
+		if err != nil {
+			s.fail(job, err)
+			return
+		}
+
+		w, err := archive.Create(accountID + ".json")
+		if err != nil {
+			s.fail(job, err)
+			return
+		}
+		if _, err := w.Write(data); err != nil {
+			s.fail(job, err)
+			return
+		}
+
+		done++
+		if err := s.jobs.UpdateProgress(job.TenantID, job.ID, done); err != nil {
+			log.Printf("bulk statement job %s: progress update failed: %v", job.ID, err)
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		s.fail(job, err)
+		return
+	}
+
+	archiveURL, err := s.storage.Store(fmt.Sprintf("bulk-statements/%s.zip", job.ID), buf.Bytes())
+	if err != nil {
+		s.fail(job, err)
+		return
+	}
+
+	s.complete(job, archiveURL)
+}
+
+// resolveAccountIDs returns the accounts a job should cover: the ones it
+// was started with, or every account under its tenant if it wasn't
+// scoped to a subset.
+func (s *BulkStatementJobService) resolveAccountIDs(job *models.BulkStatementJob) ([]string, error) {
+	if job.AccountIDs != "" {
+		return strings.Fields(job.AccountIDs), nil
+	}
+
+	accounts, err := s.accounts.ListByTenant(job.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, len(accounts))
+	for i, account := range accounts {
+		ids[i] = account.ID
+	}
+	return ids, nil
+}
+
+// complete records a job's success and, if the caller supplied one,
+// notifies its webhook.
+func (s *BulkStatementJobService) complete(job *models.BulkStatementJob, archiveURL string) {
+	if err := s.jobs.UpdateOutcome(job.TenantID, job.ID, models.BulkStatementJobCompleted, archiveURL, "", time.Now()); err != nil {
+		log.Printf("bulk statement job %s: outcome update failed: %v", job.ID, err)
+	}
+	s.notify(job, models.BulkStatementJobCompleted, archiveURL, "")
+}
+
+// fail records a job's failure and, if the caller supplied one, notifies
+// its webhook. Generation stops at the first error rather than skipping
+// the failed account, since a partial archive would be misleading to an
+// auditor expecting a complete record.
+func (s *BulkStatementJobService) fail(job *models.BulkStatementJob, err error) {
+	log.Printf("bulk statement job %s failed: %v", job.ID, err)
+	if updateErr := s.jobs.UpdateOutcome(job.TenantID, job.ID, models.BulkStatementJobFailed, "", err.Error(), time.Now()); updateErr != nil {
+		log.Printf("bulk statement job %s: outcome update failed: %v", job.ID, updateErr)
+	}
+	s.notify(job, models.BulkStatementJobFailed, "", err.Error())
+}
+
+// notify delivers job's completion webhook, if one was configured. A
+// delivery failure is logged and otherwise ignored: the job's own status
+// is the source of truth, and a caller not receiving the webhook can
+// still find that out by polling Get.
+func (s *BulkStatementJobService) notify(job *models.BulkStatementJob, status models.BulkStatementJobStatus, archiveURL, errMsg string) {
+	if job.WebhookURL == "" {
+		return
+	}
+
+	payload, err := json.Marshal(BulkStatementJobCompletedPayload{
+		JobID:      job.ID,
+		Status:     status,
+		ArchiveURL: archiveURL,
+		Error:      errMsg,
+	})
+	if err != nil {
+		log.Printf("bulk statement job %s: webhook payload marshal failed: %v", job.ID, err)
+		return
+	}
+
+	if err := s.webhooks.Deliver(job.WebhookURL, job.WebhookSecret, payload); err != nil {
+		log.Printf("bulk statement job %s: webhook delivery failed: %v", job.ID, err)
+	}
+}
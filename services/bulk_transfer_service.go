@@ -0,0 +1,127 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// ErrBulkTransferEmpty is returned when Submit is called with no lines.
+var ErrBulkTransferEmpty = errors.New("bulk transfer batch must contain at least one line")
+
+// ErrBulkTransferInsufficientFunds is returned when the debit account's
+// balance can't cover the sum of every line in the batch, before any line
+// is attempted.
+var ErrBulkTransferInsufficientFunds = errors.New("debit account balance cannot cover bulk transfer batch")
+
+// BulkTransferLine is a single credit-account/amount pair submitted to
+// BulkTransferService.Submit.
+type BulkTransferLine struct {
+	CreditAccountID string
+	Amount          int64
+}
+
+// BulkTransferService posts a payroll-style bulk transfer: one debit
+// account paying many credit accounts. The batch total is validated
+// against the debit account's balance up front, then each line is posted
+// individually through TransactionService.Post, so every line still gets
+// the interactive path's fraud, freeze, and limit checks. A per-line
+// failure doesn't abort the rest of the batch; it's recorded against that
+// line and the batch report comes back with a mix of posted and failed
+// lines.
+type BulkTransferService struct {
+	accounts     *repository.AccountRepository
+	batches      *repository.BulkTransferBatchRepository
+	items        *repository.BulkTransferItemRepository
+	transactions *TransactionService
+}
+
+// NewBulkTransferService builds a BulkTransferService.
+func NewBulkTransferService(accounts *repository.AccountRepository, batches *repository.BulkTransferBatchRepository, items *repository.BulkTransferItemRepository, transactions *TransactionService) *BulkTransferService {
+	return &BulkTransferService{accounts: accounts, batches: batches, items: items, transactions: transactions}
+}
+
+// Submit validates and posts a bulk transfer batch, returning the batch
+// summary and every line's outcome. payrollTemplateID links the batch
+// back to the PayrollTemplate whose scheduled run produced it; pass ""
+// for a batch submitted directly via the bulk transfer endpoint.
+func (s *BulkTransferService) Submit(ctx context.Context, tenantID, debitAccountID, correlationID, payrollTemplateID string, lines []BulkTransferLine) (*models.BulkTransferBatch, []models.BulkTransferItem, error) {
+	if len(lines) == 0 {
+		return nil, nil, ErrBulkTransferEmpty
+	}
+
+	var total int64
+	for _, line := range lines {
+		total += line.Amount
+	}
+
+	debitAccount, err := s.accounts.FindByID(tenantID, debitAccountID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if debitAccount.Available() < total {
+		return nil, nil, ErrBulkTransferInsufficientFunds
+	}
+
+	batch := &models.BulkTransferBatch{
+		ID:                newID(),
+		TenantID:          tenantID,
+		DebitAccountID:    debitAccountID,
+		TotalAmount:       total,
+		ItemCount:         len(lines),
+		PayrollTemplateID: payrollTemplateID,
+	}
+	if err := s.batches.Create(batch); err != nil {
+		return nil, nil, err
+	}
+
+	items := make([]models.BulkTransferItem, 0, len(lines))
+	for _, line := range lines {
+		item := models.BulkTransferItem{
+			ID:              newID(),
+			BatchID:         batch.ID,
+			TenantID:        tenantID,
+			CreditAccountID: line.CreditAccountID,
+			Amount:          line.Amount,
+		}
+
+		tx, err := s.transactions.Post(ctx, TransactionInput{
+			TenantID:              tenantID,
+			AccountID:             debitAccountID,
+			CounterpartyAccountID: line.CreditAccountID,
+			Type:                  models.TransactionTypeTransfer,
+			Amount:                line.Amount,
+			CorrelationID:         correlationID,
+		})
+		if err != nil {
+			item.Status = models.BulkTransferItemFailed
+			item.Error = err.Error()
+			batch.FailureCount++
+		} else {
+			item.Status = models.BulkTransferItemPosted
+			item.TransactionID = tx.ID
+			batch.SuccessCount++
+		}
+
+		if err := s.items.Create(&item); err != nil {
+			return nil, nil, err
+		}
+		items = append(items, item)
+	}
+
+	switch {
+	case batch.FailureCount == 0:
+		batch.Status = models.BulkTransferCompleted
+	case batch.SuccessCount == 0:
+		batch.Status = models.BulkTransferRejected
+	default:
+		batch.Status = models.BulkTransferCompletedWithErrors
+	}
+	if err := s.batches.UpdateOutcome(tenantID, batch.ID, batch.Status, batch.SuccessCount, batch.FailureCount); err != nil {
+		return nil, nil, err
+	}
+
+	return batch, items, nil
+}
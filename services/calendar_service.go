@@ -0,0 +1,78 @@
+package services
+
+import (
+	"time"
+
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// CalendarService answers whether a given date is a business day for a
+// tenant: not a weekend, and not on that tenant's holiday calendar. It
+// backs value dating (CutoffService) today; standing orders and loan
+// repayment schedules aren't modeled in this codebase yet, but are
+// expected future callers, which is why NextBusinessDay and
+// AddBusinessDays are general-purpose rather than folded into
+// CutoffService directly.
+type CalendarService struct {
+	holidays *repository.HolidayRepository
+}
+
+// NewCalendarService builds a CalendarService.
+func NewCalendarService(holidays *repository.HolidayRepository) *CalendarService {
+	return &CalendarService{holidays: holidays}
+}
+
+// IsBusinessDay reports whether date is a business day for tenantID: not
+// a Saturday or Sunday, and not on the tenant's holiday calendar. Only
+// date's year, month, and day are considered.
+func (s *CalendarService) IsBusinessDay(tenantID string, date time.Time) (bool, error) {
+	weekday := date.Weekday()
+	if weekday == time.Saturday || weekday == time.Sunday {
+		return false, nil
+	}
+
+	holiday, err := s.holidays.ExistsOnDate(tenantID, dateOnly(date))
+	if err != nil {
+		return false, err
+	}
+	return !holiday, nil
+}
+
+// NextBusinessDay returns the earliest business day on or after date,
+// for tenantID.
+func (s *CalendarService) NextBusinessDay(tenantID string, date time.Time) (time.Time, error) {
+	date = dateOnly(date)
+	for {
+		ok, err := s.IsBusinessDay(tenantID, date)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if ok {
+			return date, nil
+		}
+		date = date.AddDate(0, 0, 1)
+	}
+}
+
+// AddBusinessDays returns the date n business days after date, for
+// tenantID: date itself doesn't count, so AddBusinessDays with n=1 returns
+// the next business day, same as NextBusinessDay(date.AddDate(0,0,1)).
+// n must be non-negative.
+func (s *CalendarService) AddBusinessDays(tenantID string, date time.Time, n int) (time.Time, error) {
+	date = dateOnly(date)
+	for i := 0; i < n; i++ {
+		next, err := s.NextBusinessDay(tenantID, date.AddDate(0, 0, 1))
+		if err != nil {
+			return time.Time{}, err
+		}
+		date = next
+	}
+	return date, nil
+}
+
+// dateOnly truncates t to midnight UTC on its calendar date, discarding
+// its time-of-day and any other location.
+func dateOnly(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,244 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// cardAuthorizationHoldTTL is how long an authorization hold survives
+// before it's eligible to be expired by a reversal, matching the typical
+// card network hold lifetime.
+const cardAuthorizationHoldTTL = 7 * 24 * time.Hour
+
+// switchResponseTimeout bounds how long HandleMessage will wait before
+// giving up and responding with RespSystemMalfunction, since a card
+// switch drops the connection (and the merchant sees a timeout) if it
+// doesn't hear back within its own configured window.
+const switchResponseTimeout = 5 * time.Second
+
+// ISO 8583 MTIs this adapter understands. Only the request MTIs a card
+// switch actually sends this adapter are modeled; anything else is
+// rejected as an unsupported message type.
+const (
+	MTIAuthorizationRequest  = "0100"
+	MTIAuthorizationResponse = "0110"
+	MTIFinancialRequest      = "0200"
+	MTIFinancialResponse     = "0210"
+	MTIReversalRequest       = "0400"
+	MTIReversalResponse      = "0410"
+)
+
+// ISO 8583 field 39 response codes this adapter can return. This is not
+// the full switch-defined table, just the subset this adapter's own
+// error conditions map onto.
+const (
+	RespApproved          = "00"
+	RespDoNotHonor        = "05"
+	RespInvalidAccount    = "14"
+	RespInsufficientFunds = "51"
+	RespSystemMalfunction = "96"
+)
+
+// ErrUnsupportedMTI is returned when HandleMessage is given an MTI this
+// adapter doesn't process.
+var ErrUnsupportedMTI = errors.New("unsupported ISO 8583 message type")
+
+// ErrAuthorizationNotPending is returned when a financial or reversal
+// message references an authorization that has already been captured or
+// reversed.
+var ErrAuthorizationNotPending = errors.New("card authorization is not pending")
+
+// CardMessage is this adapter's decoded view of an ISO 8583 message. Wire
+// framing and bitmap parsing are a card-switch-specific gateway's
+// responsibility; this adapter starts from the fields it actually needs.
+type CardMessage struct {
+	MTI       string
+	RRN       string
+	AccountID string
+	Amount    int64
+	Currency  string
+}
+
+// CardResponse is this adapter's decoded view of the ISO 8583 response it
+// hands back to the switch.
+type CardResponse struct {
+	MTI          string
+	RRN          string
+	ResponseCode string
+}
+
+// CardSwitchService adapts ISO 8583 authorization, financial, and
+// reversal messages from a card switch onto holds and postings against
+// local accounts.
+type CardSwitchService struct {
+	db             *gorm.DB
+	authorizations *repository.CardAuthorizationRepository
+	transactions   *TransactionService
+}
+
+// NewCardSwitchService builds a CardSwitchService.
+func NewCardSwitchService(db *gorm.DB, transactions *TransactionService) *CardSwitchService {
+	return &CardSwitchService{db: db, authorizations: repository.NewCardAuthorizationRepository(db), transactions: transactions}
+}
+
+// HandleMessage dispatches msg by its MTI and always returns a response,
+// even on failure, mapping the failure onto the closest response code
+// instead of propagating a Go error the switch has no way to interpret.
+func (s *CardSwitchService) HandleMessage(ctx context.Context, tenantID string, msg CardMessage) CardResponse {
+	ctx, cancel := context.WithTimeout(ctx, switchResponseTimeout)
+	defer cancel()
+
+	switch msg.MTI {
+	case MTIAuthorizationRequest:
+		_, err := s.authorize(tenantID, msg)
+		return CardResponse{MTI: MTIAuthorizationResponse, RRN: msg.RRN, ResponseCode: responseCodeFor(err)}
+	case MTIFinancialRequest:
+		_, err := s.capture(ctx, tenantID, msg)
+		return CardResponse{MTI: MTIFinancialResponse, RRN: msg.RRN, ResponseCode: responseCodeFor(err)}
+	case MTIReversalRequest:
+		err := s.reverse(tenantID, msg.RRN)
+		return CardResponse{MTI: MTIReversalResponse, RRN: msg.RRN, ResponseCode: responseCodeFor(err)}
+	default:
+		return CardResponse{MTI: msg.MTI, RRN: msg.RRN, ResponseCode: responseCodeFor(ErrUnsupportedMTI)}
+	}
+}
+
+// authorize places a hold of msg.Amount on msg.AccountID for the
+// financial or reversal message that follows.
+func (s *CardSwitchService) authorize(tenantID string, msg CardMessage) (*models.CardAuthorization, error) {
+	var auth *models.CardAuthorization
+
+	err := s.db.Transaction(func(dbtx *gorm.DB) error {
+		accounts := repository.NewAccountRepository(dbtx)
+		authorizations := s.authorizations.WithTx(dbtx)
+
+		account, err := accounts.FindByIDForUpdate(tenantID, msg.AccountID)
+		if err != nil {
+			return err
+		}
+		if account.Available() < msg.Amount {
+			return ErrInsufficientFunds
+		}
+
+		if err := accounts.UpdateHeldAmount(tenantID, account.ID, account.HeldAmount+msg.Amount); err != nil {
+			return err
+		}
+
+		auth = &models.CardAuthorization{
+			ID:        newID(),
+			TenantID:  tenantID,
+			AccountID: msg.AccountID,
+			RRN:       msg.RRN,
+			Amount:    msg.Amount,
+			Currency:  msg.Currency,
+			Status:    models.CardAuthorizationPending,
+			ExpiresAt: time.Now().Add(cardAuthorizationHoldTTL),
+		}
+		return authorizations.Create(auth)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return auth, nil
+}
+
+// capture converts a pending authorization's hold into a posted
+// withdrawal, matched to it by RRN.
+func (s *CardSwitchService) capture(ctx context.Context, tenantID string, msg CardMessage) (*models.Transaction, error) {
+	auth, err := s.authorizations.FindByRRN(tenantID, msg.RRN)
+	if err != nil {
+		return nil, err
+	}
+	if auth.Status != models.CardAuthorizationPending {
+		return nil, ErrAuthorizationNotPending
+	}
+
+	err = s.db.Transaction(func(dbtx *gorm.DB) error {
+		accounts := repository.NewAccountRepository(dbtx)
+
+		account, err := accounts.FindByIDForUpdate(tenantID, auth.AccountID)
+		if err != nil {
+			return err
+		}
+		return accounts.UpdateHeldAmount(tenantID, account.ID, account.HeldAmount-auth.Amount)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.transactions.Post(ctx, TransactionInput{
+		TenantID:  tenantID,
+		AccountID: auth.AccountID,
+		Type:      models.TransactionTypeWithdrawal,
+		Amount:    auth.Amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authorizations.MarkCaptured(tenantID, auth.ID, tx.ID); err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+// reverse releases a pending authorization's hold without ever posting,
+// for a declined or timed-out card transaction.
+func (s *CardSwitchService) reverse(tenantID, rrn string) error {
+	auth, err := s.authorizations.FindByRRN(tenantID, rrn)
+	if err != nil {
+		return err
+	}
+	if auth.Status != models.CardAuthorizationPending {
+		return ErrAuthorizationNotPending
+	}
+
+	return s.db.Transaction(func(dbtx *gorm.DB) error {
+		accounts := repository.NewAccountRepository(dbtx)
+		authorizations := s.authorizations.WithTx(dbtx)
+
+		// Claim the reversal before touching the account's held amount:
+		// MarkReversed's status = pending guard means only one of a
+		// concurrent reversal message and hold-expiry sweep for the same
+		// authorization can win, so only one of them decrements the hold.
+		rowsAffected, err := authorizations.MarkReversed(tenantID, auth.ID)
+		if err != nil {
+			return err
+		}
+		if rowsAffected == 0 {
+			return ErrAuthorizationNotPending
+		}
+
+		account, err := accounts.FindByIDForUpdate(tenantID, auth.AccountID)
+		if err != nil {
+			return err
+		}
+		return accounts.UpdateHeldAmount(tenantID, account.ID, account.HeldAmount-auth.Amount)
+	})
+}
+
+// responseCodeFor maps this adapter's own error conditions onto the ISO
+// 8583 field 39 response code the switch expects, defaulting to
+// RespSystemMalfunction for anything unexpected rather than leaking a Go
+// error string onto the wire.
+func responseCodeFor(err error) string {
+	switch {
+	case err == nil:
+		return RespApproved
+	case errors.Is(err, ErrInsufficientFunds):
+		return RespInsufficientFunds
+	case errors.Is(err, ErrAuthorizationNotPending):
+		return RespDoNotHonor
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return RespInvalidAccount
+	default:
+		return RespSystemMalfunction
+	}
+}
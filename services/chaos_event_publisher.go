@@ -0,0 +1,24 @@
+package services
+
+// ChaosEventPublisher wraps an EventPublisher and fails a publish with
+// ErrChaosAMQPDisconnected instead of delegating to next whenever chaos's
+// amqp_disconnect fault is armed, so TransactionService's dead-letter
+// fallback can be verified against a simulated broker disconnect without
+// touching a real broker.
+type ChaosEventPublisher struct {
+	next  EventPublisher
+	chaos *ChaosService
+}
+
+// NewChaosEventPublisher builds a ChaosEventPublisher.
+func NewChaosEventPublisher(next EventPublisher, chaos *ChaosService) *ChaosEventPublisher {
+	return &ChaosEventPublisher{next: next, chaos: chaos}
+}
+
+// Publish implements EventPublisher.
+func (p *ChaosEventPublisher) Publish(eventType, correlationID string, payload interface{}) error {
+	if err := p.chaos.CheckAMQP(); err != nil {
+		return err
+	}
+	return p.next.Publish(eventType, correlationID, payload)
+}
@@ -0,0 +1,112 @@
+package services
+
+import (
+	"errors"
+	"sync/atomic"
+)
+
+// ErrChaosDisabled is returned by Arm when fault injection isn't
+// available in this environment (see NewChaosService).
+var ErrChaosDisabled = errors.New("fault injection is disabled in this environment")
+
+// ErrChaosUnknownFault is returned by Arm for a ChaosFault it doesn't
+// recognize.
+var ErrChaosUnknownFault = errors.New("unknown chaos fault")
+
+// ErrChaosPostgresTimeout, ErrChaosMongoWriteFailure, and
+// ErrChaosAMQPDisconnected are the synthetic errors ChaosService injects
+// once their matching fault is armed, standing in for the real timeout,
+// write failure, or broker disconnect they simulate.
+var (
+	ErrChaosPostgresTimeout   = errors.New("chaos: simulated postgres timeout")
+	ErrChaosMongoWriteFailure = errors.New("chaos: simulated mongo write failure")
+	ErrChaosAMQPDisconnected  = errors.New("chaos: simulated amqp disconnect")
+)
+
+// ChaosFault identifies one dependency ChaosService can simulate failing.
+type ChaosFault string
+
+const (
+	ChaosFaultPostgresTimeout   ChaosFault = "postgres_timeout"
+	ChaosFaultMongoWriteFailure ChaosFault = "mongo_write_failure"
+	ChaosFaultAMQPDisconnect    ChaosFault = "amqp_disconnect"
+)
+
+// ChaosService lets a staging operator arm and disarm simulated failures
+// of TransactionService's three external dependencies on demand, to
+// verify its retry, dead-letter, and best-effort-mongo behavior against a
+// real failure instead of only in unit tests.
+//
+// It is only ever wired up outside production (see config.Config.
+// IsProduction): with enabled false, Arm always fails with
+// ErrChaosDisabled and every Check always reports no fault, regardless of
+// what was armed before enabled flipped.
+type ChaosService struct {
+	enabled           bool
+	postgresTimeout   atomic.Bool
+	mongoWriteFailure atomic.Bool
+	amqpDisconnect    atomic.Bool
+}
+
+// NewChaosService builds a ChaosService gated by enabled.
+func NewChaosService(enabled bool) *ChaosService {
+	return &ChaosService{enabled: enabled}
+}
+
+// Enabled reports whether fault injection is available in this
+// environment.
+func (s *ChaosService) Enabled() bool {
+	return s.enabled
+}
+
+// Arm turns fault on or off. It fails with ErrChaosDisabled if Enabled is
+// false, and with ErrChaosUnknownFault for an unrecognized fault.
+func (s *ChaosService) Arm(fault ChaosFault, on bool) error {
+	if !s.enabled {
+		return ErrChaosDisabled
+	}
+	switch fault {
+	case ChaosFaultPostgresTimeout:
+		s.postgresTimeout.Store(on)
+	case ChaosFaultMongoWriteFailure:
+		s.mongoWriteFailure.Store(on)
+	case ChaosFaultAMQPDisconnect:
+		s.amqpDisconnect.Store(on)
+	default:
+		return ErrChaosUnknownFault
+	}
+	return nil
+}
+
+// Armed reports which faults are currently armed.
+func (s *ChaosService) Armed() map[ChaosFault]bool {
+	return map[ChaosFault]bool{
+		ChaosFaultPostgresTimeout:   s.postgresTimeout.Load(),
+		ChaosFaultMongoWriteFailure: s.mongoWriteFailure.Load(),
+		ChaosFaultAMQPDisconnect:    s.amqpDisconnect.Load(),
+	}
+}
+
+// CheckPostgres returns ErrChaosPostgresTimeout if that fault is armed.
+func (s *ChaosService) CheckPostgres() error {
+	if s.postgresTimeout.Load() {
+		return ErrChaosPostgresTimeout
+	}
+	return nil
+}
+
+// CheckMongo returns ErrChaosMongoWriteFailure if that fault is armed.
+func (s *ChaosService) CheckMongo() error {
+	if s.mongoWriteFailure.Load() {
+		return ErrChaosMongoWriteFailure
+	}
+	return nil
+}
+
+// CheckAMQP returns ErrChaosAMQPDisconnected if that fault is armed.
+func (s *ChaosService) CheckAMQP() error {
+	if s.amqpDisconnect.Load() {
+		return ErrChaosAMQPDisconnected
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// ErrChequeNotPending is returned when a clearing callback targets a
+// cheque deposit that has already been cleared or bounced.
+var ErrChequeNotPending = errors.New("cheque deposit is not pending")
+
+// ChequeDepositService records cheque deposits and applies clearing
+// callbacks against them.
+type ChequeDepositService struct {
+	deposits     *repository.ChequeDepositRepository
+	transactions *TransactionService
+}
+
+// NewChequeDepositService builds a ChequeDepositService.
+func NewChequeDepositService(deposits *repository.ChequeDepositRepository, transactions *TransactionService) *ChequeDepositService {
+	return &ChequeDepositService{deposits: deposits, transactions: transactions}
+}
+
+// Record stores a new pending cheque deposit. No funds move until it clears.
+func (s *ChequeDepositService) Record(tenantID, accountID, chequeNumber, issuingBank, imageRef string, amount int64) (*models.ChequeDeposit, error) {
+	deposit := &models.ChequeDeposit{
+		ID:           newID(),
+		TenantID:     tenantID,
+		AccountID:    accountID,
+		ChequeNumber: chequeNumber,
+		IssuingBank:  issuingBank,
+		Amount:       amount,
+		ImageRef:     imageRef,
+		Status:       models.ChequeDepositPending,
+	}
+	if err := s.deposits.Create(deposit); err != nil {
+		return nil, err
+	}
+	return deposit, nil
+}
+
+// Clear posts the deposit's amount to the account and marks it cleared.
+func (s *ChequeDepositService) Clear(ctx context.Context, tenantID, id string) (*models.ChequeDeposit, error) {
+	deposit, err := s.deposits.FindByID(tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if deposit.Status != models.ChequeDepositPending {
+		return nil, ErrChequeNotPending
+	}
+
+	// Claim the deposit before posting, not after: two concurrent
+	// clearing callbacks for the same deposit can both read Pending
+	// above, and without a claim in between, both would post a deposit
+	// before either recorded it cleared. UpdateStatus's WHERE status =
+	// pending guard means only one of them can win this update; the
+	// loser sees rowsAffected == 0 and reports ErrChequeNotPending
+	// instead of double-crediting the account.
+	rowsAffected, err := s.deposits.UpdateStatus(tenantID, id, models.ChequeDepositCleared, "")
+	if err != nil {
+		return nil, err
+	}
+	if rowsAffected == 0 {
+		return nil, ErrChequeNotPending
+	}
+
+	tx, err := s.transactions.Post(ctx, TransactionInput{
+		TenantID:  tenantID,
+		AccountID: deposit.AccountID,
+		Type:      models.TransactionTypeDeposit,
+		Amount:    deposit.Amount,
+	})
+	if err != nil {
+		// The claim above already flipped this deposit to cleared; put
+		// it back to pending so it isn't stranded cleared with nothing
+		// posted against it, and can be retried.
+		_ = s.deposits.Reopen(tenantID, id)
+		return nil, err
+	}
+
+	if err := s.deposits.SetTransactionID(tenantID, id, tx.ID); err != nil {
+		return nil, err
+	}
+
+	deposit.Status = models.ChequeDepositCleared
+	deposit.TransactionID = tx.ID
+	return deposit, nil
+}
+
+// Bounce marks the deposit as bounced without ever crediting the account.
+func (s *ChequeDepositService) Bounce(tenantID, id string) (*models.ChequeDeposit, error) {
+	deposit, err := s.deposits.FindByID(tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if deposit.Status != models.ChequeDepositPending {
+		return nil, ErrChequeNotPending
+	}
+
+	rowsAffected, err := s.deposits.UpdateStatus(tenantID, id, models.ChequeDepositBounced, "")
+	if err != nil {
+		return nil, err
+	}
+	if rowsAffected == 0 {
+		return nil, ErrChequeNotPending
+	}
+
+	deposit.Status = models.ChequeDepositBounced
+	return deposit, nil
+}
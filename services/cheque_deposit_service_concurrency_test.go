@@ -0,0 +1,79 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// TestChequeDepositService_ConcurrentClearsPostOnce fires concurrent
+// Clear calls against the same pending deposit and asserts exactly one
+// of them posts. Before UpdateStatus was guarded by status = pending,
+// Clear posted first and only recorded cleared afterward, so every
+// caller that read Pending before any of them updated the status would
+// post its own deposit.
+func TestChequeDepositService_ConcurrentClearsPostOnce(t *testing.T) {
+	db := testDB(t)
+
+	const (
+		tenantID = "66666666-6666-6666-6666-666666666666"
+		amount   = int64(500)
+		attempts = 5
+	)
+
+	accounts := repository.NewAccountRepository(db)
+	account := &models.Account{ID: newID(), TenantID: tenantID, UserID: newID(), Currency: "USD", Balance: 0}
+	if err := accounts.Create(account); err != nil {
+		t.Fatalf("create account: %v", err)
+	}
+
+	deposits := repository.NewChequeDepositRepository(db)
+	deposit := &models.ChequeDeposit{
+		ID:           newID(),
+		TenantID:     tenantID,
+		AccountID:    account.ID,
+		ChequeNumber: "000123",
+		IssuingBank:  "Test Bank",
+		Amount:       amount,
+		Status:       models.ChequeDepositPending,
+	}
+	if err := deposits.Create(deposit); err != nil {
+		t.Fatalf("create deposit: %v", err)
+	}
+
+	transactions := NewTransactionService(db, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	svc := NewChequeDepositService(deposits, transactions)
+
+	var wg sync.WaitGroup
+	posted := make([]bool, attempts)
+	for i := 0; i < attempts; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			cleared, err := svc.Clear(context.Background(), tenantID, deposit.ID)
+			posted[i] = err == nil && cleared != nil
+		}(i)
+	}
+	wg.Wait()
+
+	successCount := 0
+	for _, ok := range posted {
+		if ok {
+			successCount++
+		}
+	}
+	if successCount != 1 {
+		t.Fatalf("got %d successful Clear calls, want exactly 1", successCount)
+	}
+
+	after, err := accounts.FindByID(tenantID, account.ID)
+	if err != nil {
+		t.Fatalf("find account: %v", err)
+	}
+	if after.Balance != amount {
+		t.Fatalf("account balance = %d, want %d (posted exactly once)", after.Balance, amount)
+	}
+}
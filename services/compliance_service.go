@@ -0,0 +1,106 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// ErrHoldNotPending is returned when a release/deny action targets a
+// compliance hold that has already been resolved.
+var ErrHoldNotPending = errors.New("compliance hold is not pending")
+
+// ComplianceService screens transfers before they post and manages the
+// resulting holds. A screening match takes the transfer out of the normal
+// posting flow entirely until an admin releases or denies it.
+type ComplianceService struct {
+	holds        *repository.ComplianceHoldRepository
+	screening    *ScreeningService
+	transactions *TransactionService
+}
+
+// NewComplianceService builds a ComplianceService.
+func NewComplianceService(holds *repository.ComplianceHoldRepository, screening *ScreeningService, transactions *TransactionService) *ComplianceService {
+	return &ComplianceService{holds: holds, screening: screening, transactions: transactions}
+}
+
+// Submit screens in and either posts it immediately (no match) or opens a
+// compliance hold and returns it instead of posting (match). Exactly one
+// of the two return values is non-nil.
+func (s *ComplianceService) Submit(ctx context.Context, in TransactionInput) (*models.Transaction, *models.ComplianceHold, error) {
+	matched, matchedValue, reason, err := s.screening.Screen(in.TenantID, in.AccountID, in.CounterpartyAccountID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !matched {
+		tx, err := s.transactions.Post(ctx, in)
+		return tx, nil, err
+	}
+
+	hold := &models.ComplianceHold{
+		ID:                    newID(),
+		TenantID:              in.TenantID,
+		AccountID:             in.AccountID,
+		CounterpartyAccountID: in.CounterpartyAccountID,
+		Amount:                in.Amount,
+		BranchID:              in.BranchID,
+		PerformedByUserID:     in.PerformedByUserID,
+		MatchedValue:          matchedValue,
+		Reason:                reason,
+		Status:                models.ComplianceHoldPending,
+		// Recorded up front (rather than only once posted) so a caller who
+		// generated in.RequestID can already correlate this hold to the
+		// transaction it will eventually produce.
+		TransactionID: in.RequestID,
+	}
+	if err := s.holds.Create(hold); err != nil {
+		return nil, nil, err
+	}
+	return nil, hold, nil
+}
+
+// Release posts the transfer a pending hold was blocking and marks the
+// hold released.
+func (s *ComplianceService) Release(ctx context.Context, tenantID, id string) (*models.Transaction, error) {
+	hold, err := s.holds.FindByID(tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if hold.Status != models.ComplianceHoldPending {
+		return nil, ErrHoldNotPending
+	}
+
+	tx, err := s.transactions.Post(ctx, TransactionInput{
+		TenantID:              hold.TenantID,
+		AccountID:             hold.AccountID,
+		CounterpartyAccountID: hold.CounterpartyAccountID,
+		Type:                  models.TransactionTypeTransfer,
+		Amount:                hold.Amount,
+		BranchID:              hold.BranchID,
+		PerformedByUserID:     hold.PerformedByUserID,
+		RequestID:             hold.TransactionID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.holds.Resolve(tenantID, id, models.ComplianceHoldReleased, tx.ID); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// Deny discards the transfer a pending hold was blocking; it never posts.
+func (s *ComplianceService) Deny(tenantID, id string) error {
+	hold, err := s.holds.FindByID(tenantID, id)
+	if err != nil {
+		return err
+	}
+	if hold.Status != models.ComplianceHoldPending {
+		return ErrHoldNotPending
+	}
+	return s.holds.Resolve(tenantID, id, models.ComplianceHoldDenied, "")
+}
@@ -0,0 +1,112 @@
+package services
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// ErrConsentNotFound is returned when a request authenticates with a
+// token that isn't tied to any consent, or when a consent to revoke
+// doesn't exist under the calling tenant.
+var ErrConsentNotFound = errors.New("consent not found")
+
+// ErrConsentNotLive is returned when a request's consent has been revoked
+// or has expired.
+var ErrConsentNotLive = errors.New("consent is revoked or expired")
+
+// ErrAccountNotConsented is returned when a request's consent doesn't
+// cover the account being read.
+var ErrAccountNotConsented = errors.New("account is not covered by this consent")
+
+// ConsentService grants and revokes Open Banking consents: a customer's
+// explicit, time-bounded approval for a third party to read a specific
+// set of their accounts, enforced through a scoped APIToken minted for
+// exactly that grant.
+type ConsentService struct {
+	consents  *repository.ConsentRepository
+	apiTokens *APITokenService
+}
+
+// NewConsentService builds a ConsentService.
+func NewConsentService(consents *repository.ConsentRepository, apiTokens *APITokenService) *ConsentService {
+	return &ConsentService{consents: consents, apiTokens: apiTokens}
+}
+
+// Grant mints a read-only APIToken scoped to accountIDs and records the
+// consent it was issued under, returning both the consent and the
+// plaintext token, which is never recoverable again once this call
+// returns.
+func (s *ConsentService) Grant(tenantID, userID, thirdPartyName string, accountIDs []string, ttl time.Duration) (*models.Consent, string, error) {
+	token, plaintext, err := s.apiTokens.Issue(tenantID, userID, "open-banking:"+thirdPartyName, []string{models.ScopeBalanceRead, models.ScopeHistoryRead})
+	if err != nil {
+		return nil, "", err
+	}
+
+	consent := &models.Consent{
+		ID:             newID(),
+		TenantID:       tenantID,
+		UserID:         userID,
+		ThirdPartyName: thirdPartyName,
+		AccountIDs:     strings.Join(accountIDs, " "),
+		APITokenID:     token.ID,
+		Status:         models.ConsentActive,
+		ExpiresAt:      time.Now().Add(ttl),
+	}
+	if err := s.consents.Create(consent); err != nil {
+		return nil, "", err
+	}
+
+	return consent, plaintext, nil
+}
+
+// Revoke disables a consent's underlying token so it can no longer
+// authenticate requests, and marks the consent revoked.
+func (s *ConsentService) Revoke(tenantID, id string) (*models.Consent, error) {
+	consent, err := s.consents.FindByID(tenantID, id)
+	if err != nil {
+		return nil, ErrConsentNotFound
+	}
+	if consent.Status == models.ConsentRevoked {
+		return consent, nil
+	}
+
+	if err := s.apiTokens.Revoke(tenantID, consent.APITokenID); err != nil {
+		return nil, err
+	}
+
+	consent.Status = models.ConsentRevoked
+	if err := s.consents.Revoke(consent); err != nil {
+		return nil, err
+	}
+	return consent, nil
+}
+
+// Resolve looks up the consent an APIToken was minted for and checks that
+// it's still live.
+func (s *ConsentService) Resolve(tenantID, apiTokenID string) (*models.Consent, error) {
+	consent, err := s.consents.FindByAPITokenID(tenantID, apiTokenID)
+	if err != nil {
+		return nil, ErrConsentNotFound
+	}
+	if !consent.Live(time.Now()) {
+		return nil, ErrConsentNotLive
+	}
+	return consent, nil
+}
+
+// Authorize resolves the consent an APIToken was minted for and checks
+// that it's still live and covers accountID.
+func (s *ConsentService) Authorize(tenantID, apiTokenID, accountID string) (*models.Consent, error) {
+	consent, err := s.Resolve(tenantID, apiTokenID)
+	if err != nil {
+		return nil, err
+	}
+	if !consent.HasAccount(accountID) {
+		return nil, ErrAccountNotConsented
+	}
+	return consent, nil
+}
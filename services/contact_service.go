@@ -0,0 +1,54 @@
+package services
+
+import (
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// ContactMatch is a hashed phone number the caller submitted that
+// corresponds to a registered user.
+type ContactMatch struct {
+	PhoneHash string `json:"phone_hash"`
+	UserID    string `json:"user_id"`
+	Name      string `json:"name"`
+}
+
+// ContactService matches a caller's hashed contact list against
+// registered users, so the mobile app can offer "send to a contact"
+// without either side exchanging plaintext phone numbers.
+type ContactService struct {
+	users *repository.UserRepository
+}
+
+// NewContactService builds a ContactService.
+func NewContactService(users *repository.UserRepository) *ContactService {
+	return &ContactService{users: users}
+}
+
+// Match returns one ContactMatch for every hash in phoneHashes that
+// corresponds to a registered user's phone number under tenantID.
+// phoneHashes must be keyed the same way UserRepository keys PhoneHash
+// (see models.HashPhone), so the mobile app needs the same shared key
+// provisioned to it to hash its local contacts before calling this.
+func (s *ContactService) Match(tenantID string, phoneHashes []string) ([]ContactMatch, error) {
+	wanted := make(map[string]bool, len(phoneHashes))
+	for _, hash := range phoneHashes {
+		wanted[hash] = true
+	}
+
+	users, err := s.users.List(tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []ContactMatch
+	for _, user := range users {
+		if user.Phone == "" {
+			continue
+		}
+		hash := s.users.HashPhone(user.Phone)
+		if wanted[hash] {
+			matches = append(matches, ContactMatch{PhoneHash: hash, UserID: user.ID, Name: user.Name})
+		}
+	}
+	return matches, nil
+}
@@ -0,0 +1,72 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// CutoffService lets admins configure a per-transaction-type daily
+// cut-off and computes the value date a submission at a given time
+// should settle under.
+type CutoffService struct {
+	schedules *repository.CutoffScheduleRepository
+	calendar  *CalendarService
+}
+
+// NewCutoffService builds a CutoffService.
+func NewCutoffService(schedules *repository.CutoffScheduleRepository, calendar *CalendarService) *CutoffService {
+	return &CutoffService{schedules: schedules, calendar: calendar}
+}
+
+// Get returns the cut-off configured for txType, or a zero-value,
+// cut-off-free schedule if none has been set yet.
+func (s *CutoffService) Get(tenantID string, txType models.TransactionType) (*models.CutoffSchedule, error) {
+	schedule, err := s.schedules.FindByType(tenantID, txType)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &models.CutoffSchedule{TenantID: tenantID, Type: txType}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+// Set creates or replaces the cut-off configured for txType. cutoffMinute
+// is minutes past midnight UTC, e.g. 16*60 for a 16:00 UTC cut-off.
+func (s *CutoffService) Set(tenantID string, txType models.TransactionType, cutoffMinute int) (*models.CutoffSchedule, error) {
+	schedule := &models.CutoffSchedule{
+		ID:           newID(),
+		TenantID:     tenantID,
+		Type:         txType,
+		CutoffMinute: cutoffMinute,
+	}
+	if err := s.schedules.Upsert(schedule); err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+// ValueDate returns the date a submission of txType made at submittedAt
+// should value-date to: submittedAt's own date if it's a business day and
+// at or before any configured cut-off, otherwise the next business day.
+// A transaction type with no cut-off configured still value-dates forward
+// off of a non-business submission day.
+func (s *CutoffService) ValueDate(tenantID string, txType models.TransactionType, submittedAt time.Time) (time.Time, error) {
+	schedule, err := s.Get(tenantID, txType)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	date := dateOnly(submittedAt)
+	minuteOfDay := submittedAt.UTC().Hour()*60 + submittedAt.UTC().Minute()
+	if schedule.CutoffMinute > 0 && minuteOfDay > schedule.CutoffMinute {
+		date = date.AddDate(0, 0, 1)
+	}
+
+	return s.calendar.NextBusinessDay(tenantID, date)
+}
@@ -0,0 +1,128 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// DailySummaryService lets a user opt in to an end-of-day notification
+// summarizing their spending, and runs the nightly job that computes and
+// sends it.
+type DailySummaryService struct {
+	subscriptions *repository.DailySummarySubscriptionRepository
+	accounts      *repository.AccountRepository
+	logs          *repository.TransactionLogRepository
+	notifications NotificationProvider
+}
+
+// NewDailySummaryService builds a DailySummaryService.
+func NewDailySummaryService(subscriptions *repository.DailySummarySubscriptionRepository, accounts *repository.AccountRepository, logs *repository.TransactionLogRepository, notifications NotificationProvider) *DailySummaryService {
+	return &DailySummaryService{subscriptions: subscriptions, accounts: accounts, logs: logs, notifications: notifications}
+}
+
+// GetSubscription returns userID's daily summary subscription, or a
+// disabled zero-value subscription if they've never opted in.
+func (s *DailySummaryService) GetSubscription(tenantID, userID string) (*models.DailySummarySubscription, error) {
+	sub, err := s.subscriptions.FindForUser(tenantID, userID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &models.DailySummarySubscription{TenantID: tenantID, UserID: userID}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return sub, nil
+}
+
+// SetSubscription creates or replaces userID's daily summary opt-in.
+func (s *DailySummaryService) SetSubscription(tenantID, userID string, enabled bool) (*models.DailySummarySubscription, error) {
+	existing, err := s.subscriptions.FindForUser(tenantID, userID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	if existing == nil {
+		sub := &models.DailySummarySubscription{
+			ID:       newID(),
+			TenantID: tenantID,
+			UserID:   userID,
+			Enabled:  enabled,
+		}
+		if err := s.subscriptions.Create(sub); err != nil {
+			return nil, err
+		}
+		return sub, nil
+	}
+
+	existing.Enabled = enabled
+	if err := s.subscriptions.Update(existing); err != nil {
+		return nil, err
+	}
+	return existing, nil
+}
+
+// RunForDay sends every opted-in user their spending summary for day,
+// aggregated from the Mongo transaction log. A single user's failure is
+// logged and skipped rather than aborting the rest of the run.
+func (s *DailySummaryService) RunForDay(ctx context.Context, day time.Time) {
+	subs, err := s.subscriptions.ListEnabled()
+	if err != nil {
+		log.Printf("failed to list daily summary subscriptions: %v", err)
+		return
+	}
+
+	for _, sub := range subs {
+		if err := s.summarize(ctx, sub.TenantID, sub.UserID, day); err != nil {
+			log.Printf("daily summary run for user %s failed: %v", sub.UserID, err)
+		}
+	}
+}
+
+// summarize aggregates userID's accounts for day and notifies them, unless
+// the day saw no activity at all.
+func (s *DailySummaryService) summarize(ctx context.Context, tenantID, userID string, day time.Time) error {
+	accounts, err := s.accounts.ListByUser(tenantID, userID)
+	if err != nil {
+		return err
+	}
+	if len(accounts) == 0 {
+		return nil
+	}
+
+	accountIDs := make([]string, len(accounts))
+	var remainingBalance int64
+	for i, account := range accounts {
+		accountIDs[i] = account.ID
+		remainingBalance += account.Balance
+	}
+
+	from := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, day.Location())
+	to := from.Add(24 * time.Hour)
+
+	aggregates, err := s.logs.AggregateDaily(ctx, tenantID, accountIDs, from, to)
+	if err != nil {
+		return err
+	}
+
+	var totalIn, totalOut, largest int64
+	for _, aggregate := range aggregates {
+		totalIn += aggregate.TotalIn
+		totalOut += aggregate.TotalOut
+		if aggregate.LargestTransaction > largest {
+			largest = aggregate.LargestTransaction
+		}
+	}
+	if totalIn == 0 && totalOut == 0 {
+		return nil
+	}
+
+	message := fmt.Sprintf("today's activity: %d in, %d out, largest transaction %d, remaining balance %d", totalIn, totalOut, largest, remainingBalance)
+	return s.notifications.Notify(userID, message)
+}
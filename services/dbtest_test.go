@@ -0,0 +1,31 @@
+package services
+
+import (
+	"os"
+	"testing"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/database"
+)
+
+// testDB connects to a real Postgres for concurrency tests that need
+// actual row locking and transaction isolation, which no in-process
+// fake can reproduce faithfully. It reads POSTGRES_DSN the same way
+// config.Load does, and skips the test if that database isn't
+// reachable, since a live Postgres isn't assumed to be available in
+// every environment this test suite runs in.
+func testDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	dsn := os.Getenv("POSTGRES_DSN")
+	if dsn == "" {
+		dsn = "host=localhost user=postgres password=postgres dbname=go_bank_api_test port=5432 sslmode=disable"
+	}
+
+	db, err := database.NewPostgres(dsn)
+	if err != nil {
+		t.Skipf("skipping: no Postgres reachable at POSTGRES_DSN (%v)", err)
+	}
+	return db
+}
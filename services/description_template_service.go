@@ -0,0 +1,119 @@
+package services
+
+import (
+	"bytes"
+	"errors"
+	"text/template"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// defaultDescriptionLocale is used when a caller doesn't specify a
+// locale, and as the fallback when no template is configured for a
+// requested one.
+const defaultDescriptionLocale = "en"
+
+// defaultDescriptionTemplates are the built-in templates a system posting
+// renders from until a tenant configures its own, for the reason codes
+// below; other reason codes TransactionService.Post is called with (e.g.
+// payment_initiation, external_deposit_settled) have no built-in
+// template. Each is a text/template body rendered against that reason
+// code's own variables:
+//   - interest_accrual: Period (e.g. "March")
+//   - external_transfer_returned: Reason
+//   - merchant_settlement: Period
+//   - suspense_matched: OriginalTransactionID
+var defaultDescriptionTemplates = map[string]string{
+	"interest_accrual":           "Interest accrued{{if .Period}} for {{.Period}}{{end}}",
+	"external_transfer_returned": "External transfer returned{{if .Reason}}: {{.Reason}}{{end}}",
+	"merchant_settlement":        "Merchant settlement{{if .Period}} for {{.Period}}{{end}}",
+	"suspense_matched":           "Suspense item matched{{if .OriginalTransactionID}} to transaction {{.OriginalTransactionID}}{{end}}",
+}
+
+// DescriptionTemplateService renders the human-readable description
+// attached to a system-generated transaction, from a per-tenant,
+// per-locale template keyed by the same reason code
+// TransactionService.Post records under Transaction.ReasonCode.
+type DescriptionTemplateService struct {
+	templates *repository.DescriptionTemplateRepository
+}
+
+// NewDescriptionTemplateService builds a DescriptionTemplateService.
+func NewDescriptionTemplateService(templates *repository.DescriptionTemplateRepository) *DescriptionTemplateService {
+	return &DescriptionTemplateService{templates: templates}
+}
+
+// Get returns the template body configured for reasonCode and locale
+// under tenantID, falling back to this package's built-in default if the
+// tenant hasn't configured one. Empty if neither exists.
+func (s *DescriptionTemplateService) Get(tenantID, reasonCode, locale string) (string, error) {
+	found, err := s.templates.FindByReasonAndLocale(tenantID, reasonCode, locale)
+	if err == nil {
+		return found.Template, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return "", err
+	}
+	if locale == defaultDescriptionLocale {
+		return defaultDescriptionTemplates[reasonCode], nil
+	}
+	return "", nil
+}
+
+// Set creates or replaces the template configured for reasonCode and
+// locale under tenantID.
+func (s *DescriptionTemplateService) Set(tenantID, reasonCode, locale, body string) (*models.DescriptionTemplate, error) {
+	template := &models.DescriptionTemplate{
+		ID:         newID(),
+		TenantID:   tenantID,
+		ReasonCode: reasonCode,
+		Locale:     locale,
+		Template:   body,
+	}
+	if err := s.templates.Upsert(template); err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+// Render returns reasonCode's description in locale (falling back to
+// defaultDescriptionLocale if locale is empty, or if no template is
+// configured for the requested one), with vars substituted in. A reason
+// code with no configured or built-in template renders to an empty
+// description, matching current behavior for transactions posted before
+// this system existed.
+func (s *DescriptionTemplateService) Render(tenantID, reasonCode, locale string, vars map[string]string) (string, error) {
+	if reasonCode == "" {
+		return "", nil
+	}
+	if locale == "" {
+		locale = defaultDescriptionLocale
+	}
+
+	body, err := s.Get(tenantID, reasonCode, locale)
+	if err != nil {
+		return "", err
+	}
+	if body == "" && locale != defaultDescriptionLocale {
+		body, err = s.Get(tenantID, reasonCode, defaultDescriptionLocale)
+		if err != nil {
+			return "", err
+		}
+	}
+	if body == "" {
+		return "", nil
+	}
+
+	tmpl, err := template.New(reasonCode).Parse(body)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, vars); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,95 @@
+package services
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// ErrInvalidDeviceKey is returned when a public key submitted for
+// registration isn't a validly encoded Ed25519 key.
+var ErrInvalidDeviceKey = errors.New("public key must be a base64-encoded ed25519 key")
+
+// ErrDeviceKeyNotRegistered is returned when a signature is checked
+// against a device that has no registered key.
+var ErrDeviceKeyNotRegistered = errors.New("no signing key registered for this device")
+
+// ErrSignatureInvalid is returned when a submitted signature doesn't
+// verify against the device's registered public key.
+var ErrSignatureInvalid = errors.New("signature does not verify against registered device key")
+
+// DeviceKeyService registers device signing keys and verifies signatures
+// over a transaction's canonical payload, giving non-repudiation beyond
+// what a bearer credential alone can prove.
+type DeviceKeyService struct {
+	keys *repository.DeviceKeyRepository
+}
+
+// NewDeviceKeyService builds a DeviceKeyService.
+func NewDeviceKeyService(keys *repository.DeviceKeyRepository) *DeviceKeyService {
+	return &DeviceKeyService{keys: keys}
+}
+
+// Register validates and persists a new public key for a user's device.
+func (s *DeviceKeyService) Register(tenantID, userID, deviceID, publicKeyBase64 string) (*models.DeviceKey, error) {
+	raw, err := base64.StdEncoding.DecodeString(publicKeyBase64)
+	if err != nil || len(raw) != ed25519.PublicKeySize {
+		return nil, ErrInvalidDeviceKey
+	}
+
+	key := &models.DeviceKey{
+		ID:        newID(),
+		TenantID:  tenantID,
+		UserID:    userID,
+		DeviceID:  deviceID,
+		PublicKey: publicKeyBase64,
+	}
+	if err := s.keys.Create(key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// Verify checks signatureBase64 against payload using the public key
+// registered for userID/deviceID.
+func (s *DeviceKeyService) Verify(tenantID, userID, deviceID string, payload []byte, signatureBase64 string) error {
+	key, err := s.keys.FindByUserAndDevice(tenantID, userID, deviceID)
+	if err != nil || key.RevokedAt != nil {
+		return ErrDeviceKeyNotRegistered
+	}
+
+	publicKey, err := base64.StdEncoding.DecodeString(key.PublicKey)
+	if err != nil {
+		return ErrDeviceKeyNotRegistered
+	}
+	signature, err := base64.StdEncoding.DecodeString(signatureBase64)
+	if err != nil {
+		return ErrSignatureInvalid
+	}
+
+	if !ed25519.Verify(ed25519.PublicKey(publicKey), payload, signature) {
+		return ErrSignatureInvalid
+	}
+	return nil
+}
+
+// CanonicalTransactionPayload builds the deterministic byte string a
+// device must sign to authorize in: every field that determines what the
+// transaction does, plus its idempotency key so a captured signature
+// can't be replayed against a different logical operation.
+func CanonicalTransactionPayload(in TransactionInput) []byte {
+	fields := []string{
+		in.TenantID,
+		in.AccountID,
+		in.CounterpartyAccountID,
+		string(in.Type),
+		strconv.FormatInt(in.Amount, 10),
+		in.IdempotencyKey,
+	}
+	return []byte(strings.Join(fields, "|"))
+}
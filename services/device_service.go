@@ -0,0 +1,118 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// ErrChallengeNotVerifiable is returned when a device challenge has already
+// been verified, has expired, or does not exist.
+var ErrChallengeNotVerifiable = errors.New("device challenge is not verifiable")
+
+// ErrChallengeCodeMismatch is returned when the submitted code doesn't
+// match the pending challenge.
+var ErrChallengeCodeMismatch = errors.New("device challenge code does not match")
+
+// deviceChallengeTTL is how long a step-up code remains valid.
+const deviceChallengeTTL = 10 * time.Minute
+
+// DeviceService tracks trusted devices per user and issues OTP step-up
+// challenges for devices that haven't been seen before.
+type DeviceService struct {
+	devices    *repository.TrustedDeviceRepository
+	challenges *repository.DeviceChallengeRepository
+	otp        OTPProvider
+}
+
+// NewDeviceService builds a DeviceService.
+func NewDeviceService(devices *repository.TrustedDeviceRepository, challenges *repository.DeviceChallengeRepository, otp OTPProvider) *DeviceService {
+	return &DeviceService{devices: devices, challenges: challenges, otp: otp}
+}
+
+// IsTrusted reports whether deviceID has already passed a step-up
+// challenge for userID. On a trusted hit it bumps the device's last-seen
+// timestamp.
+func (s *DeviceService) IsTrusted(tenantID, userID, deviceID string) (bool, error) {
+	device, err := s.devices.FindByUserAndDevice(tenantID, userID, deviceID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	_ = s.devices.Touch(tenantID, device.ID)
+	return true, nil
+}
+
+// Challenge issues a new OTP challenge for an unseen device and delivers
+// the code to the user via the configured OTPProvider. fingerprint is
+// the client-computed device fingerprint, if the caller sent one; it's
+// carried through to the TrustedDevice record Verify creates.
+func (s *DeviceService) Challenge(tenantID string, user *models.User, deviceID, fingerprint string) (*models.DeviceChallenge, error) {
+	challenge := &models.DeviceChallenge{
+		ID:          newID(),
+		TenantID:    tenantID,
+		UserID:      user.ID,
+		DeviceID:    deviceID,
+		Fingerprint: fingerprint,
+		Code:        generateNumericCode(),
+		Status:      models.DeviceChallengePending,
+		ExpiresAt:   time.Now().Add(deviceChallengeTTL),
+	}
+	if err := s.challenges.Create(challenge); err != nil {
+		return nil, err
+	}
+	if err := s.otp.Send(user.Phone, challenge.Code); err != nil {
+		return nil, err
+	}
+	return challenge, nil
+}
+
+// Verify checks the submitted code against a pending challenge and, on
+// success, adds the challenge's device to the user's trusted-devices list.
+func (s *DeviceService) Verify(tenantID, challengeID, code string) error {
+	challenge, err := s.challenges.FindByID(tenantID, challengeID)
+	if err != nil {
+		return ErrChallengeNotVerifiable
+	}
+	if challenge.Status != models.DeviceChallengePending || time.Now().After(challenge.ExpiresAt) {
+		return ErrChallengeNotVerifiable
+	}
+	if challenge.Code != code {
+		return ErrChallengeCodeMismatch
+	}
+
+	if err := s.challenges.MarkStatus(tenantID, challenge.ID, models.DeviceChallengeVerified); err != nil {
+		return err
+	}
+
+	return s.devices.Create(&models.TrustedDevice{
+		ID:          newID(),
+		TenantID:    tenantID,
+		UserID:      challenge.UserID,
+		DeviceID:    challenge.DeviceID,
+		Fingerprint: challenge.Fingerprint,
+		LastSeenAt:  time.Now(),
+	})
+}
+
+// ListTrusted returns every device trusted for userID.
+func (s *DeviceService) ListTrusted(tenantID, userID string) ([]models.TrustedDevice, error) {
+	return s.devices.ListByUser(tenantID, userID)
+}
+
+// Revoke removes a device from userID's trusted-devices list.
+func (s *DeviceService) Revoke(tenantID, userID, id string) error {
+	return s.devices.Delete(tenantID, userID, id)
+}
+
+// SetPushToken records userID's device id's current push-notification
+// token.
+func (s *DeviceService) SetPushToken(tenantID, userID, id, token string) error {
+	return s.devices.UpdatePushToken(tenantID, userID, id, token)
+}
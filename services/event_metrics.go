@@ -0,0 +1,134 @@
+package services
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// eventOutcome enumerates how a single publishEvent delivery ended, for
+// EventMetrics counters.
+type eventOutcome string
+
+const (
+	eventOutcomeProcessed    eventOutcome = "processed"
+	eventOutcomeFailed       eventOutcome = "failed"
+	eventOutcomeRetried      eventOutcome = "retried"
+	eventOutcomeDeadLettered eventOutcome = "dead_lettered"
+)
+
+// eventLatencyBuckets defines the end-to-end (enqueue-to-commit) latency
+// histogram: each entry's label counts publishes at or under upperBoundMs,
+// and a final "gte_<n>ms" bucket catches everything past the last bound.
+var eventLatencyBuckets = []struct {
+	label        string
+	upperBoundMs int64
+}{
+	{"lt_100ms", 100},
+	{"lt_500ms", 500},
+	{"lt_1000ms", 1000},
+	{"lt_5000ms", 5000},
+}
+
+// eventLatencyOverflowLabel names the bucket for publishes slower than the
+// last eventLatencyBuckets bound.
+const eventLatencyOverflowLabel = "gte_5000ms"
+
+// EventMetrics tracks TransactionService's event-publish throughput and
+// outcomes: counters by event type, a coarse end-to-end latency histogram,
+// and how many publishes are currently in flight. It has no external
+// dependency, so it can be read straight off an HTTP endpoint without
+// wiring in a metrics client.
+type EventMetrics struct {
+	mu       sync.Mutex
+	counts   map[eventOutcome]map[string]int64
+	latency  []int64 // parallel to eventLatencyBuckets, plus one overflow entry
+	inFlight int64
+}
+
+// NewEventMetrics builds an empty EventMetrics.
+func NewEventMetrics() *EventMetrics {
+	return &EventMetrics{
+		counts: map[eventOutcome]map[string]int64{
+			eventOutcomeProcessed:    {},
+			eventOutcomeFailed:       {},
+			eventOutcomeRetried:      {},
+			eventOutcomeDeadLettered: {},
+		},
+		latency: make([]int64, len(eventLatencyBuckets)+1),
+	}
+}
+
+// StartPublish marks a publish as in flight and returns a func to call
+// once it settles (successfully, retried out, or dead-lettered), which
+// records its elapsed time against the latency histogram.
+func (m *EventMetrics) StartPublish() func() {
+	atomic.AddInt64(&m.inFlight, 1)
+	start := time.Now()
+	return func() {
+		atomic.AddInt64(&m.inFlight, -1)
+		m.recordLatency(time.Since(start))
+	}
+}
+
+func (m *EventMetrics) recordLatency(d time.Duration) {
+	ms := d.Milliseconds()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i, bucket := range eventLatencyBuckets {
+		if ms <= bucket.upperBoundMs {
+			m.latency[i]++
+			return
+		}
+	}
+	m.latency[len(m.latency)-1]++
+}
+
+// Record increments outcome's counter for eventType.
+func (m *EventMetrics) Record(outcome eventOutcome, eventType string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.counts[outcome][eventType]++
+}
+
+// EventMetricsSnapshot is a point-in-time, JSON-serializable copy of every
+// EventMetrics counter.
+type EventMetricsSnapshot struct {
+	Processed        map[string]int64 `json:"processed"`
+	Failed           map[string]int64 `json:"failed"`
+	Retried          map[string]int64 `json:"retried"`
+	DeadLettered     map[string]int64 `json:"dead_lettered"`
+	LatencyMsBuckets map[string]int64 `json:"latency_ms_buckets"`
+	InFlight         int64            `json:"in_flight"`
+}
+
+// Snapshot returns a copy of every counter, safe to serve concurrently
+// with further Record/StartPublish calls.
+func (m *EventMetrics) Snapshot() EventMetricsSnapshot {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	snapshot := EventMetricsSnapshot{
+		Processed:        copyEventCounts(m.counts[eventOutcomeProcessed]),
+		Failed:           copyEventCounts(m.counts[eventOutcomeFailed]),
+		Retried:          copyEventCounts(m.counts[eventOutcomeRetried]),
+		DeadLettered:     copyEventCounts(m.counts[eventOutcomeDeadLettered]),
+		LatencyMsBuckets: make(map[string]int64, len(m.latency)),
+		InFlight:         atomic.LoadInt64(&m.inFlight),
+	}
+	for i, bucket := range eventLatencyBuckets {
+		snapshot.LatencyMsBuckets[bucket.label] = m.latency[i]
+	}
+	snapshot.LatencyMsBuckets[eventLatencyOverflowLabel] = m.latency[len(m.latency)-1]
+
+	return snapshot
+}
+
+func copyEventCounts(counts map[string]int64) map[string]int64 {
+	out := make(map[string]int64, len(counts))
+	for k, v := range counts {
+		out[k] = v
+	}
+	return out
+}
@@ -0,0 +1,22 @@
+package services
+
+import "log"
+
+// EventPublisher delivers domain events to whatever downstream system
+// reacts to them, such as a webhook fanout or message queue, so consumers
+// can react without polling. correlationID, when set, is carried in the
+// message envelope so a consumer's log line can be traced back to the
+// request that triggered it; pass "" when no correlation ID applies.
+type EventPublisher interface {
+	Publish(eventType, correlationID string, payload interface{}) error
+}
+
+// NoopEventPublisher logs events instead of delivering them, for
+// environments without a message broker configured.
+type NoopEventPublisher struct{}
+
+// Publish logs eventType, correlationID, and payload and always succeeds.
+func (NoopEventPublisher) Publish(eventType, correlationID string, payload interface{}) error {
+	log.Printf("event %s (correlation_id=%s, noop publisher, not actually delivered): %+v", eventType, correlationID, payload)
+	return nil
+}
@@ -0,0 +1,66 @@
+package services
+
+import (
+	"context"
+	"log"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// EventReplayService re-emits historical transaction events to the event
+// publisher and to matching webhook subscriptions, for integrators who
+// lost events during their own outage rather than TransactionService's.
+type EventReplayService struct {
+	logs     *repository.TransactionLogRepository
+	events   EventPublisher
+	webhooks *AccountWebhookService
+}
+
+// NewEventReplayService builds an EventReplayService.
+func NewEventReplayService(logs *repository.TransactionLogRepository, events EventPublisher, webhooks *AccountWebhookService) *EventReplayService {
+	return &EventReplayService{logs: logs, events: events, webhooks: webhooks}
+}
+
+// Replay re-emits every transaction log entry matching filter: once to
+// the event publisher, under the same transaction.<type>.completed event
+// type posting originally used, and once to any webhook subscription on
+// the entry's account whose filter matches. It returns the number of
+// entries replayed. A single entry's delivery failure is logged and
+// skipped rather than aborting the rest of the replay, matching how
+// these failures are already handled the first time an event is
+// published.
+func (s *EventReplayService) Replay(ctx context.Context, filter repository.TransactionLogFilter) (int, error) {
+	cursor, err := s.logs.Export(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+	defer cursor.Close(ctx)
+
+	replayed := 0
+	for cursor.Next(ctx) {
+		var entry models.TransactionLog
+		if err := cursor.Decode(&entry); err != nil {
+			log.Printf("event replay: failed to decode transaction log entry, skipping: %v", err)
+			continue
+		}
+
+		if s.events != nil {
+			eventType := transactionEventType(entry.Type, "completed")
+			if err := s.events.Publish(eventType, entry.CorrelationID, entry); err != nil {
+				log.Printf("event replay: publish failed for entry %s: %v", entry.ID, err)
+			}
+		}
+
+		if s.webhooks != nil {
+			eventType := models.AccountWebhookEventCredit
+			if entry.Direction == models.TransactionLogDirectionDebit {
+				eventType = models.AccountWebhookEventDebit
+			}
+			s.webhooks.Replay(entry.TenantID, entry.AccountID, entry.ID, entry.Amount, eventType)
+		}
+
+		replayed++
+	}
+	return replayed, nil
+}
@@ -0,0 +1,101 @@
+package services
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// ErrExposureCeilingExceeded is returned when crediting a user would push
+// their aggregate system exposure over the ceiling their KYC level
+// allows.
+var ErrExposureCeilingExceeded = errors.New("exposure ceiling exceeded for this identity's KYC level")
+
+// ExposureService enforces a per-KYC-level ceiling on a user's aggregate
+// system exposure: the sum of their balances across every account they
+// hold, plus amounts already queued behind a pending maker-checker
+// approval, so a wallet regulation's per-tier caps hold even though a
+// user can spread funds across several accounts.
+type ExposureService struct {
+	accounts *repository.AccountRepository
+	pending  *repository.PendingApprovalRepository
+	limits   *repository.ExposureLimitRepository
+}
+
+// NewExposureService builds an ExposureService.
+func NewExposureService(accounts *repository.AccountRepository, pending *repository.PendingApprovalRepository, limits *repository.ExposureLimitRepository) *ExposureService {
+	return &ExposureService{accounts: accounts, pending: pending, limits: limits}
+}
+
+// Get returns the exposure ceiling configured for kycLevel, or a
+// zero-value, unlimited ceiling if none has been set yet.
+func (s *ExposureService) Get(tenantID string, kycLevel int) (*models.ExposureLimit, error) {
+	limit, err := s.limits.FindByKYCLevel(tenantID, kycLevel)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &models.ExposureLimit{TenantID: tenantID, KYCLevel: kycLevel}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return limit, nil
+}
+
+// Set creates or replaces the exposure ceiling configured for kycLevel.
+// A ceiling of zero disables the check for that level.
+func (s *ExposureService) Set(tenantID string, kycLevel int, ceiling int64) (*models.ExposureLimit, error) {
+	limit := &models.ExposureLimit{
+		ID:       newID(),
+		TenantID: tenantID,
+		KYCLevel: kycLevel,
+		Ceiling:  ceiling,
+	}
+	if err := s.limits.Upsert(limit); err != nil {
+		return nil, err
+	}
+	return limit, nil
+}
+
+// CheckCredit reports ErrExposureCeilingExceeded if crediting user with
+// incoming would push their aggregate exposure over the ceiling their
+// KYC level allows. It's a no-op if no ceiling is configured for that
+// level.
+func (s *ExposureService) CheckCredit(tenantID string, user *models.User, incoming int64) error {
+	limit, err := s.limits.FindByKYCLevel(tenantID, user.KYCLevel)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if limit.Ceiling <= 0 {
+		return nil
+	}
+
+	accounts, err := s.accounts.ListByUser(tenantID, user.ID)
+	if err != nil {
+		return err
+	}
+
+	exposure := incoming
+	for _, account := range accounts {
+		exposure += account.Balance
+
+		pending, err := s.pending.ListPendingByAccount(tenantID, account.ID)
+		if err != nil {
+			return err
+		}
+		for _, approval := range pending {
+			if approval.Type == models.TransactionTypeDeposit || approval.CounterpartyAccountID == account.ID {
+				exposure += approval.Amount
+			}
+		}
+	}
+
+	if exposure > limit.Ceiling {
+		return ErrExposureCeilingExceeded
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package services
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// FeeService lets admins configure a per-transaction-type fee schedule and
+// computes the fee owed on a transaction under it.
+type FeeService struct {
+	schedules *repository.FeeScheduleRepository
+}
+
+// NewFeeService builds a FeeService.
+func NewFeeService(schedules *repository.FeeScheduleRepository) *FeeService {
+	return &FeeService{schedules: schedules}
+}
+
+// Get returns the fee schedule configured for txType, or a zero-value,
+// fee-free schedule if none has been set yet.
+func (s *FeeService) Get(tenantID string, txType models.TransactionType) (*models.FeeSchedule, error) {
+	schedule, err := s.schedules.FindByType(tenantID, txType)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &models.FeeSchedule{TenantID: tenantID, Type: txType}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+// Set creates or replaces the fee schedule configured for txType.
+func (s *FeeService) Set(tenantID string, txType models.TransactionType, flatFee int64, basisPoints int) (*models.FeeSchedule, error) {
+	schedule := &models.FeeSchedule{
+		ID:          newID(),
+		TenantID:    tenantID,
+		Type:        txType,
+		FlatFee:     flatFee,
+		BasisPoints: basisPoints,
+	}
+	if err := s.schedules.Upsert(schedule); err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+// Calculate returns the fee owed on a transaction of txType and amount.
+// A transaction type with no schedule configured is fee-free.
+func (s *FeeService) Calculate(tenantID string, txType models.TransactionType, amount int64) (int64, error) {
+	schedule, err := s.Get(tenantID, txType)
+	if err != nil {
+		return 0, err
+	}
+	return schedule.Calculate(amount), nil
+}
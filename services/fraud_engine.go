@@ -0,0 +1,125 @@
+package services
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// Velocity thresholds used by the fraud rules engine. These are fixed for
+// now; a later request can move them into per-tenant Limit rows if they
+// need to be configurable.
+const (
+	velocityTransferWindow    = 10 * time.Minute
+	velocityTransferMaxCount  = 5
+	largeAmountNewBeneficiary = 100000 // minor units
+	dailyWithdrawalWindow     = 24 * time.Hour
+	dailyWithdrawalCeiling    = 500000 // minor units
+
+	impossibleTravelWindow = 1 * time.Hour
+
+	riskScoreHighRiskGeo      = 50
+	riskScoreImpossibleTravel = 40
+	riskScoreReviewThreshold  = 50
+)
+
+// FraudEngine evaluates a transaction against a fixed set of velocity,
+// pattern, and geo/IP risk rules before it posts, producing a decision of
+// allow, review, or block plus a 0-100 risk score.
+type FraudEngine struct {
+	transactions *repository.TransactionRepository
+	geo          GeoProvider
+}
+
+// NewFraudEngine builds a FraudEngine backed by transactions and geo.
+func NewFraudEngine(transactions *repository.TransactionRepository, geo GeoProvider) *FraudEngine {
+	return &FraudEngine{transactions: transactions, geo: geo}
+}
+
+// WithTx returns a copy of the engine reading through dbtx, so a caller
+// evaluating rules inside its own DB transaction sees a consistent view.
+func (e *FraudEngine) WithTx(dbtx *gorm.DB) *FraudEngine {
+	return &FraudEngine{transactions: e.transactions.WithTx(dbtx), geo: e.geo}
+}
+
+// Evaluate runs every rule against in and returns the strictest decision,
+// the rule that produced it, a human-readable reason, and a 0-100 risk
+// score. A clean pass returns (FraudDecisionAllow, "", "", 0, nil).
+func (e *FraudEngine) Evaluate(in TransactionInput) (decision models.FraudDecision, rule string, reason string, score int, err error) {
+	decision, rule, reason, score = e.evaluateGeoRisk(in)
+	if decision == models.FraudDecisionBlock {
+		return
+	}
+
+	switch in.Type {
+	case models.TransactionTypeTransfer:
+		count, err := e.transactions.CountByAccountSince(in.TenantID, in.AccountID, models.TransactionTypeTransfer, time.Now().Add(-velocityTransferWindow))
+		if err != nil {
+			return "", "", "", 0, err
+		}
+		if count >= velocityTransferMaxCount {
+			return models.FraudDecisionBlock, "velocity_transfer_count", "too many transfers in a short window", 100, nil
+		}
+
+		if in.Amount >= largeAmountNewBeneficiary {
+			seen, err := e.transactions.HasPriorTransferTo(in.TenantID, in.AccountID, in.CounterpartyAccountID)
+			if err != nil {
+				return "", "", "", 0, err
+			}
+			if !seen {
+				return models.FraudDecisionReview, "new_beneficiary_large_amount", "large transfer to a first-time beneficiary", max(score, 60), nil
+			}
+		}
+
+	case models.TransactionTypeWithdrawal:
+		sum, err := e.transactions.SumAmountByAccountSince(in.TenantID, in.AccountID, models.TransactionTypeWithdrawal, time.Now().Add(-dailyWithdrawalWindow))
+		if err != nil {
+			return "", "", "", 0, err
+		}
+		if sum+in.Amount > dailyWithdrawalCeiling {
+			return models.FraudDecisionReview, "daily_withdrawal_ceiling", "sum of withdrawals today exceeds the daily ceiling", max(score, 60), nil
+		}
+	}
+
+	return decision, rule, reason, score, nil
+}
+
+// evaluateGeoRisk scores the client IP on its own: a high-risk range adds
+// to the score directly, and a country change from the account's last
+// transaction within impossibleTravelWindow flags impossible travel.
+// Neither condition alone blocks a transaction, only routes it to review.
+func (e *FraudEngine) evaluateGeoRisk(in TransactionInput) (models.FraudDecision, string, string, int) {
+	if e.geo == nil || in.ClientIP == "" {
+		return models.FraudDecisionAllow, "", "", 0
+	}
+
+	geo, err := e.geo.Resolve(in.ClientIP)
+	if err != nil {
+		return models.FraudDecisionAllow, "", "", 0
+	}
+
+	score := 0
+	if geo.HighRisk {
+		score += riskScoreHighRiskGeo
+	}
+
+	if last, err := e.transactions.LastByAccount(in.TenantID, in.AccountID); err == nil && last.ClientIP != "" {
+		if lastGeo, err := e.geo.Resolve(last.ClientIP); err == nil &&
+			lastGeo.Country != "" && geo.Country != "" && lastGeo.Country != geo.Country &&
+			time.Since(last.CreatedAt) < impossibleTravelWindow {
+			score += riskScoreImpossibleTravel
+			if score >= riskScoreReviewThreshold {
+				return models.FraudDecisionReview, "impossible_travel", "client country changed too quickly to be a real trip", score
+			}
+		}
+	}
+
+	if score >= riskScoreReviewThreshold {
+		return models.FraudDecisionReview, "high_risk_geo", "client IP resolves to a high-risk range", score
+	}
+
+	return models.FraudDecisionAllow, "", "", score
+}
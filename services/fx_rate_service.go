@@ -0,0 +1,53 @@
+package services
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// ErrFXRateNotConfigured is returned when a currency pair has no
+// configured rate, so it can't be quoted for a cross-currency transfer.
+var ErrFXRateNotConfigured = errors.New("no fx rate configured for this currency pair")
+
+// FXRateService lets admins configure a per-currency-pair conversion
+// rate and looks it up for quoting.
+type FXRateService struct {
+	rates *repository.FXRateRepository
+}
+
+// NewFXRateService builds an FXRateService.
+func NewFXRateService(rates *repository.FXRateRepository) *FXRateService {
+	return &FXRateService{rates: rates}
+}
+
+// Get returns the configured rate for a currency pair, or
+// ErrFXRateNotConfigured if none has been set.
+func (s *FXRateService) Get(tenantID, baseCurrency, quoteCurrency string) (*models.FXRate, error) {
+	rate, err := s.rates.FindByPair(tenantID, baseCurrency, quoteCurrency)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrFXRateNotConfigured
+	}
+	if err != nil {
+		return nil, err
+	}
+	return rate, nil
+}
+
+// Set creates or replaces the configured rate for a currency pair.
+func (s *FXRateService) Set(tenantID, baseCurrency, quoteCurrency string, rateValue float64) (*models.FXRate, error) {
+	rate := &models.FXRate{
+		ID:            newID(),
+		TenantID:      tenantID,
+		BaseCurrency:  baseCurrency,
+		QuoteCurrency: quoteCurrency,
+		Rate:          rateValue,
+	}
+	if err := s.rates.Upsert(rate); err != nil {
+		return nil, err
+	}
+	return rate, nil
+}
@@ -0,0 +1,25 @@
+package services
+
+// GeoInfo is the resolved location and network context for a client IP.
+type GeoInfo struct {
+	Country  string
+	ASN      string
+	HighRisk bool
+}
+
+// GeoProvider abstracts the IP-to-geo/ASN lookup so it can be swapped for a
+// real MaxMind/IPinfo-backed implementation later or mocked in tests.
+type GeoProvider interface {
+	// Resolve looks up geo/ASN information for a client IP.
+	Resolve(ip string) (GeoInfo, error)
+}
+
+// NoopGeoProvider is a placeholder provider used until a real geo/ASN
+// database is configured. It reports every IP as low-risk and of unknown
+// origin.
+type NoopGeoProvider struct{}
+
+// Resolve implements GeoProvider.
+func (NoopGeoProvider) Resolve(ip string) (GeoInfo, error) {
+	return GeoInfo{Country: "", ASN: "", HighRisk: false}, nil
+}
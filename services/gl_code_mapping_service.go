@@ -0,0 +1,48 @@
+package services
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// GLCodeMappingService lets admins configure the external ERP account
+// code a GL account type exports under.
+type GLCodeMappingService struct {
+	mappings *repository.GLCodeMappingRepository
+}
+
+// NewGLCodeMappingService builds a GLCodeMappingService.
+func NewGLCodeMappingService(mappings *repository.GLCodeMappingRepository) *GLCodeMappingService {
+	return &GLCodeMappingService{mappings: mappings}
+}
+
+// Get returns the external code mapped for glType, or glType's own string
+// value if the tenant hasn't configured one.
+func (s *GLCodeMappingService) Get(tenantID string, glType models.GLAccountType) (*models.GLCodeMapping, error) {
+	mapping, err := s.mappings.FindByType(tenantID, glType)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &models.GLCodeMapping{TenantID: tenantID, Type: glType, ExternalCode: string(glType)}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return mapping, nil
+}
+
+// Set creates or replaces the external code mapped for glType.
+func (s *GLCodeMappingService) Set(tenantID string, glType models.GLAccountType, externalCode string) (*models.GLCodeMapping, error) {
+	mapping := &models.GLCodeMapping{
+		ID:           newID(),
+		TenantID:     tenantID,
+		Type:         glType,
+		ExternalCode: externalCode,
+	}
+	if err := s.mappings.Upsert(mapping); err != nil {
+		return nil, err
+	}
+	return mapping, nil
+}
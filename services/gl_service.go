@@ -0,0 +1,93 @@
+package services
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// GLService posts double-entry legs against internal general-ledger
+// accounts, so money the bank itself earns, owes, or pays out to an
+// external party always has a proper counterparty instead of appearing
+// from, or vanishing to, nowhere.
+type GLService struct {
+	db       *gorm.DB
+	accounts *repository.GLAccountRepository
+	entries  *repository.GLEntryRepository
+}
+
+// NewGLService builds a GLService.
+func NewGLService(db *gorm.DB, accounts *repository.GLAccountRepository, entries *repository.GLEntryRepository) *GLService {
+	return &GLService{db: db, accounts: accounts, entries: entries}
+}
+
+// Post records direction's effect of amount against tenantID's GL account
+// of glType and currency, and the GLEntry documenting it, both within
+// dbtx. Callers run this inside the same database transaction as the
+// customer-side posting it offsets, so the two legs commit or roll back
+// together. transactionID and reasonCode are carried onto the entry for
+// reconciliation, and may be empty.
+func (s *GLService) Post(dbtx *gorm.DB, tenantID string, glType models.GLAccountType, currency string, direction models.GLEntryDirection, amount int64, transactionID, reasonCode string) error {
+	accounts := s.accounts.WithTx(dbtx)
+	account, err := accounts.GetOrCreateForUpdate(tenantID, glType, currency, newID())
+	if err != nil {
+		return err
+	}
+
+	switch direction {
+	case models.GLEntryCredit:
+		account.Balance += amount
+	case models.GLEntryDebit:
+		account.Balance -= amount
+	}
+	if err := accounts.UpdateBalance(account.ID, account.Balance); err != nil {
+		return err
+	}
+
+	entry := &models.GLEntry{
+		ID:            newID(),
+		TenantID:      tenantID,
+		GLAccountID:   account.ID,
+		Direction:     direction,
+		Amount:        amount,
+		BalanceAfter:  account.Balance,
+		TransactionID: transactionID,
+		ReasonCode:    reasonCode,
+	}
+	return s.entries.WithTx(dbtx).Create(entry)
+}
+
+// PostStandalone posts a single GL leg outside of any existing database
+// transaction, for a posting with no customer-side Transaction row to
+// pair with, such as an inbound credit that couldn't be matched to any
+// account, or its later return to the sender.
+func (s *GLService) PostStandalone(tenantID string, glType models.GLAccountType, currency string, direction models.GLEntryDirection, amount int64, reasonCode string) error {
+	return s.db.Transaction(func(dbtx *gorm.DB) error {
+		return s.Post(dbtx, tenantID, glType, currency, direction, amount, "", reasonCode)
+	})
+}
+
+// ChartOfAccounts returns tenantID's internal GL accounts, for finance to
+// inspect fee income, interest expense, suspense, and settlement balances
+// without querying Postgres directly.
+func (s *GLService) ChartOfAccounts(tenantID string) ([]models.GLAccount, error) {
+	return s.accounts.ListByTenant(tenantID)
+}
+
+// Transfer moves amount from one internal GL account to another,
+// atomically, for postings that are entirely internal to the bank (e.g.
+// the fee a merchant settlement withholds) rather than offsetting a
+// customer-facing Transaction. It opens its own database transaction, so
+// it's safe to call outside of one.
+func (s *GLService) Transfer(tenantID string, from, to models.GLAccountType, currency string, amount int64, reasonCode string) error {
+	if amount == 0 {
+		return nil
+	}
+	return s.db.Transaction(func(dbtx *gorm.DB) error {
+		if err := s.Post(dbtx, tenantID, from, currency, models.GLEntryDebit, amount, "", reasonCode); err != nil {
+			return err
+		}
+		return s.Post(dbtx, tenantID, to, currency, models.GLEntryCredit, amount, "", reasonCode)
+	})
+}
@@ -0,0 +1,106 @@
+package services
+
+import (
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// holdExpirySweepSize is how many expired holds a single Sweep releases.
+const holdExpirySweepSize = 50
+
+// HoldExpiryService releases card authorization holds that outlived
+// cardAuthorizationHoldTTL without ever being captured or reversed by
+// the switch, so a merchant authorization that never resolves doesn't
+// lock a customer's funds indefinitely.
+type HoldExpiryService struct {
+	db             *gorm.DB
+	authorizations *repository.CardAuthorizationRepository
+	accounts       *repository.AccountRepository
+	notifications  NotificationProvider
+	metrics        *HoldAgingMetrics
+}
+
+// NewHoldExpiryService builds a HoldExpiryService.
+func NewHoldExpiryService(db *gorm.DB, authorizations *repository.CardAuthorizationRepository, accounts *repository.AccountRepository, notifications NotificationProvider, metrics *HoldAgingMetrics) *HoldExpiryService {
+	return &HoldExpiryService{db: db, authorizations: authorizations, accounts: accounts, notifications: notifications, metrics: metrics}
+}
+
+// Sweep releases a batch of expired pending holds. It returns the number
+// released.
+func (s *HoldExpiryService) Sweep() (int, error) {
+	expired, err := s.authorizations.FindExpiredPending(time.Now(), holdExpirySweepSize)
+	if err != nil {
+		return 0, err
+	}
+
+	released := 0
+	for _, auth := range expired {
+		if err := s.release(auth); err != nil {
+			log.Printf("hold expiry: failed to release authorization %s: %v", auth.ID, err)
+			continue
+		}
+		released++
+	}
+	return released, nil
+}
+
+// release drops auth's hold on its account, marks it reversed, notifies
+// the account owner, and records its age against HoldAgingMetrics. If
+// auth was already reversed or captured by the time this runs -- e.g. an
+// overlapping sweep on another instance claimed it first -- release is a
+// no-op: the account's held amount is left untouched so the hold isn't
+// released twice.
+func (s *HoldExpiryService) release(auth models.CardAuthorization) error {
+	claimed := false
+	err := s.db.Transaction(func(dbtx *gorm.DB) error {
+		accounts := s.accounts.WithTx(dbtx)
+		authorizations := s.authorizations.WithTx(dbtx)
+
+		// Claim the authorization before touching the account: MarkReversed's
+		// status = pending guard means only one of two overlapping sweeps
+		// of the same authorization can win, so only one of them decrements
+		// HeldAmount.
+		rowsAffected, err := authorizations.MarkReversed(auth.TenantID, auth.ID)
+		if err != nil {
+			return err
+		}
+		if rowsAffected == 0 {
+			return nil
+		}
+		claimed = true
+
+		account, err := accounts.FindByIDForUpdate(auth.TenantID, auth.AccountID)
+		if err != nil {
+			return err
+		}
+		return accounts.UpdateHeldAmount(auth.TenantID, account.ID, account.HeldAmount-auth.Amount)
+	})
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		return nil
+	}
+
+	if s.metrics != nil {
+		s.metrics.RecordExpiry(time.Since(auth.CreatedAt))
+	}
+
+	if s.notifications != nil {
+		account, err := s.accounts.FindByID(auth.TenantID, auth.AccountID)
+		if err != nil {
+			log.Printf("hold expiry: failed to look up account %s for expiry notification: %v", auth.AccountID, err)
+			return nil
+		}
+		if err := s.notifications.Notify(account.UserID, "A pending card authorization hold on your account has expired and been released."); err != nil {
+			log.Printf("hold expiry: failed to notify user %s of hold release: %v", account.UserID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package services
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// TestHoldExpiryService_OverlappingSweepsReleaseHoldOnce runs two
+// overlapping sweeps of the same expired authorization concurrently and
+// asserts the account's held amount is only decremented once. Before
+// MarkReversed was guarded by status = pending, both sweeps could read
+// the authorization as pending, both decrement HeldAmount, and both mark
+// it reversed, releasing the hold twice.
+func TestHoldExpiryService_OverlappingSweepsReleaseHoldOnce(t *testing.T) {
+	db := testDB(t)
+
+	const tenantID = "33333333-3333-3333-3333-333333333333"
+	const holdAmount = int64(500)
+
+	accounts := repository.NewAccountRepository(db)
+	account := &models.Account{ID: newID(), TenantID: tenantID, UserID: newID(), Currency: "USD", Balance: 10_000, HeldAmount: holdAmount}
+	if err := accounts.Create(account); err != nil {
+		t.Fatalf("create account: %v", err)
+	}
+
+	authRepo := repository.NewCardAuthorizationRepository(db)
+	auth := &models.CardAuthorization{
+		ID:        newID(),
+		TenantID:  tenantID,
+		AccountID: account.ID,
+		RRN:       "123456",
+		Amount:    holdAmount,
+		Currency:  "USD",
+		Status:    models.CardAuthorizationPending,
+		ExpiresAt: time.Now().Add(-time.Minute),
+	}
+	if err := authRepo.Create(auth); err != nil {
+		t.Fatalf("create authorization: %v", err)
+	}
+
+	svc := NewHoldExpiryService(db, authRepo, accounts, nil, nil)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := svc.Sweep(); err != nil {
+				t.Errorf("sweep: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	after, err := accounts.FindByID(tenantID, account.ID)
+	if err != nil {
+		t.Fatalf("find account: %v", err)
+	}
+	if after.HeldAmount != 0 {
+		t.Fatalf("held amount = %d, want 0 (released exactly once from %d)", after.HeldAmount, holdAmount)
+	}
+}
@@ -0,0 +1,9 @@
+package services
+
+import "github.com/divzzrk/go_bank_api/ids"
+
+// newID generates a new unique identifier for records created by this
+// package.
+func newID() string {
+	return ids.New()
+}
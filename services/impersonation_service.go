@@ -0,0 +1,136 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// impersonationSessionTTL is the hard lifetime of a support impersonation
+// session. It cannot be extended; a support admin who needs more time must
+// start a new session.
+const impersonationSessionTTL = 15 * time.Minute
+
+// ErrImpersonationTargetInvalid is returned when the requested customer
+// cannot be impersonated, e.g. because they aren't a customer.
+var ErrImpersonationTargetInvalid = errors.New("target user is not an impersonatable customer")
+
+// ErrImpersonationSessionInactive is returned when a session has expired,
+// been ended, or does not belong to the calling admin.
+var ErrImpersonationSessionInactive = errors.New("impersonation session is not active")
+
+// ImpersonationService starts, validates, and audits support-admin
+// impersonation sessions. Every session is read-mostly and time-boxed; it
+// is enforced by middleware.ImpersonationRequired restricting which routes
+// accept a session, and by RecordAction stamping both identities on every
+// action taken under it.
+type ImpersonationService struct {
+	sessions  *repository.ImpersonationSessionRepository
+	users     *repository.UserRepository
+	auditLogs *repository.AuditLogRepository
+}
+
+// NewImpersonationService builds an ImpersonationService.
+func NewImpersonationService(sessions *repository.ImpersonationSessionRepository, users *repository.UserRepository, auditLogs *repository.AuditLogRepository) *ImpersonationService {
+	return &ImpersonationService{sessions: sessions, users: users, auditLogs: auditLogs}
+}
+
+// Start opens a new impersonation session for adminUserID scoped to
+// customerUserID, expiring impersonationSessionTTL from now.
+func (s *ImpersonationService) Start(tenantID, adminUserID, customerUserID string) (*models.ImpersonationSession, error) {
+	customer, err := s.users.FindByID(tenantID, customerUserID)
+	if err != nil {
+		return nil, err
+	}
+	if customer.Role != models.RoleCustomer {
+		return nil, ErrImpersonationTargetInvalid
+	}
+
+	session := &models.ImpersonationSession{
+		ID:             newID(),
+		TenantID:       tenantID,
+		AdminUserID:    adminUserID,
+		CustomerUserID: customerUserID,
+		ExpiresAt:      time.Now().Add(impersonationSessionTTL),
+	}
+	if err := s.sessions.Create(session); err != nil {
+		return nil, err
+	}
+
+	if err := s.auditLogs.Create(&models.AuditLog{
+		ID:               newID(),
+		TenantID:         tenantID,
+		ActorUserID:      adminUserID,
+		OnBehalfOfUserID: customerUserID,
+		Action:           "impersonation_started",
+		EntityType:       "user",
+		EntityID:         customerUserID,
+		ReasonCode:       "support_impersonation",
+		Description:      "support session opened, expires " + session.ExpiresAt.Format(time.RFC3339),
+	}); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// Validate returns sessionID if it belongs to adminUserID and is still
+// active, and is the check middleware.ImpersonationRequired runs on every
+// request made under a session.
+func (s *ImpersonationService) Validate(tenantID, sessionID, adminUserID string) (*models.ImpersonationSession, error) {
+	session, err := s.sessions.FindByID(tenantID, sessionID)
+	if err != nil {
+		return nil, ErrImpersonationSessionInactive
+	}
+	if session.AdminUserID != adminUserID || !session.Active(time.Now()) {
+		return nil, ErrImpersonationSessionInactive
+	}
+	return session, nil
+}
+
+// End closes a session early, before its hard expiry.
+func (s *ImpersonationService) End(tenantID, sessionID, adminUserID string) error {
+	session, err := s.sessions.FindByID(tenantID, sessionID)
+	if err != nil {
+		return err
+	}
+	if session.AdminUserID != adminUserID {
+		return ErrImpersonationSessionInactive
+	}
+
+	now := time.Now()
+	session.EndedAt = &now
+	if err := s.sessions.End(session); err != nil {
+		return err
+	}
+
+	return s.auditLogs.Create(&models.AuditLog{
+		ID:               newID(),
+		TenantID:         tenantID,
+		ActorUserID:      adminUserID,
+		OnBehalfOfUserID: session.CustomerUserID,
+		Action:           "impersonation_ended",
+		EntityType:       "user",
+		EntityID:         session.CustomerUserID,
+		ReasonCode:       "support_impersonation",
+		Description:      "support session ended early",
+	})
+}
+
+// RecordAction stamps a read taken under an active session into the audit
+// log with both the admin's and the customer's identity, so every action
+// during a session is individually traceable.
+func (s *ImpersonationService) RecordAction(session *models.ImpersonationSession, action, entityType, entityID string) error {
+	return s.auditLogs.Create(&models.AuditLog{
+		ID:               newID(),
+		TenantID:         session.TenantID,
+		ActorUserID:      session.AdminUserID,
+		OnBehalfOfUserID: session.CustomerUserID,
+		Action:           action,
+		EntityType:       entityType,
+		EntityID:         entityID,
+		ReasonCode:       "support_impersonation",
+	})
+}
@@ -0,0 +1,142 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// compoundingPeriodDays maps a Product's CompoundingFrequency to the
+// nominal number of days one compounding period covers, used together
+// with a DayCountConvention's basis to prorate its annual
+// InterestRateBps into a per-period rate.
+var compoundingPeriodDays = map[models.CompoundingFrequency]int{
+	models.CompoundingDaily:     1,
+	models.CompoundingMonthly:   30,
+	models.CompoundingQuarterly: 91,
+	models.CompoundingAnnually:  365,
+}
+
+// dayCountBasis maps a DayCountConvention to the denominator its period
+// day count is divided by to get a fraction of a year.
+var dayCountBasis = map[models.DayCountConvention]int{
+	models.DayCountActual365: 365,
+	models.DayCountActual360: 360,
+	models.DayCount30360:     360,
+}
+
+// ErrNoProduct is returned when interest accrual is requested for an
+// account that isn't enrolled in a product, so there's no rate to accrue.
+var ErrNoProduct = errors.New("account has no product to accrue interest against")
+
+// InterestService credits accounts with interest earned under the terms of
+// their enrolled Product.
+type InterestService struct {
+	accounts     *repository.AccountRepository
+	products     *repository.ProductRepository
+	transactions *TransactionService
+	calendar     *CalendarService
+	breakdowns   *repository.InterestAccrualBreakdownRepository
+}
+
+// NewInterestService builds an InterestService. calendar may be nil, in
+// which case Accrue runs regardless of what day it's called on; when set,
+// a call on a non-business day is a no-op, since daily accrual is meant
+// to run once per business day, not once per calendar day. breakdowns may
+// be nil, in which case Accrue skips recording a per-accrual breakdown.
+func NewInterestService(accounts *repository.AccountRepository, products *repository.ProductRepository, transactions *TransactionService, calendar *CalendarService, breakdowns *repository.InterestAccrualBreakdownRepository) *InterestService {
+	return &InterestService{accounts: accounts, products: products, transactions: transactions, calendar: calendar, breakdowns: breakdowns}
+}
+
+// Accrue computes one compounding period's interest on accountID's current
+// balance, under the terms of the Product it's enrolled in, and posts it
+// as an adjustment credit. The per-period rate is InterestRateBps prorated
+// by the period's nominal day count (from CompoundingFrequency) over the
+// Product's DayCountConvention basis, and the resulting fractional
+// minor-unit amount is rounded before posting since balances are always
+// whole minor units. If breakdowns is set, the calculation's inputs and
+// intermediate values are recorded against the posted transaction for
+// later dispute resolution. It returns nil, nil if the accrued amount
+// rounds to zero.
+func (s *InterestService) Accrue(ctx context.Context, tenantID, accountID string) (*models.Transaction, error) {
+	if s.calendar != nil {
+		ok, err := s.calendar.IsBusinessDay(tenantID, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, nil
+		}
+	}
+
+	account, err := s.accounts.FindByID(tenantID, accountID)
+	if err != nil {
+		return nil, err
+	}
+	if account.ProductID == "" {
+		return nil, ErrNoProduct
+	}
+
+	product, err := s.products.FindByID(tenantID, account.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	periodDays, ok := compoundingPeriodDays[product.CompoundingFrequency]
+	if !ok {
+		periodDays = 30
+	}
+	basis, ok := dayCountBasis[product.DayCountConvention]
+	if !ok {
+		basis = 365
+	}
+
+	periodRate := float64(product.InterestRateBps) / 10000 * float64(periodDays) / float64(basis)
+	rawAmount := float64(account.Balance) * periodRate
+	amount := models.RoundMinorUnits(rawAmount)
+	if amount == 0 {
+		return nil, nil
+	}
+
+	tx, err := s.transactions.Post(ctx, TransactionInput{
+		TenantID:        tenantID,
+		AccountID:       accountID,
+		Type:            models.TransactionTypeAdjustmentCredit,
+		Amount:          amount,
+		ReasonCode:      "interest_accrual",
+		Justification:   "scheduled interest accrual",
+		DescriptionVars: map[string]string{"Period": time.Now().Format("January")},
+		GLPosting: &GLPosting{
+			AccountType: models.GLAccountInterestExpense,
+			Direction:   models.GLEntryDebit,
+			Amount:      amount,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if s.breakdowns != nil && tx != nil {
+		if err := s.breakdowns.Create(&models.InterestAccrualBreakdown{
+			ID:                 newID(),
+			TenantID:           tenantID,
+			AccountID:          accountID,
+			ProductID:          product.ID,
+			TransactionID:      tx.ID,
+			Balance:            account.Balance,
+			InterestRateBps:    product.InterestRateBps,
+			DayCountConvention: product.DayCountConvention,
+			PeriodDays:         periodDays,
+			Basis:              basis,
+			RawAmount:          rawAmount,
+			RoundedAmount:      amount,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return tx, nil
+}
@@ -0,0 +1,95 @@
+package services
+
+import (
+	"time"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// JournalLine is one GLEntry translated for an external ERP: the same
+// double-entry leg, addressed by the tenant's configured external GL code
+// instead of this codebase's own GLAccountType.
+type JournalLine struct {
+	Date          time.Time               `json:"date"`
+	TenantID      string                  `json:"tenant_id"`
+	GLAccountType models.GLAccountType    `json:"gl_account_type"`
+	ExternalCode  string                  `json:"external_code"`
+	Currency      string                  `json:"currency"`
+	Direction     models.GLEntryDirection `json:"direction"`
+	Amount        int64                   `json:"amount"`
+	BalanceAfter  int64                   `json:"balance_after"`
+	TransactionID string                  `json:"transaction_id,omitempty"`
+	ReasonCode    string                  `json:"reason_code,omitempty"`
+}
+
+// JournalExportService maps a tenant's GL activity onto the external
+// codes finance configured for it, so it can be imported into their ERP
+// as a proper double-entry feed.
+type JournalExportService struct {
+	glAccounts *repository.GLAccountRepository
+	glEntries  *repository.GLEntryRepository
+	codes      *GLCodeMappingService
+}
+
+// NewJournalExportService builds a JournalExportService.
+func NewJournalExportService(glAccounts *repository.GLAccountRepository, glEntries *repository.GLEntryRepository, codes *GLCodeMappingService) *JournalExportService {
+	return &JournalExportService{glAccounts: glAccounts, glEntries: glEntries, codes: codes}
+}
+
+// Generate returns tenantID's journal lines for the UTC calendar day
+// containing date, oldest first, for a daily batch file import. It always
+// covers the whole day; there is no narrower window and no separate live
+// feed.
+func (s *JournalExportService) Generate(tenantID string, date time.Time) ([]JournalLine, error) {
+	from := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour).Add(-time.Nanosecond)
+
+	accounts, err := s.glAccounts.ListByTenant(tenantID)
+	if err != nil {
+		return nil, err
+	}
+	accountsByID := make(map[string]models.GLAccount, len(accounts))
+	for _, account := range accounts {
+		accountsByID[account.ID] = account
+	}
+
+	entries, err := s.glEntries.ListByTenantBetween(tenantID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	codeCache := make(map[models.GLAccountType]string)
+	lines := make([]JournalLine, 0, len(entries))
+	for _, entry := range entries {
+		account, ok := accountsByID[entry.GLAccountID]
+		if !ok {
+			continue
+		}
+
+		externalCode, cached := codeCache[account.Type]
+		if !cached {
+			mapping, err := s.codes.Get(tenantID, account.Type)
+			if err != nil {
+				return nil, err
+			}
+			externalCode = mapping.ExternalCode
+			codeCache[account.Type] = externalCode
+		}
+
+		lines = append(lines, JournalLine{
+			Date:          entry.CreatedAt,
+			TenantID:      tenantID,
+			GLAccountType: account.Type,
+			ExternalCode:  externalCode,
+			Currency:      account.Currency,
+			Direction:     entry.Direction,
+			Amount:        entry.Amount,
+			BalanceAfter:  entry.BalanceAfter,
+			TransactionID: entry.TransactionID,
+			ReasonCode:    entry.ReasonCode,
+		})
+	}
+
+	return lines, nil
+}
@@ -0,0 +1,122 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// LargeTransactionAlertService lets a user set a "notify me for any
+// transaction above X" rule and evaluates it, best-effort, against both
+// sides of every posted transaction: the account debited and, for a
+// transfer, the counterparty account credited.
+type LargeTransactionAlertService struct {
+	alerts        *repository.LargeTransactionAlertRepository
+	accounts      *repository.AccountRepository
+	notifications NotificationProvider
+}
+
+// NewLargeTransactionAlertService builds a LargeTransactionAlertService.
+func NewLargeTransactionAlertService(alerts *repository.LargeTransactionAlertRepository, accounts *repository.AccountRepository, notifications NotificationProvider) *LargeTransactionAlertService {
+	return &LargeTransactionAlertService{alerts: alerts, accounts: accounts, notifications: notifications}
+}
+
+// GetThreshold returns userID's large-transaction alert rule, or a
+// zero-value, disabled rule if none has been set yet.
+func (s *LargeTransactionAlertService) GetThreshold(tenantID, userID string) (*models.LargeTransactionAlertRule, error) {
+	rule, err := s.alerts.FindRuleForUser(tenantID, userID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &models.LargeTransactionAlertRule{TenantID: tenantID, UserID: userID}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// SetThreshold creates or replaces userID's large-transaction alert
+// threshold. A threshold of 0 disables the alert.
+func (s *LargeTransactionAlertService) SetThreshold(tenantID, userID string, threshold int64) (*models.LargeTransactionAlertRule, error) {
+	existing, err := s.alerts.FindRuleForUser(tenantID, userID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	if existing == nil {
+		rule := &models.LargeTransactionAlertRule{
+			ID:        newID(),
+			TenantID:  tenantID,
+			UserID:    userID,
+			Threshold: threshold,
+		}
+		if err := s.alerts.CreateRule(rule); err != nil {
+			return nil, err
+		}
+		return rule, nil
+	}
+
+	existing.Threshold = threshold
+	if err := s.alerts.UpdateRule(existing); err != nil {
+		return nil, err
+	}
+	return existing, nil
+}
+
+// ActivityFeed returns userID's past large-transaction alert firings,
+// most recent first.
+func (s *LargeTransactionAlertService) ActivityFeed(tenantID, userID string) ([]models.LargeTransactionAlertEvent, error) {
+	return s.alerts.ListEventsForUser(tenantID, userID)
+}
+
+// OnPosted evaluates a just-posted transaction against both the debited
+// account's owner and, for a transfer, the credited counterparty's
+// owner. Called best-effort after a transaction posts; a failure here
+// must never undo the transaction it followed.
+func (s *LargeTransactionAlertService) OnPosted(tx *models.Transaction) {
+	switch tx.Type {
+	case models.TransactionTypeDeposit, models.TransactionTypeAdjustmentCredit:
+		s.evaluate(tx.TenantID, tx.AccountID, tx.ID, tx.Amount, models.LargeTransactionAlertIncoming)
+	case models.TransactionTypeWithdrawal, models.TransactionTypeAdjustmentDebit:
+		s.evaluate(tx.TenantID, tx.AccountID, tx.ID, tx.Amount, models.LargeTransactionAlertOutgoing)
+	case models.TransactionTypeTransfer:
+		s.evaluate(tx.TenantID, tx.AccountID, tx.ID, tx.Amount, models.LargeTransactionAlertOutgoing)
+		if tx.CounterpartyAccountID != "" {
+			s.evaluate(tx.TenantID, tx.CounterpartyAccountID, tx.ID, tx.Amount, models.LargeTransactionAlertIncoming)
+		}
+	}
+}
+
+func (s *LargeTransactionAlertService) evaluate(tenantID, accountID, transactionID string, amount int64, direction models.LargeTransactionAlertDirection) {
+	account, err := s.accounts.FindByID(tenantID, accountID)
+	if err != nil {
+		return
+	}
+
+	rule, err := s.alerts.FindRuleForUser(tenantID, account.UserID)
+	if err != nil || rule.Threshold <= 0 || amount < rule.Threshold {
+		return
+	}
+
+	event := &models.LargeTransactionAlertEvent{
+		ID:            newID(),
+		TenantID:      tenantID,
+		UserID:        account.UserID,
+		AccountID:     accountID,
+		TransactionID: transactionID,
+		Amount:        amount,
+		Direction:     direction,
+	}
+	if err := s.alerts.CreateEvent(event); err != nil {
+		log.Printf("failed to record large-transaction alert event for user %s: %v", account.UserID, err)
+	}
+
+	message := fmt.Sprintf("an %s transaction of %d just posted on your account, above your alert threshold of %d", direction, amount, rule.Threshold)
+	if err := s.notifications.Notify(account.UserID, message); err != nil {
+		log.Printf("failed to notify user %s of large transaction: %v", account.UserID, err)
+	}
+}
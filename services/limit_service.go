@@ -0,0 +1,84 @@
+package services
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// LimitService lets admins view and override an account's transaction
+// limits, recording every override in the audit log.
+type LimitService struct {
+	limits    *repository.LimitRepository
+	auditLogs *repository.AuditLogRepository
+}
+
+// NewLimitService builds a LimitService.
+func NewLimitService(limits *repository.LimitRepository, auditLogs *repository.AuditLogRepository) *LimitService {
+	return &LimitService{limits: limits, auditLogs: auditLogs}
+}
+
+// Get returns accountID's limit override, or a zero-value, unlimited Limit
+// if none has been set yet.
+func (s *LimitService) Get(tenantID, accountID string) (*models.Limit, error) {
+	limit, err := s.limits.FindForAccount(tenantID, accountID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &models.Limit{TenantID: tenantID, Scope: models.LimitScopeAccount, AccountID: accountID}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return limit, nil
+}
+
+// Set creates or replaces accountID's limit override and records the
+// change in the audit log. The override takes effect on the very next
+// transaction, since TransactionService reads limits fresh on every post.
+func (s *LimitService) Set(tenantID, accountID, adminUserID string, perTransaction, daily, monthly int64, reasonCode, justification string) (*models.Limit, error) {
+	existing, err := s.limits.FindForAccount(tenantID, accountID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	if existing == nil {
+		limit := &models.Limit{
+			ID:                  newID(),
+			TenantID:            tenantID,
+			Scope:               models.LimitScopeAccount,
+			AccountID:           accountID,
+			PerTransactionLimit: perTransaction,
+			DailyLimit:          daily,
+			MonthlyLimit:        monthly,
+		}
+		if err := s.limits.Create(limit); err != nil {
+			return nil, err
+		}
+		existing = limit
+	} else {
+		existing.PerTransactionLimit = perTransaction
+		existing.DailyLimit = daily
+		existing.MonthlyLimit = monthly
+		if err := s.limits.Update(existing); err != nil {
+			return nil, err
+		}
+	}
+
+	audit := &models.AuditLog{
+		ID:          newID(),
+		TenantID:    tenantID,
+		ActorUserID: adminUserID,
+		Action:      "account_limits_updated",
+		EntityType:  "account",
+		EntityID:    accountID,
+		ReasonCode:  reasonCode,
+		Description: justification,
+	}
+	if err := s.auditLogs.Create(audit); err != nil {
+		return nil, err
+	}
+
+	return existing, nil
+}
@@ -0,0 +1,106 @@
+package services
+
+import (
+	"context"
+	"log"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// LogEnricher annotates a TransactionLog entry that's already been
+// written, returning the fields to add to it, keyed by bson field name,
+// or nil if it has nothing to add. clientIP is the IP that submitted the
+// originating transaction, if known. An enricher's error only affects its
+// own annotation; it never fails the entry that's already on the log.
+type LogEnricher interface {
+	Enrich(entry *models.TransactionLog, clientIP string) (map[string]interface{}, error)
+}
+
+// MerchantCategoryEnricher annotates an entry with its merchant's
+// category, via provider.
+type MerchantCategoryEnricher struct {
+	provider MerchantCategoryProvider
+}
+
+// NewMerchantCategoryEnricher builds a MerchantCategoryEnricher.
+func NewMerchantCategoryEnricher(provider MerchantCategoryProvider) *MerchantCategoryEnricher {
+	return &MerchantCategoryEnricher{provider: provider}
+}
+
+// Enrich implements LogEnricher.
+func (e *MerchantCategoryEnricher) Enrich(entry *models.TransactionLog, clientIP string) (map[string]interface{}, error) {
+	if entry.Merchant == "" {
+		return nil, nil
+	}
+	category, err := e.provider.Categorize(entry.Merchant)
+	if err != nil || category == "" {
+		return nil, err
+	}
+	return map[string]interface{}{"merchant_category": category}, nil
+}
+
+// GeoEnricher annotates an entry with the country the originating
+// transaction was submitted from, via provider.
+type GeoEnricher struct {
+	provider GeoProvider
+}
+
+// NewGeoEnricher builds a GeoEnricher.
+func NewGeoEnricher(provider GeoProvider) *GeoEnricher {
+	return &GeoEnricher{provider: provider}
+}
+
+// Enrich implements LogEnricher.
+func (e *GeoEnricher) Enrich(entry *models.TransactionLog, clientIP string) (map[string]interface{}, error) {
+	if clientIP == "" {
+		return nil, nil
+	}
+	info, err := e.provider.Resolve(clientIP)
+	if err != nil || info.Country == "" {
+		return nil, err
+	}
+	return map[string]interface{}{"origin_country": info.Country}, nil
+}
+
+// LogEnrichmentService runs a pipeline of LogEnrichers over a
+// TransactionLog entry after it's already been written, annotating it
+// with details that are either slow, unreliable, or both (merchant
+// categorization, geolocation), so a lookup failure never holds up the
+// money movement that produced the entry in the first place.
+type LogEnrichmentService struct {
+	logs      *repository.TransactionLogRepository
+	enrichers []LogEnricher
+}
+
+// NewLogEnrichmentService builds a LogEnrichmentService running enrichers
+// in the order given.
+func NewLogEnrichmentService(logs *repository.TransactionLogRepository, enrichers ...LogEnricher) *LogEnrichmentService {
+	return &LogEnrichmentService{logs: logs, enrichers: enrichers}
+}
+
+// Run enriches entry in the background: it returns immediately, and the
+// resulting annotations, if any, are written back to the log entry once
+// every enricher has run. An enricher that errors is skipped; the others
+// still run.
+func (s *LogEnrichmentService) Run(entry *models.TransactionLog, clientIP string) {
+	go func() {
+		fields := map[string]interface{}{}
+		for _, enricher := range s.enrichers {
+			found, err := enricher.Enrich(entry, clientIP)
+			if err != nil {
+				log.Printf("transaction log enrichment failed for %s: %v", entry.ID, err)
+				continue
+			}
+			for k, v := range found {
+				fields[k] = v
+			}
+		}
+		if len(fields) == 0 {
+			return
+		}
+		if err := s.logs.SetFields(context.Background(), entry.ID, fields); err != nil {
+			log.Printf("transaction log enrichment write failed for %s: %v", entry.ID, err)
+		}
+	}()
+}
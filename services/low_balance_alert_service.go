@@ -0,0 +1,130 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// lowBalanceResetMultiplier is how far above Threshold a balance must
+// climb before an already-fired alert can fire again, so a balance
+// bouncing right around the threshold doesn't renotify on every debit.
+const lowBalanceResetMultiplier = 1.1
+
+// LowBalanceAlertService lets users configure a low-balance threshold on
+// an account and, once wired into a consumer of balance-change events
+// (see BalanceChangeListener), notifies them the first time a debit
+// drops the balance below it.
+type LowBalanceAlertService struct {
+	alerts        *repository.LowBalanceAlertRepository
+	accounts      *repository.AccountRepository
+	notifications NotificationProvider
+	events        EventPublisher
+}
+
+// NewLowBalanceAlertService builds a LowBalanceAlertService. events may
+// be nil for callers that don't need the webhook fanout, e.g. tests.
+func NewLowBalanceAlertService(alerts *repository.LowBalanceAlertRepository, accounts *repository.AccountRepository, notifications NotificationProvider, events EventPublisher) *LowBalanceAlertService {
+	return &LowBalanceAlertService{alerts: alerts, accounts: accounts, notifications: notifications, events: events}
+}
+
+// Get returns accountID's low-balance alert configuration, or a
+// zero-value, disabled configuration if none has been set yet.
+func (s *LowBalanceAlertService) Get(tenantID, accountID string) (*models.LowBalanceAlert, error) {
+	alert, err := s.alerts.FindForAccount(tenantID, accountID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &models.LowBalanceAlert{TenantID: tenantID, AccountID: accountID}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return alert, nil
+}
+
+// Set creates or replaces accountID's low-balance threshold. A threshold
+// of 0 disables the alert. Changing the threshold clears Active, since a
+// crossing evaluated against the old threshold no longer applies.
+func (s *LowBalanceAlertService) Set(tenantID, accountID string, threshold int64) (*models.LowBalanceAlert, error) {
+	existing, err := s.alerts.FindForAccount(tenantID, accountID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	if existing == nil {
+		alert := &models.LowBalanceAlert{
+			ID:        newID(),
+			TenantID:  tenantID,
+			AccountID: accountID,
+			Threshold: threshold,
+		}
+		if err := s.alerts.Create(alert); err != nil {
+			return nil, err
+		}
+		return alert, nil
+	}
+
+	existing.Threshold = threshold
+	existing.Active = false
+	if err := s.alerts.Update(existing); err != nil {
+		return nil, err
+	}
+	return existing, nil
+}
+
+// CheckBalance is called by a consumer of the account's balance changes
+// after each processed debit. If a threshold is configured and balance
+// has dropped below it for the first time since the last recovery, it
+// notifies the account owner and publishes a webhook event; it is a
+// no-op on every subsequent debit until the balance climbs back above
+// the hysteresis band, and a no-op entirely if no threshold is
+// configured. Best-effort: a notification failure is logged by the
+// underlying provider, never returned to the caller as fatal.
+func (s *LowBalanceAlertService) CheckBalance(tenantID, accountID string, balance int64) error {
+	alert, err := s.alerts.FindForAccount(tenantID, accountID)
+	if errors.Is(err, gorm.ErrRecordNotFound) || alert.Threshold <= 0 {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	resetAbove := int64(float64(alert.Threshold) * lowBalanceResetMultiplier)
+	switch {
+	case !alert.Active && balance < alert.Threshold:
+		alert.Active = true
+		if err := s.alerts.Update(alert); err != nil {
+			return err
+		}
+		return s.notify(tenantID, accountID, alert.Threshold, balance)
+	case alert.Active && balance >= resetAbove:
+		alert.Active = false
+		return s.alerts.Update(alert)
+	}
+	return nil
+}
+
+func (s *LowBalanceAlertService) notify(tenantID, accountID string, threshold, balance int64) error {
+	account, err := s.accounts.FindByID(tenantID, accountID)
+	if err != nil {
+		return err
+	}
+
+	message := fmt.Sprintf("your account balance of %d has dropped below your alert threshold of %d", balance, threshold)
+	if err := s.notifications.Notify(account.UserID, message); err != nil {
+		return err
+	}
+
+	if s.events != nil {
+		_ = s.events.Publish("account.low_balance", "", map[string]interface{}{
+			"tenant_id":  tenantID,
+			"account_id": accountID,
+			"threshold":  threshold,
+			"balance":    balance,
+		})
+	}
+	return nil
+}
@@ -0,0 +1,20 @@
+package services
+
+// MerchantCategoryProvider abstracts merchant category classification so
+// it can be swapped for a real merchant-category-code lookup later or
+// mocked in tests.
+type MerchantCategoryProvider interface {
+	// Categorize returns the category for merchant, or "" if it isn't
+	// recognized.
+	Categorize(merchant string) (string, error)
+}
+
+// NoopMerchantCategoryProvider is a placeholder provider used until a
+// real merchant-category-code database is configured. It never
+// categorizes anything.
+type NoopMerchantCategoryProvider struct{}
+
+// Categorize implements MerchantCategoryProvider.
+func (NoopMerchantCategoryProvider) Categorize(merchant string) (string, error) {
+	return "", nil
+}
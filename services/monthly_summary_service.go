@@ -0,0 +1,106 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// MonthlySummaryService precomputes each account's monthly activity,
+// broken down by transaction type and category, into a dedicated
+// collection so month-view screens can be served without re-aggregating
+// raw transaction log history on every request.
+type MonthlySummaryService struct {
+	accounts  *repository.AccountRepository
+	logs      *repository.TransactionLogRepository
+	summaries *repository.MonthlyAccountSummaryRepository
+}
+
+// NewMonthlySummaryService builds a MonthlySummaryService.
+func NewMonthlySummaryService(accounts *repository.AccountRepository, logs *repository.TransactionLogRepository, summaries *repository.MonthlyAccountSummaryRepository) *MonthlySummaryService {
+	return &MonthlySummaryService{accounts: accounts, logs: logs, summaries: summaries}
+}
+
+// RunForMonth recomputes every account's summary for the calendar month
+// containing month. A single account's failure is logged and skipped
+// rather than aborting the rest of the run.
+func (s *MonthlySummaryService) RunForMonth(ctx context.Context, month time.Time) {
+	accounts, err := s.accounts.ListAll()
+	if err != nil {
+		log.Printf("failed to list accounts for monthly summary run: %v", err)
+		return
+	}
+
+	from := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, month.Location())
+	to := from.AddDate(0, 1, 0)
+	key := from.Format("2006-01")
+
+	// Group accounts by tenant so AggregateMonthly, which is tenant-scoped,
+	// runs once per tenant instead of once per account.
+	byTenant := map[string][]models.Account{}
+	for _, account := range accounts {
+		byTenant[account.TenantID] = append(byTenant[account.TenantID], account)
+	}
+
+	for tenantID, tenantAccounts := range byTenant {
+		if err := s.runForTenant(ctx, tenantID, tenantAccounts, from, to, key); err != nil {
+			log.Printf("monthly summary run for tenant %s failed: %v", tenantID, err)
+		}
+	}
+}
+
+func (s *MonthlySummaryService) runForTenant(ctx context.Context, tenantID string, accounts []models.Account, from, to time.Time, monthKey string) error {
+	accountIDs := make([]string, len(accounts))
+	for i, account := range accounts {
+		accountIDs[i] = account.ID
+	}
+
+	aggregates, err := s.logs.AggregateMonthly(ctx, tenantID, accountIDs, from, to)
+	if err != nil {
+		return err
+	}
+
+	lines := map[string][]models.MonthlyAccountSummaryLine{}
+	for _, aggregate := range aggregates {
+		lines[aggregate.AccountID] = append(lines[aggregate.AccountID], models.MonthlyAccountSummaryLine{
+			Type:     models.TransactionType(aggregate.Type),
+			Category: aggregate.Category,
+			Count:    aggregate.Count,
+			Total:    aggregate.Total,
+			Min:      aggregate.Min,
+			Max:      aggregate.Max,
+		})
+	}
+
+	computedAt := time.Now()
+	for _, account := range accounts {
+		summary := &models.MonthlyAccountSummary{
+			ID:         fmt.Sprintf("%s:%s:%s", tenantID, account.ID, monthKey),
+			TenantID:   tenantID,
+			AccountID:  account.ID,
+			Month:      monthKey,
+			Lines:      lines[account.ID],
+			ComputedAt: computedAt,
+		}
+		if err := s.summaries.Upsert(ctx, summary); err != nil {
+			log.Printf("failed to store monthly summary for account %s: %v", account.ID, err)
+		}
+	}
+	return nil
+}
+
+// GetSummary returns accountID's precomputed summary for month ("YYYY-MM"),
+// scoped to tenantID.
+func (s *MonthlySummaryService) GetSummary(ctx context.Context, tenantID, accountID, month string) (*models.MonthlyAccountSummary, error) {
+	return s.summaries.FindByAccountMonth(ctx, tenantID, accountID, month)
+}
+
+// ListSummaries returns every precomputed summary for accountID, scoped to
+// tenantID, for a month-over-month trend view.
+func (s *MonthlySummaryService) ListSummaries(ctx context.Context, tenantID, accountID string) ([]models.MonthlyAccountSummary, error) {
+	return s.summaries.ListByAccount(ctx, tenantID, accountID)
+}
@@ -0,0 +1,22 @@
+package services
+
+import "log"
+
+// NotificationProvider abstracts delivering an in-app/push/email/SMS
+// notification to a user so it can be swapped per tenant or mocked in
+// tests.
+type NotificationProvider interface {
+	// Notify delivers message to userID.
+	Notify(userID, message string) error
+}
+
+// NoopNotificationProvider is a placeholder provider used until a real
+// notification channel is configured. It logs the message instead of
+// delivering it.
+type NoopNotificationProvider struct{}
+
+// Notify implements NotificationProvider.
+func (NoopNotificationProvider) Notify(userID, message string) error {
+	log.Printf("notification for user %s (noop provider, not actually delivered): %s", userID, message)
+	return nil
+}
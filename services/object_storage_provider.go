@@ -0,0 +1,23 @@
+package services
+
+import "log"
+
+// ObjectStorageProvider abstracts the object store an archive is uploaded
+// to, so it can be swapped for a real S3/GCS-backed implementation later or
+// mocked in tests.
+type ObjectStorageProvider interface {
+	// Store uploads data under key and returns a URL a caller can later
+	// download it from.
+	Store(key string, data []byte) (string, error)
+}
+
+// NoopObjectStorageProvider is a placeholder provider used until a real
+// object store is configured. It discards the data and logs that it would
+// have been uploaded.
+type NoopObjectStorageProvider struct{}
+
+// Store implements ObjectStorageProvider.
+func (NoopObjectStorageProvider) Store(key string, data []byte) (string, error) {
+	log.Printf("object storage: would store %d bytes under %q", len(data), key)
+	return "", nil
+}
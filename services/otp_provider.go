@@ -0,0 +1,20 @@
+package services
+
+import "log"
+
+// OTPProvider abstracts the channel a one-time code is delivered over
+// (SMS, email, push) so it can be swapped per tenant or mocked in tests.
+type OTPProvider interface {
+	// Send delivers code to destination (e.g. a phone number).
+	Send(destination, code string) error
+}
+
+// NoopOTPProvider is a placeholder provider used until a real SMS/email
+// gateway is configured. It logs the code instead of delivering it.
+type NoopOTPProvider struct{}
+
+// Send implements OTPProvider.
+func (NoopOTPProvider) Send(destination, code string) error {
+	log.Printf("otp code %s for %s (noop provider, not actually delivered)", code, destination)
+	return nil
+}
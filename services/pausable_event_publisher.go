@@ -0,0 +1,56 @@
+package services
+
+import (
+	"errors"
+	"regexp"
+)
+
+// ErrQueuePaused is returned by PausableEventPublisher.Publish for an
+// event routed to a queue an operator has paused.
+var ErrQueuePaused = errors.New("event queue is paused")
+
+// transactionEventTypePattern extracts the transaction type out of an
+// event type built by transactionEventType ("transaction.<type>.<stage>"),
+// the only event types PausableEventPublisher scopes a pause to
+// individually; every other event type (e.g. "statement.ready") is only
+// affected by a models.QueueGlobal pause.
+var transactionEventTypePattern = regexp.MustCompile(`^transaction\.([a-z_]+)\.[a-z]+$`)
+
+// PausableEventPublisher wraps another EventPublisher and fails a
+// publish with ErrQueuePaused instead of delegating to next whenever the
+// event's queue (or models.QueueGlobal) is paused, so TransactionService's
+// existing dead-letter fallback holds what would have been delivered
+// until an operator resumes the queue and replays it (see
+// EventReplayService).
+type PausableEventPublisher struct {
+	next   EventPublisher
+	pauses *QueuePauseService
+}
+
+// NewPausableEventPublisher builds a PausableEventPublisher.
+func NewPausableEventPublisher(next EventPublisher, pauses *QueuePauseService) *PausableEventPublisher {
+	return &PausableEventPublisher{next: next, pauses: pauses}
+}
+
+// Publish implements EventPublisher.
+func (p *PausableEventPublisher) Publish(eventType, correlationID string, payload interface{}) error {
+	paused, err := p.pauses.IsPaused(queueForEventType(eventType))
+	if err != nil {
+		return err
+	}
+	if paused {
+		return ErrQueuePaused
+	}
+	return p.next.Publish(eventType, correlationID, payload)
+}
+
+// queueForEventType returns the transaction type eventType was published
+// under, or "" if eventType isn't a transaction lifecycle event, in
+// which case only a models.QueueGlobal pause applies to it.
+func queueForEventType(eventType string) string {
+	m := transactionEventTypePattern.FindStringSubmatch(eventType)
+	if m == nil {
+		return ""
+	}
+	return m[1]
+}
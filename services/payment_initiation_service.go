@@ -0,0 +1,117 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// paymentConsentTTL is how long a payment consent waits for the
+// customer's confirmation before it can no longer be authorised.
+const paymentConsentTTL = 10 * time.Minute
+
+// ErrPaymentConsentNotFound is returned when a payment consent to confirm
+// or reject doesn't exist under the calling tenant.
+var ErrPaymentConsentNotFound = errors.New("payment consent not found")
+
+// ErrPaymentConsentNotPending is returned when a payment consent has
+// already been confirmed, rejected, or has expired, enforcing that it can
+// be acted on at most once.
+var ErrPaymentConsentNotPending = errors.New("payment consent is not awaiting authorisation")
+
+// ErrPaymentConsentExpired is returned when the customer confirms a
+// payment consent after its authorisation window has passed.
+var ErrPaymentConsentExpired = errors.New("payment consent has expired")
+
+// PaymentInitiationService implements a PSD2-style payment initiation
+// flow: a third party requests a payment on a customer's behalf, and the
+// transfer is only enqueued once the customer confirms it through a
+// separate redirect/confirmation step.
+type PaymentInitiationService struct {
+	consents     *repository.PaymentConsentRepository
+	transactions *TransactionService
+}
+
+// NewPaymentInitiationService builds a PaymentInitiationService.
+func NewPaymentInitiationService(consents *repository.PaymentConsentRepository, transactions *TransactionService) *PaymentInitiationService {
+	return &PaymentInitiationService{consents: consents, transactions: transactions}
+}
+
+// Initiate records a third party's request to move funds out of
+// accountID, awaiting the customer's confirmation before anything is
+// posted.
+func (s *PaymentInitiationService) Initiate(tenantID, userID, thirdPartyName, accountID, counterpartyAccountID string, amount int64, currency, reference string) (*models.PaymentConsent, error) {
+	consent := &models.PaymentConsent{
+		ID:                    newID(),
+		TenantID:              tenantID,
+		UserID:                userID,
+		ThirdPartyName:        thirdPartyName,
+		AccountID:             accountID,
+		CounterpartyAccountID: counterpartyAccountID,
+		Amount:                amount,
+		Currency:              currency,
+		Reference:             reference,
+		Status:                models.PaymentConsentAwaitingAuthorisation,
+		ExpiresAt:             time.Now().Add(paymentConsentTTL),
+	}
+	if err := s.consents.Create(consent); err != nil {
+		return nil, err
+	}
+	return consent, nil
+}
+
+// Confirm is called once the customer has approved the payment through
+// the redirect flow. It posts the transfer and marks the consent
+// authorised; a consent that has already been confirmed, rejected, or
+// has expired can never be confirmed again.
+func (s *PaymentInitiationService) Confirm(ctx context.Context, tenantID, id string) (*models.Transaction, error) {
+	consent, err := s.consents.FindByID(tenantID, id)
+	if err != nil {
+		return nil, ErrPaymentConsentNotFound
+	}
+	if consent.Status != models.PaymentConsentAwaitingAuthorisation {
+		return nil, ErrPaymentConsentNotPending
+	}
+	if time.Now().After(consent.ExpiresAt) {
+		_ = s.consents.MarkStatus(tenantID, id, models.PaymentConsentExpired, "")
+		return nil, ErrPaymentConsentExpired
+	}
+
+	tx, err := s.transactions.Post(ctx, TransactionInput{
+		TenantID:              tenantID,
+		AccountID:             consent.AccountID,
+		CounterpartyAccountID: consent.CounterpartyAccountID,
+		Type:                  models.TransactionTypeTransfer,
+		Amount:                consent.Amount,
+		ReasonCode:            "payment_initiation",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.consents.MarkStatus(tenantID, id, models.PaymentConsentAuthorised, tx.ID); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// Reject lets the customer decline the payment. It spends the consent's
+// single use without moving any funds.
+func (s *PaymentInitiationService) Reject(tenantID, id string) (*models.PaymentConsent, error) {
+	consent, err := s.consents.FindByID(tenantID, id)
+	if err != nil {
+		return nil, ErrPaymentConsentNotFound
+	}
+	if consent.Status != models.PaymentConsentAwaitingAuthorisation {
+		return nil, ErrPaymentConsentNotPending
+	}
+
+	if err := s.consents.MarkStatus(tenantID, id, models.PaymentConsentRejected, ""); err != nil {
+		return nil, err
+	}
+	consent.Status = models.PaymentConsentRejected
+	return consent, nil
+}
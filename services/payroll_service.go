@@ -0,0 +1,137 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// PayrollLineInput is a single employee-account/amount pair submitted to
+// PayrollService.CreateTemplate.
+type PayrollLineInput struct {
+	EmployeeAccountID string
+	Amount            int64
+}
+
+// PayrollService defines and runs recurring payroll templates: a business
+// account's fixed employee list and amounts, executed automatically by
+// RunDue as a bulk transfer on each template's PayDay.
+type PayrollService struct {
+	templates     *repository.PayrollTemplateRepository
+	lines         *repository.PayrollTemplateLineRepository
+	accounts      *repository.AccountRepository
+	batches       *repository.BulkTransferBatchRepository
+	bulkTransfers *BulkTransferService
+	notifications NotificationProvider
+}
+
+// NewPayrollService builds a PayrollService.
+func NewPayrollService(templates *repository.PayrollTemplateRepository, lines *repository.PayrollTemplateLineRepository, accounts *repository.AccountRepository, batches *repository.BulkTransferBatchRepository, bulkTransfers *BulkTransferService, notifications NotificationProvider) *PayrollService {
+	return &PayrollService{templates: templates, lines: lines, accounts: accounts, batches: batches, bulkTransfers: bulkTransfers, notifications: notifications}
+}
+
+// CreateTemplate defines a new recurring payroll template.
+func (s *PayrollService) CreateTemplate(tenantID, accountID, name string, payDay int, employeeLines []PayrollLineInput) (*models.PayrollTemplate, error) {
+	template := &models.PayrollTemplate{
+		ID:        newID(),
+		TenantID:  tenantID,
+		AccountID: accountID,
+		Name:      name,
+		PayDay:    payDay,
+		Active:    true,
+	}
+	if err := s.templates.Create(template); err != nil {
+		return nil, err
+	}
+
+	for _, line := range employeeLines {
+		if err := s.lines.Create(&models.PayrollTemplateLine{
+			ID:                newID(),
+			TemplateID:        template.ID,
+			TenantID:          tenantID,
+			EmployeeAccountID: line.EmployeeAccountID,
+			Amount:            line.Amount,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return template, nil
+}
+
+// History returns every bulk transfer batch a template's runs have
+// produced, most recent first.
+func (s *PayrollService) History(tenantID, templateID string) ([]models.BulkTransferBatch, error) {
+	return s.batches.ListByPayrollTemplate(tenantID, templateID)
+}
+
+// RunDue executes every active template whose PayDay matches now's day of
+// month and that hasn't already run today, posting each as a bulk
+// transfer. A template whose account can't cover its total is skipped and
+// its owner notified of the shortfall instead of posting a partial run.
+func (s *PayrollService) RunDue(ctx context.Context, now time.Time) {
+	startOfDay := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	due, err := s.templates.ListDue(now.Day(), startOfDay)
+	if err != nil {
+		log.Printf("failed to list due payroll templates: %v", err)
+		return
+	}
+
+	for _, template := range due {
+		s.run(ctx, template, now)
+	}
+}
+
+// run executes a single due template.
+func (s *PayrollService) run(ctx context.Context, template models.PayrollTemplate, now time.Time) {
+	lines, err := s.lines.ListByTemplate(template.TenantID, template.ID)
+	if err != nil {
+		log.Printf("failed to load lines for payroll template %s: %v", template.ID, err)
+		return
+	}
+	if len(lines) == 0 {
+		return
+	}
+
+	var total int64
+	bulkLines := make([]BulkTransferLine, 0, len(lines))
+	for _, line := range lines {
+		total += line.Amount
+		bulkLines = append(bulkLines, BulkTransferLine{CreditAccountID: line.EmployeeAccountID, Amount: line.Amount})
+	}
+
+	account, err := s.accounts.FindByID(template.TenantID, template.AccountID)
+	if err != nil {
+		log.Printf("failed to load account %s for payroll template %s: %v", template.AccountID, template.ID, err)
+		return
+	}
+
+	if account.Available() < total {
+		s.notifyShortfall(template, account, total)
+		return
+	}
+
+	if _, _, err := s.bulkTransfers.Submit(ctx, template.TenantID, template.AccountID, template.ID, template.ID, bulkLines); err != nil {
+		log.Printf("payroll run for template %s failed: %v", template.ID, err)
+		return
+	}
+
+	if err := s.templates.MarkRun(template.TenantID, template.ID, now); err != nil {
+		log.Printf("failed to mark payroll template %s run: %v", template.ID, err)
+	}
+}
+
+func (s *PayrollService) notifyShortfall(template models.PayrollTemplate, account *models.Account, total int64) {
+	if s.notifications == nil {
+		return
+	}
+	message := fmt.Sprintf("payroll run %q needs %d but account %s only has %d available; run skipped", template.Name, total, account.ID, account.Available())
+	if err := s.notifications.Notify(account.UserID, message); err != nil {
+		log.Printf("failed to notify %s of payroll shortfall: %v", account.UserID, err)
+	}
+}
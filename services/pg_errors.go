@@ -0,0 +1,22 @@
+package services
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// pgUniqueViolationCode is the Postgres SQLSTATE for a unique constraint
+// violation, used to map a raw insert conflict to a clean domain-level
+// error instead of leaking a database error string to callers.
+const pgUniqueViolationCode = "23505"
+
+// isUniqueViolation reports whether err is a Postgres unique constraint
+// violation and, if so, the name of the constraint that fired.
+func isUniqueViolation(err error) (constraint string, ok bool) {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolationCode {
+		return pgErr.ConstraintName, true
+	}
+	return "", false
+}
@@ -0,0 +1,127 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// ErrPhoneNotRegistered is returned when Request is called with a phone
+// number that doesn't match any user under the tenant. Only a number
+// verified at signup can be used for passwordless login.
+var ErrPhoneNotRegistered = errors.New("phone number not registered")
+
+// ErrPhoneLoginCooldown is returned when a phone-login OTP was already
+// requested too recently.
+var ErrPhoneLoginCooldown = errors.New("a login code was already requested recently, try again later")
+
+// ErrPhoneLoginNotVerifiable is returned when a phone-login request has
+// already been verified, has expired, or does not exist.
+var ErrPhoneLoginNotVerifiable = errors.New("phone login request is not verifiable")
+
+// ErrPhoneLoginAttemptsExceeded is returned once a phone-login request has
+// used up its allotted verification attempts.
+var ErrPhoneLoginAttemptsExceeded = errors.New("too many phone login attempts")
+
+// ErrPhoneLoginCodeMismatch is returned when the submitted code doesn't
+// match the pending request.
+var ErrPhoneLoginCodeMismatch = errors.New("phone login code does not match")
+
+const (
+	// phoneLoginRequestTTL is how long a phone-login OTP remains valid.
+	phoneLoginRequestTTL = 10 * time.Minute
+
+	// phoneLoginCooldown is the minimum time a user must wait between two
+	// phone-login requests, to slow down brute-force OTP spam. Shorter
+	// than PINService's cooldown since this is a routine login path
+	// rather than a rare recovery flow.
+	phoneLoginCooldown = time.Minute
+
+	// phoneLoginMaxAttempts is how many wrong codes a single request
+	// tolerates before it must be abandoned for a fresh one.
+	phoneLoginMaxAttempts = 5
+
+	// phoneLoginTokenName is the Name recorded on the API token issued at
+	// the end of a successful phone login.
+	phoneLoginTokenName = "phone login"
+)
+
+// phoneLoginScopes are the scopes granted to a token issued via
+// passwordless phone login: enough for a regular customer session,
+// nothing admin-adjacent.
+var phoneLoginScopes = []string{models.ScopeBalanceRead, models.ScopeHistoryRead, models.ScopeTransactionCreate}
+
+// PhoneLoginService drives passwordless login: an OTP sent to a user's
+// registered phone is exchanged for an API token, for markets where PINs
+// and passwords are a barrier to entry.
+type PhoneLoginService struct {
+	requests *repository.PhoneLoginRequestRepository
+	users    *repository.UserRepository
+	tokens   *APITokenService
+	otp      OTPProvider
+}
+
+// NewPhoneLoginService builds a PhoneLoginService.
+func NewPhoneLoginService(requests *repository.PhoneLoginRequestRepository, users *repository.UserRepository, tokens *APITokenService, otp OTPProvider) *PhoneLoginService {
+	return &PhoneLoginService{requests: requests, users: users, tokens: tokens, otp: otp}
+}
+
+// Request issues a new login OTP to phone, unless one was already
+// requested within phoneLoginCooldown. phone must belong to a user
+// already registered under tenantID; this is what ties login to a
+// number verified at signup rather than any number a caller supplies.
+func (s *PhoneLoginService) Request(tenantID, phone string) (*models.PhoneLoginRequest, error) {
+	user, err := s.users.FindByPhone(tenantID, phone)
+	if err != nil {
+		return nil, ErrPhoneNotRegistered
+	}
+
+	if last, err := s.requests.FindLatestByUser(tenantID, user.ID); err == nil {
+		if time.Since(last.CreatedAt) < phoneLoginCooldown {
+			return nil, ErrPhoneLoginCooldown
+		}
+	}
+
+	request := &models.PhoneLoginRequest{
+		ID:        newID(),
+		TenantID:  tenantID,
+		UserID:    user.ID,
+		Code:      generateNumericCode(),
+		Status:    models.PhoneLoginPending,
+		ExpiresAt: time.Now().Add(phoneLoginRequestTTL),
+	}
+	if err := s.requests.Create(request); err != nil {
+		return nil, err
+	}
+	if err := s.otp.Send(phone, request.Code); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+// Verify checks code against a pending request and, on success, issues an
+// API token for the user the request was raised against.
+func (s *PhoneLoginService) Verify(tenantID, requestID, code string) (*models.APIToken, string, error) {
+	request, err := s.requests.FindByID(tenantID, requestID)
+	if err != nil {
+		return nil, "", ErrPhoneLoginNotVerifiable
+	}
+	if request.Status != models.PhoneLoginPending || time.Now().After(request.ExpiresAt) {
+		return nil, "", ErrPhoneLoginNotVerifiable
+	}
+	if request.Attempts >= phoneLoginMaxAttempts {
+		return nil, "", ErrPhoneLoginAttemptsExceeded
+	}
+	if request.Code != code {
+		_ = s.requests.IncrementAttempts(tenantID, request.ID)
+		return nil, "", ErrPhoneLoginCodeMismatch
+	}
+
+	if err := s.requests.MarkStatus(tenantID, request.ID, models.PhoneLoginVerified); err != nil {
+		return nil, "", err
+	}
+
+	return s.tokens.Issue(tenantID, request.UserID, phoneLoginTokenName, phoneLoginScopes)
+}
@@ -0,0 +1,45 @@
+package services
+
+import "encoding/json"
+
+// PIIEncryptionService envelope-encrypts personally identifiable field
+// values (currently User.Phone) at rest, reusing the same KMSProvider
+// machinery AMQPEventPublisher uses for event payloads: a fresh AES-256
+// data key per value, wrapped by kms, so the plaintext is only ever
+// recoverable by something with access to the KMS. It implements
+// models.PhoneCodec.
+type PIIEncryptionService struct {
+	kms KMSProvider
+}
+
+// NewPIIEncryptionService builds a PIIEncryptionService backed by kms.
+func NewPIIEncryptionService(kms KMSProvider) *PIIEncryptionService {
+	return &PIIEncryptionService{kms: kms}
+}
+
+// EncryptPhone envelope-encrypts plaintext and returns it JSON-marshaled,
+// for storage in a text column.
+func (s *PIIEncryptionService) EncryptPhone(plaintext string) (string, error) {
+	encrypted, err := encryptPayload(s.kms, plaintext)
+	if err != nil {
+		return "", err
+	}
+	out, err := json.Marshal(encrypted)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// DecryptPhone reverses EncryptPhone.
+func (s *PIIEncryptionService) DecryptPhone(ciphertext string) (string, error) {
+	var encrypted EncryptedPayload
+	if err := json.Unmarshal([]byte(ciphertext), &encrypted); err != nil {
+		return "", err
+	}
+	var plaintext string
+	if err := DecryptPayload(s.kms, &encrypted, &plaintext); err != nil {
+		return "", err
+	}
+	return plaintext, nil
+}
@@ -0,0 +1,154 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// ErrPINResetCooldown is returned when a PIN-reset OTP was already
+// requested too recently.
+var ErrPINResetCooldown = errors.New("a pin reset was already requested recently, try again later")
+
+// ErrPINResetNotVerifiable is returned when a PIN-reset request has
+// already been verified, has expired, or does not exist.
+var ErrPINResetNotVerifiable = errors.New("pin reset request is not verifiable")
+
+// ErrPINResetAttemptsExceeded is returned once a PIN-reset request has
+// used up its allotted verification attempts.
+var ErrPINResetAttemptsExceeded = errors.New("too many pin reset attempts")
+
+// ErrPINResetCodeMismatch is returned when the submitted code doesn't
+// match the pending request.
+var ErrPINResetCodeMismatch = errors.New("pin reset code does not match")
+
+const (
+	// pinResetRequestTTL is how long a PIN-reset OTP remains valid.
+	pinResetRequestTTL = 10 * time.Minute
+
+	// pinResetCooldown is the minimum time a user must wait between two
+	// PIN-reset requests, to slow down brute-force OTP spam.
+	pinResetCooldown = 15 * time.Minute
+
+	// pinResetMaxAttempts is how many wrong codes a single request
+	// tolerates before it must be abandoned for a fresh one.
+	pinResetMaxAttempts = 5
+
+	// pinResetFreezeWindow is how long an account is blocked from posting
+	// new transactions after its PIN is reset, to limit the damage of an
+	// account takeover before the customer notices and reports it.
+	pinResetFreezeWindow = 24 * time.Hour
+)
+
+// PINService drives the forgot-PIN flow: an OTP sent to the user's
+// registered phone authorizes setting a new PIN, after which every
+// account the user owns is frozen for pinResetFreezeWindow.
+type PINService struct {
+	requests *repository.PINResetRequestRepository
+	users    *repository.UserRepository
+	accounts *repository.AccountRepository
+	freezes  *repository.AccountFreezeRepository
+	otp      OTPProvider
+}
+
+// NewPINService builds a PINService.
+func NewPINService(requests *repository.PINResetRequestRepository, users *repository.UserRepository, accounts *repository.AccountRepository, freezes *repository.AccountFreezeRepository, otp OTPProvider) *PINService {
+	return &PINService{requests: requests, users: users, accounts: accounts, freezes: freezes, otp: otp}
+}
+
+// Request issues a new PIN-reset OTP to user's registered phone, unless
+// one was already requested within pinResetCooldown.
+func (s *PINService) Request(tenantID string, user *models.User) (*models.PINResetRequest, error) {
+	if last, err := s.requests.FindLatestByUser(tenantID, user.ID); err == nil {
+		if time.Since(last.CreatedAt) < pinResetCooldown {
+			return nil, ErrPINResetCooldown
+		}
+	}
+
+	request := &models.PINResetRequest{
+		ID:        newID(),
+		TenantID:  tenantID,
+		UserID:    user.ID,
+		Code:      generateNumericCode(),
+		Status:    models.PINResetPending,
+		ExpiresAt: time.Now().Add(pinResetRequestTTL),
+	}
+	if err := s.requests.Create(request); err != nil {
+		return nil, err
+	}
+	if err := s.otp.Send(user.Phone, request.Code); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+// Verify checks code against a pending request and, on success, sets
+// newPIN as the user's PIN and freezes their accounts against new
+// transactions for pinResetFreezeWindow.
+func (s *PINService) Verify(tenantID, requestID, code, newPIN string) error {
+	request, err := s.requests.FindByID(tenantID, requestID)
+	if err != nil {
+		return ErrPINResetNotVerifiable
+	}
+	if request.Status != models.PINResetPending || time.Now().After(request.ExpiresAt) {
+		return ErrPINResetNotVerifiable
+	}
+	if request.Attempts >= pinResetMaxAttempts {
+		return ErrPINResetAttemptsExceeded
+	}
+	if request.Code != code {
+		_ = s.requests.IncrementAttempts(tenantID, request.ID)
+		return ErrPINResetCodeMismatch
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(newPIN), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	if err := s.users.UpdatePINHash(tenantID, request.UserID, string(hash)); err != nil {
+		return err
+	}
+	if err := s.requests.MarkStatus(tenantID, request.ID, models.PINResetVerified); err != nil {
+		return err
+	}
+
+	return s.freezeAccounts(tenantID, request.UserID)
+}
+
+// ForceReset clears userID's PIN hash and freezes their accounts, for an
+// admin who suspects the account has been compromised. Unlike Verify, it
+// skips the OTP step entirely since it's admin-initiated: the user must
+// set a fresh PIN, under freeze, before transacting again.
+func (s *PINService) ForceReset(tenantID, userID string) error {
+	if err := s.users.UpdatePINHash(tenantID, userID, ""); err != nil {
+		return err
+	}
+	return s.freezeAccounts(tenantID, userID)
+}
+
+// freezeAccounts places a pinResetFreezeWindow-long freeze on every
+// account the user owns.
+func (s *PINService) freezeAccounts(tenantID, userID string) error {
+	accounts, err := s.accounts.ListByUser(tenantID, userID)
+	if err != nil {
+		return err
+	}
+
+	expiresAt := time.Now().Add(pinResetFreezeWindow)
+	for _, account := range accounts {
+		if err := s.freezes.Create(&models.AccountFreeze{
+			ID:        newID(),
+			TenantID:  tenantID,
+			AccountID: account.ID,
+			Reason:    "pin_reset",
+			ExpiresAt: expiresAt,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
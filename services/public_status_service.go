@@ -0,0 +1,61 @@
+package services
+
+// statusDegradedInFlightThreshold and statusDegradedSlowShareThreshold
+// define when PublicStatusService reports delayed processing rather than
+// normal: too many event publishes in flight at once, or too large a
+// share of them landing in the slowest latency bucket.
+const (
+	statusDegradedInFlightThreshold  = 50
+	statusDegradedSlowShareThreshold = 0.1
+)
+
+// PublicStatus is the coarse, external-facing system health summary
+// returned by GET /status, worded for a mobile app banner rather than an
+// operator dashboard.
+type PublicStatus struct {
+	API        string `json:"api"`
+	Processing string `json:"processing"`
+	Message    string `json:"message"`
+}
+
+// PublicStatusService derives PublicStatus from EventMetrics: the same
+// event-publish counters /admin/metrics exposes to operators, translated
+// into wording safe to show an end user, without exposing the raw
+// counters themselves.
+type PublicStatusService struct {
+	metrics *EventMetrics
+}
+
+// NewPublicStatusService builds a PublicStatusService.
+func NewPublicStatusService(metrics *EventMetrics) *PublicStatusService {
+	return &PublicStatusService{metrics: metrics}
+}
+
+// Snapshot returns the current public status.
+func (s *PublicStatusService) Snapshot() PublicStatus {
+	snapshot := s.metrics.Snapshot()
+
+	var slow, total int64
+	for label, count := range snapshot.LatencyMsBuckets {
+		total += count
+		if label == eventLatencyOverflowLabel {
+			slow += count
+		}
+	}
+
+	degraded := snapshot.InFlight > statusDegradedInFlightThreshold ||
+		(total > 0 && float64(slow)/float64(total) > statusDegradedSlowShareThreshold)
+	if degraded {
+		return PublicStatus{
+			API:        "up",
+			Processing: "delayed",
+			Message:    "transactions may take up to 5 minutes to reflect",
+		}
+	}
+
+	return PublicStatus{
+		API:        "up",
+		Processing: "normal",
+		Message:    "all systems operating normally",
+	}
+}
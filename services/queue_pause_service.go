@@ -0,0 +1,63 @@
+package services
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// QueuePauseService lets an operator pause and resume outbound
+// transaction event publishing, either globally or for one transaction
+// type's queue, and reports the current state for /admin/stats.
+type QueuePauseService struct {
+	pauses *repository.QueuePauseRepository
+}
+
+// NewQueuePauseService builds a QueuePauseService.
+func NewQueuePauseService(pauses *repository.QueuePauseRepository) *QueuePauseService {
+	return &QueuePauseService{pauses: pauses}
+}
+
+// Set pauses or resumes queue, which is either models.QueueGlobal or a
+// transaction type's name (e.g. "withdrawal").
+func (s *QueuePauseService) Set(queue string, paused bool) (*models.QueuePauseState, error) {
+	state := &models.QueuePauseState{Queue: queue, Paused: paused}
+	if err := s.pauses.Upsert(state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+// List returns every queue with a recorded pause state, for reporting on
+// /admin/stats.
+func (s *QueuePauseService) List() ([]models.QueuePauseState, error) {
+	return s.pauses.List()
+}
+
+// IsPaused reports whether queue is currently paused, either directly or
+// because models.QueueGlobal is paused. A queue with no recorded state is
+// not paused.
+func (s *QueuePauseService) IsPaused(queue string) (bool, error) {
+	global, err := s.pauses.FindByQueue(models.QueueGlobal)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, err
+	}
+	if global != nil && global.Paused {
+		return true, nil
+	}
+	if queue == "" || queue == models.QueueGlobal {
+		return false, nil
+	}
+
+	state, err := s.pauses.FindByQueue(queue)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return state.Paused, nil
+}
@@ -0,0 +1,84 @@
+package services
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/jung-kurt/gofpdf"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// ReceiptService renders signed PDF receipts for posted transactions.
+type ReceiptService struct {
+	transactions *repository.TransactionRepository
+	accounts     *repository.AccountRepository
+	signingKey   []byte
+}
+
+// NewReceiptService builds a ReceiptService. signingKey is used to produce
+// the verification code printed on each receipt.
+func NewReceiptService(transactions *repository.TransactionRepository, accounts *repository.AccountRepository, signingKey []byte) *ReceiptService {
+	return &ReceiptService{transactions: transactions, accounts: accounts, signingKey: signingKey}
+}
+
+// Render builds a PDF receipt for tx as raw bytes.
+func (s *ReceiptService) Render(tenantID, transactionID string) ([]byte, error) {
+	tx, err := s.transactions.FindByID(tenantID, transactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := s.accounts.FindByID(tenantID, tx.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	verificationCode := s.sign(tx)
+
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.AddPage()
+	pdf.SetFont("Arial", "B", 16)
+	pdf.Cell(0, 10, "Transaction Receipt")
+	pdf.Ln(14)
+
+	pdf.SetFont("Arial", "", 11)
+	row := func(label, value string) {
+		pdf.SetFont("Arial", "B", 11)
+		pdf.CellFormat(50, 8, label, "", 0, "L", false, 0, "")
+		pdf.SetFont("Arial", "", 11)
+		pdf.CellFormat(0, 8, value, "", 1, "L", false, 0, "")
+	}
+
+	row("Reference", tx.ID)
+	row("Type", string(tx.Type))
+	row("Account", account.AccountNumber)
+	if tx.CounterpartyAccountID != "" {
+		row("Counterparty Account", tx.CounterpartyAccountID)
+	}
+	row("Amount", fmt.Sprintf("%d %s", tx.Amount, tx.Currency))
+	row("Running Balance", fmt.Sprintf("%d %s", tx.BalanceAfter, tx.Currency))
+	row("Timestamp", tx.CreatedAt.Format("2006-01-02 15:04:05 MST"))
+	row("Status", string(tx.Status))
+	pdf.Ln(6)
+	row("Verification Code", verificationCode)
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// sign derives a verification code from the transaction's immutable
+// fields, so a shared receipt can be checked against the transaction
+// without exposing the signing key.
+func (s *ReceiptService) sign(tx *models.Transaction) string {
+	mac := hmac.New(sha256.New, s.signingKey)
+	fmt.Fprintf(mac, "%s|%s|%d|%s|%s", tx.ID, tx.AccountID, tx.Amount, tx.Currency, tx.CreatedAt.UTC().Format("2006-01-02T15:04:05Z"))
+	return hex.EncodeToString(mac.Sum(nil))[:16]
+}
@@ -0,0 +1,121 @@
+package services
+
+import (
+	"time"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// ReconciliationReportAccountLine is one account's row in a
+// ReconciliationReport: its balance at the start and end of the day, and
+// what moved through it in between.
+type ReconciliationReportAccountLine struct {
+	AccountID      string                           `json:"account_id"`
+	TenantID       string                           `json:"tenant_id"`
+	Currency       string                           `json:"currency"`
+	OpeningBalance int64                            `json:"opening_balance"`
+	ClosingBalance int64                            `json:"closing_balance"`
+	Movements      map[models.TransactionType]int64 `json:"movements"`
+	FeesCollected  int64                            `json:"fees_collected"`
+}
+
+// ReconciliationReport is the finance team's end-of-day reconciliation
+// view: every account that moved money on Date, its opening and closing
+// balance, and the totals rolled up across all of them.
+type ReconciliationReport struct {
+	Date                time.Time                         `json:"date"`
+	Accounts            []ReconciliationReportAccountLine `json:"accounts"`
+	TotalMovements      map[models.TransactionType]int64  `json:"total_movements"`
+	TotalFeesCollected  int64                             `json:"total_fees_collected"`
+	TotalOpeningBalance int64                             `json:"total_opening_balance"`
+	TotalClosingBalance int64                             `json:"total_closing_balance"`
+}
+
+// ReconciliationReportService builds the daily reconciliation report from
+// the same ledger the balance reconciliation checker and account
+// statements already trust, rather than a separate snapshot table this
+// codebase has no other use for.
+type ReconciliationReportService struct {
+	accounts     *repository.AccountRepository
+	transactions *repository.TransactionRepository
+	fees         *FeeService
+}
+
+// NewReconciliationReportService builds a ReconciliationReportService.
+// fees may be nil, in which case every account reports zero fees
+// collected.
+func NewReconciliationReportService(accounts *repository.AccountRepository, transactions *repository.TransactionRepository, fees *FeeService) *ReconciliationReportService {
+	return &ReconciliationReportService{accounts: accounts, transactions: transactions, fees: fees}
+}
+
+// Generate builds the reconciliation report for the UTC calendar day
+// containing date, across every tenant's accounts. Accounts with no
+// activity that day are omitted, since their opening and closing
+// balances are identical and they contribute nothing to reconcile.
+//
+// Transaction.Fee isn't persisted on the ledger row today, so
+// FeesCollected is recomputed from the tenant's current fee schedule the
+// same way TransactionService.Preview does; it reflects the schedule in
+// effect now, not necessarily the one in effect when a given transaction
+// posted.
+func (s *ReconciliationReportService) Generate(date time.Time) (*ReconciliationReport, error) {
+	from := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour).Add(-time.Nanosecond)
+
+	accounts, err := s.accounts.ListAll()
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ReconciliationReport{
+		Date:           from,
+		TotalMovements: map[models.TransactionType]int64{},
+	}
+
+	for _, account := range accounts {
+		txs, err := s.transactions.ListByAccountBetween(account.TenantID, account.ID, from, to)
+		if err != nil {
+			return nil, err
+		}
+		if len(txs) == 0 {
+			continue
+		}
+
+		opening, err := s.transactions.BalanceBefore(account.TenantID, account.ID, from)
+		if err != nil {
+			return nil, err
+		}
+
+		line := ReconciliationReportAccountLine{
+			AccountID:      account.ID,
+			TenantID:       account.TenantID,
+			Currency:       account.Currency,
+			OpeningBalance: opening,
+			Movements:      map[models.TransactionType]int64{},
+		}
+
+		closing := opening
+		for _, tx := range txs {
+			line.Movements[tx.Type] += tx.Amount
+			report.TotalMovements[tx.Type] += tx.Amount
+			closing = tx.BalanceAfter
+
+			if s.fees != nil && (tx.Type == models.TransactionTypeWithdrawal || tx.Type == models.TransactionTypeTransfer) {
+				fee, err := s.fees.Calculate(account.TenantID, tx.Type, tx.Amount)
+				if err != nil {
+					return nil, err
+				}
+				line.FeesCollected += fee
+				report.TotalFeesCollected += fee
+			}
+		}
+		line.ClosingBalance = closing
+
+		report.Accounts = append(report.Accounts, line)
+		report.TotalOpeningBalance += opening
+		report.TotalClosingBalance += closing
+	}
+
+	return report, nil
+}
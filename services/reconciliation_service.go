@@ -0,0 +1,98 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// reconciliationSampleSize is how many accounts a single Run checks.
+const reconciliationSampleSize = 50
+
+// ReconciliationService continuously verifies that every account's stored
+// balance still agrees with the balance recomputed from its own ledger
+// history (each Transaction records the BalanceAfter it produced), so
+// drift caused by a bug or an out-of-band data fix is caught instead of
+// silently compounding.
+type ReconciliationService struct {
+	accounts     *repository.ReconciliationRepository
+	transactions *repository.TransactionRepository
+	mismatches   *repository.BalanceMismatchRepository
+	alerts       AlertProvider
+}
+
+// NewReconciliationService builds a ReconciliationService.
+func NewReconciliationService(accounts *repository.ReconciliationRepository, transactions *repository.TransactionRepository, mismatches *repository.BalanceMismatchRepository, alerts AlertProvider) *ReconciliationService {
+	return &ReconciliationService{accounts: accounts, transactions: transactions, mismatches: mismatches, alerts: alerts}
+}
+
+// Run samples a batch of accounts, recomputes each one's expected balance
+// from its most recent ledger entry, and records and alerts on any
+// mismatch. It returns the number of mismatches found.
+func (s *ReconciliationService) Run() (int, error) {
+	accounts, err := s.accounts.SampleAccounts(reconciliationSampleSize)
+	if err != nil {
+		return 0, err
+	}
+
+	found := 0
+	for _, account := range accounts {
+		expected, err := s.expectedBalance(account.TenantID, account.ID)
+		if err != nil {
+			log.Printf("reconciliation: failed to recompute balance for account %s: %v", account.ID, err)
+			continue
+		}
+		if expected == account.Balance {
+			continue
+		}
+
+		found++
+		s.recordMismatch(account, expected)
+	}
+	return found, nil
+}
+
+// expectedBalance recomputes accountID's balance from the ledger: it's
+// whatever BalanceAfter its most recent transaction produced, or zero if
+// it has never posted one.
+func (s *ReconciliationService) expectedBalance(tenantID, accountID string) (int64, error) {
+	last, err := s.transactions.LastByAccount(tenantID, accountID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return last.BalanceAfter, nil
+}
+
+// recordMismatch persists the drift and alerts, best-effort: a failure to
+// record or alert must not stop the sweep from checking the rest of the
+// batch.
+func (s *ReconciliationService) recordMismatch(account models.Account, expected int64) {
+	mismatch := &models.BalanceMismatch{
+		ID:              newID(),
+		TenantID:        account.TenantID,
+		AccountID:       account.ID,
+		ExpectedBalance: expected,
+		ActualBalance:   account.Balance,
+	}
+	if err := s.mismatches.Create(mismatch); err != nil {
+		log.Printf("reconciliation: failed to record mismatch for account %s: %v", account.ID, err)
+	}
+
+	if s.alerts == nil {
+		return
+	}
+	if err := s.alerts.Alert(
+		"balance drift detected",
+		fmt.Sprintf("account %s (tenant %s): expected %d, stored %d", account.ID, account.TenantID, expected, account.Balance),
+	); err != nil {
+		log.Printf("reconciliation: failed to send drift alert for account %s: %v", account.ID, err)
+	}
+}
@@ -0,0 +1,194 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"log"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// ErrDuplicateEmail is returned by ReferralService.Signup when the tenant
+// already has a user registered with that email.
+var ErrDuplicateEmail = errors.New("email already registered")
+
+const (
+	// referralCodeAlphabet excludes visually ambiguous characters (0/O, 1/I).
+	referralCodeAlphabet = "23456789ABCDEFGHJKLMNPQRSTUVWXYZ"
+	referralCodeLength   = 8
+
+	// maxSignupAttempts bounds retries when a freshly generated referral
+	// code collides with an existing one; an email collision fails fast
+	// instead of retrying, since regenerating won't change the email.
+	maxSignupAttempts = 5
+
+	// usersTenantEmailConstraint is the unique index name on
+	// (tenant_id, email), used to tell an email collision apart from a
+	// referral code collision on the same insert.
+	usersTenantEmailConstraint = "idx_users_tenant_email"
+
+	// signupBonusAmount is paid to both the referrer and the referred user
+	// once the referred user completes a qualifying transaction.
+	signupBonusAmount = 1000 // minor units
+
+	// qualifyingDepositMinimum is the smallest deposit that counts as
+	// "completing" a referral, to prevent a trivial 1-unit deposit from
+	// triggering the payout.
+	qualifyingDepositMinimum = 5000 // minor units
+
+	// maxRewardedReferralsPerReferrer caps how many signup bonuses a single
+	// referrer can ever earn, to blunt bulk fake-account abuse.
+	maxRewardedReferralsPerReferrer = 50
+)
+
+// ReferralService attributes signups to referral codes and pays the
+// signup bonus once the referred user completes a qualifying transaction.
+type ReferralService struct {
+	users        *repository.UserRepository
+	accounts     *repository.AccountRepository
+	referrals    *repository.ReferralRepository
+	transactions *TransactionService
+}
+
+// NewReferralService builds a ReferralService.
+func NewReferralService(users *repository.UserRepository, accounts *repository.AccountRepository, referrals *repository.ReferralRepository, transactions *TransactionService) *ReferralService {
+	return &ReferralService{users: users, accounts: accounts, referrals: referrals, transactions: transactions}
+}
+
+// Signup creates a new user with a freshly generated referral code. If
+// referralCode matches an existing user, the signup is attributed to them
+// via a pending Referral; an unrecognized code is ignored rather than
+// failing the signup. If pin is set, it's hashed and stored as the user's
+// PIN so they can authenticate via AuthService.Login right away, instead
+// of only being able to set one later through the forgot-PIN flow.
+func (s *ReferralService) Signup(tenantID, name, email, phone, referralCode, pin string) (*models.User, error) {
+	user := &models.User{
+		ID:       newID(),
+		TenantID: tenantID,
+		Name:     name,
+		Email:    email,
+		Phone:    phone,
+	}
+	if pin != "" {
+		hash, err := bcrypt.GenerateFromPassword([]byte(pin), bcrypt.DefaultCost)
+		if err != nil {
+			return nil, err
+		}
+		user.PINHash = string(hash)
+	}
+
+	var err error
+	for attempt := 1; attempt <= maxSignupAttempts; attempt++ {
+		user.ReferralCode = generateReferralCode()
+		err = s.users.Create(user)
+		if err == nil {
+			break
+		}
+
+		constraint, ok := isUniqueViolation(err)
+		if !ok {
+			return nil, err
+		}
+		if constraint == usersTenantEmailConstraint {
+			return nil, ErrDuplicateEmail
+		}
+		// Any other unique violation is the referral code colliding with
+		// an existing one; regenerate and retry.
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if referralCode != "" {
+		referrer, err := s.users.FindByReferralCode(tenantID, referralCode)
+		if err != nil {
+			log.Printf("referral code %q not found for tenant %s, signup proceeding unattributed", referralCode, tenantID)
+			return user, nil
+		}
+
+		referral := &models.Referral{
+			ID:             newID(),
+			TenantID:       tenantID,
+			ReferrerUserID: referrer.ID,
+			ReferredUserID: user.ID,
+			Status:         models.ReferralPending,
+		}
+		if err := s.referrals.Create(referral); err != nil {
+			return nil, err
+		}
+	}
+
+	return user, nil
+}
+
+// OnQualifyingTransaction checks whether accountID's owner has a pending
+// referral that amount (posted as txType) satisfies, and if so pays the
+// signup bonus to both parties. Called best-effort after a transaction
+// posts; a failure here must never undo the transaction it followed.
+func (s *ReferralService) OnQualifyingTransaction(ctx context.Context, tenantID, accountID string, txType models.TransactionType, amount int64) {
+	if txType != models.TransactionTypeDeposit || amount < qualifyingDepositMinimum {
+		return
+	}
+
+	account, err := s.accounts.FindByID(tenantID, accountID)
+	if err != nil {
+		return
+	}
+
+	referral, err := s.referrals.FindPendingByReferredUser(tenantID, account.UserID)
+	if err != nil {
+		return
+	}
+
+	rewardedCount, err := s.referrals.CountRewardedByReferrer(tenantID, referral.ReferrerUserID)
+	if err != nil || rewardedCount >= maxRewardedReferralsPerReferrer {
+		return
+	}
+
+	referrerAccounts, err := s.accounts.ListByUser(tenantID, referral.ReferrerUserID)
+	if err != nil || len(referrerAccounts) == 0 {
+		return
+	}
+
+	if _, err := s.transactions.Post(ctx, TransactionInput{
+		TenantID:  tenantID,
+		AccountID: referrerAccounts[0].ID,
+		Type:      models.TransactionTypeDeposit,
+		Amount:    signupBonusAmount,
+	}); err != nil {
+		log.Printf("referral bonus deposit to referrer %s failed: %v", referral.ReferrerUserID, err)
+		return
+	}
+
+	if _, err := s.transactions.Post(ctx, TransactionInput{
+		TenantID:  tenantID,
+		AccountID: accountID,
+		Type:      models.TransactionTypeDeposit,
+		Amount:    signupBonusAmount,
+	}); err != nil {
+		log.Printf("referral bonus deposit to referred user %s failed: %v", account.UserID, err)
+		return
+	}
+
+	if err := s.referrals.MarkRewarded(tenantID, referral.ID, time.Now()); err != nil {
+		log.Printf("failed to mark referral %s rewarded: %v", referral.ID, err)
+	}
+}
+
+// generateReferralCode returns a random, human-typeable referral code.
+func generateReferralCode() string {
+	b := make([]byte, referralCodeLength)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	code := make([]byte, referralCodeLength)
+	for i, v := range b {
+		code[i] = referralCodeAlphabet[int(v)%len(referralCodeAlphabet)]
+	}
+	return string(code)
+}
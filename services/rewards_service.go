@@ -0,0 +1,190 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+const (
+	// pointsPerMinorUnit is the fixed redemption rate: this many points
+	// convert into one minor unit of currency.
+	pointsPerMinorUnit = 100
+)
+
+// ErrInsufficientPoints is returned when a redemption would overdraw a
+// user's points balance.
+var ErrInsufficientPoints = errors.New("insufficient points")
+
+// ErrInvalidRedemption is returned when a redemption's point amount is too
+// small to convert into any currency.
+var ErrInvalidRedemption = errors.New("points amount is too small to redeem")
+
+// RewardsService accrues points on qualifying transactions and redeems a
+// user's points balance into a deposit.
+type RewardsService struct {
+	db           *gorm.DB
+	rules        *repository.RewardRuleRepository
+	points       *repository.PointsAccountRepository
+	ledger       *repository.PointsLedgerRepository
+	accounts     *repository.AccountRepository
+	transactions *TransactionService
+}
+
+// NewRewardsService builds a RewardsService.
+func NewRewardsService(rules *repository.RewardRuleRepository, points *repository.PointsAccountRepository, ledger *repository.PointsLedgerRepository, accounts *repository.AccountRepository, transactions *TransactionService, db *gorm.DB) *RewardsService {
+	return &RewardsService{db: db, rules: rules, points: points, ledger: ledger, accounts: accounts, transactions: transactions}
+}
+
+// OnQualifyingTransaction accrues points for tx against the tenant's reward
+// rules, if any active rule matches its category/merchant. Called
+// best-effort after a transaction posts; a failure here must never undo the
+// transaction it followed.
+func (s *RewardsService) OnQualifyingTransaction(ctx context.Context, tenantID string, tx *models.Transaction) {
+	if tx.Type != models.TransactionTypeDeposit && tx.Type != models.TransactionTypeWithdrawal && tx.Type != models.TransactionTypeTransfer {
+		return
+	}
+
+	rules, err := s.rules.List(tenantID)
+	if err != nil {
+		return
+	}
+	rule := bestRewardRule(rules, tx.Category, tx.Merchant)
+	if rule == nil {
+		return
+	}
+
+	points := tx.Amount * int64(rule.PointsRateBps) / 10000
+	if points <= 0 {
+		return
+	}
+
+	account, err := s.accounts.FindByID(tenantID, tx.AccountID)
+	if err != nil {
+		return
+	}
+
+	if err := s.adjustBalance(tenantID, account.UserID, points, "accrual", tx.ID); err != nil {
+		log.Printf("rewards accrual failed for transaction %s: %v", tx.ID, err)
+	}
+}
+
+// Redeem converts points from userID's balance into a deposit posted to
+// accountID. If the deposit fails to post, the points are refunded rather
+// than left debited.
+func (s *RewardsService) Redeem(ctx context.Context, tenantID, userID, accountID string, points int64) (*models.Transaction, error) {
+	if points <= 0 {
+		return nil, ErrInvalidRedemption
+	}
+	amount := points / pointsPerMinorUnit
+	if amount <= 0 {
+		return nil, ErrInvalidRedemption
+	}
+
+	if err := s.adjustBalance(tenantID, userID, -points, "redemption", ""); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.transactions.Post(ctx, TransactionInput{
+		TenantID:  tenantID,
+		AccountID: accountID,
+		Type:      models.TransactionTypeDeposit,
+		Amount:    amount,
+	})
+	if err != nil {
+		if refundErr := s.adjustBalance(tenantID, userID, points, "redemption_refund", ""); refundErr != nil {
+			log.Printf("failed to refund %d points to user %s after failed redemption: %v", points, userID, refundErr)
+		}
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+// Balance returns userID's current points balance, scoped to tenantID. A
+// user who has never accrued or redeemed points has a balance of zero.
+func (s *RewardsService) Balance(tenantID, userID string) (int64, error) {
+	account, err := s.points.FindByUser(tenantID, userID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return account.Balance, nil
+}
+
+// adjustBalance applies delta to userID's points balance and records a
+// matching ledger entry, both inside a single DB transaction. The account
+// is created lazily on its first adjustment.
+func (s *RewardsService) adjustBalance(tenantID, userID string, delta int64, reason, transactionID string) error {
+	return s.db.Transaction(func(dbtx *gorm.DB) error {
+		points := s.points.WithTx(dbtx)
+
+		account, err := points.FindByUserForUpdate(tenantID, userID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			account = &models.PointsAccount{ID: newID(), TenantID: tenantID, UserID: userID}
+			if err := points.Create(account); err != nil {
+				return err
+			}
+		} else if err != nil {
+			return err
+		}
+
+		newBalance := account.Balance + delta
+		if newBalance < 0 {
+			return ErrInsufficientPoints
+		}
+		if err := points.UpdateBalance(tenantID, account.ID, newBalance); err != nil {
+			return err
+		}
+
+		entry := &models.PointsLedgerEntry{
+			ID:            newID(),
+			TenantID:      tenantID,
+			UserID:        userID,
+			TransactionID: transactionID,
+			Points:        delta,
+			Reason:        reason,
+		}
+		return s.ledger.WithTx(dbtx).Create(entry)
+	})
+}
+
+// bestRewardRule picks the most specific active rule matching category and
+// merchant: a rule naming both beats a rule naming one, which beats the
+// tenant's blanket (both-empty) rule. An empty rule field matches anything.
+func bestRewardRule(rules []models.RewardRule, category, merchant string) *models.RewardRule {
+	var best *models.RewardRule
+	bestScore := -1
+	for i := range rules {
+		rule := &rules[i]
+		if !rule.Active {
+			continue
+		}
+		if rule.Category != "" && rule.Category != category {
+			continue
+		}
+		if rule.Merchant != "" && rule.Merchant != merchant {
+			continue
+		}
+
+		score := 0
+		if rule.Category != "" {
+			score++
+		}
+		if rule.Merchant != "" {
+			score++
+		}
+		if score > bestScore {
+			bestScore = score
+			best = rule
+		}
+	}
+	return best
+}
@@ -0,0 +1,18 @@
+package services
+
+// ScreeningProvider abstracts an external sanctions/watchlist API so it
+// can be swapped per tenant or mocked in tests.
+type ScreeningProvider interface {
+	// Check reports whether name or phone appears on the external list,
+	// and if so which list matched.
+	Check(name, phone string) (matched bool, listName string, err error)
+}
+
+// NoopScreeningProvider is a placeholder provider used until a real
+// sanctions list subscription is configured. It never matches.
+type NoopScreeningProvider struct{}
+
+// Check implements ScreeningProvider.
+func (NoopScreeningProvider) Check(name, phone string) (bool, string, error) {
+	return false, "", nil
+}
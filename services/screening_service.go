@@ -0,0 +1,82 @@
+package services
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// ScreeningService checks a transfer's account and counterparty against
+// the internal blocklist and an external sanctions list provider.
+type ScreeningService struct {
+	blocklist *repository.BlocklistRepository
+	accounts  *repository.AccountRepository
+	users     *repository.UserRepository
+	external  ScreeningProvider
+}
+
+// NewScreeningService builds a ScreeningService.
+func NewScreeningService(blocklist *repository.BlocklistRepository, accounts *repository.AccountRepository, users *repository.UserRepository, external ScreeningProvider) *ScreeningService {
+	return &ScreeningService{blocklist: blocklist, accounts: accounts, users: users, external: external}
+}
+
+// Screen checks accountID and counterpartyAccountID against the blocklist
+// and external list. matched is true if either party is listed, in which
+// case matchedValue and reason describe what matched.
+func (s *ScreeningService) Screen(tenantID, accountID, counterpartyAccountID string) (matched bool, matchedValue, reason string, err error) {
+	for _, id := range []string{accountID, counterpartyAccountID} {
+		entry, err := s.blocklist.MatchExact(tenantID, models.BlocklistEntryAccount, id)
+		if err == nil {
+			return true, entry.Value, entry.Reason, nil
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, "", "", err
+		}
+	}
+
+	counterparty, err := s.accounts.FindByID(tenantID, counterpartyAccountID)
+	if err != nil {
+		return false, "", "", err
+	}
+	if counterparty.UserID == "" {
+		return false, "", "", nil
+	}
+
+	user, err := s.users.FindByID(tenantID, counterparty.UserID)
+	if err != nil {
+		return false, "", "", err
+	}
+
+	if user.Phone != "" {
+		entry, err := s.blocklist.MatchExact(tenantID, models.BlocklistEntryPhone, user.Phone)
+		if err == nil {
+			return true, entry.Value, entry.Reason, nil
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, "", "", err
+		}
+	}
+
+	entry, err := s.blocklist.MatchNamePattern(tenantID, user.Name)
+	if err == nil {
+		return true, entry.Value, entry.Reason, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, "", "", err
+	}
+
+	if s.external != nil {
+		externalMatched, listName, err := s.external.Check(user.Name, user.Phone)
+		if err != nil {
+			return false, "", "", err
+		}
+		if externalMatched {
+			return true, listName, "matched external sanctions list " + listName, nil
+		}
+	}
+
+	return false, "", "", nil
+}
@@ -0,0 +1,86 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// ErrSessionRevoked is returned when a request's session has already been
+// revoked, so the caller can reject it immediately instead of re-trusting
+// it.
+var ErrSessionRevoked = errors.New("session has been revoked")
+
+// ErrSessionNotFound is returned when a session to revoke doesn't belong
+// to the given user.
+var ErrSessionNotFound = errors.New("session not found")
+
+// SessionService tracks one session per user/device pair under the
+// interim header-based auth, so a user or admin can see active sessions
+// and revoke a compromised one.
+type SessionService struct {
+	sessions *repository.SessionRepository
+}
+
+// NewSessionService builds a SessionService.
+func NewSessionService(sessions *repository.SessionRepository) *SessionService {
+	return &SessionService{sessions: sessions}
+}
+
+// Touch records activity for a tenant/user/device triple, creating the
+// session on first sight. If the session has been revoked it returns
+// ErrSessionRevoked so the caller can reject the request immediately.
+func (s *SessionService) Touch(tenantID, userID, deviceID, ipAddress, userAgent string) (*models.Session, error) {
+	session, err := s.sessions.FindByUserAndDevice(tenantID, userID, deviceID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		session = &models.Session{
+			ID:         newID(),
+			TenantID:   tenantID,
+			UserID:     userID,
+			DeviceID:   deviceID,
+			IPAddress:  ipAddress,
+			UserAgent:  userAgent,
+			LastSeenAt: time.Now(),
+		}
+		if err := s.sessions.Create(session); err != nil {
+			return nil, err
+		}
+		return session, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if session.RevokedAt != nil {
+		return session, ErrSessionRevoked
+	}
+
+	if err := s.sessions.Touch(tenantID, session.ID, ipAddress, userAgent, time.Now()); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// List returns every session recorded for userID.
+func (s *SessionService) List(tenantID, userID string) ([]models.Session, error) {
+	return s.sessions.ListByUser(tenantID, userID)
+}
+
+// Revoke ends a session immediately; the next request on that
+// tenant/user/device is rejected by SessionTracker.
+func (s *SessionService) Revoke(tenantID, userID, sessionID string) error {
+	session, err := s.sessions.FindByID(tenantID, userID, sessionID)
+	if err != nil {
+		return ErrSessionNotFound
+	}
+	if session.RevokedAt != nil {
+		return nil
+	}
+
+	now := time.Now()
+	session.RevokedAt = &now
+	return s.sessions.Revoke(session)
+}
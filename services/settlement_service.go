@@ -0,0 +1,117 @@
+package services
+
+import (
+	"context"
+	"log"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// SettlementService nets a merchant's receipts collected during the day
+// minus its fee, and posts the result as a single credit to the
+// merchant's settlement account.
+type SettlementService struct {
+	merchants    *repository.MerchantRepository
+	transactions *repository.TransactionRepository
+	batches      *repository.SettlementBatchRepository
+	txService    *TransactionService
+	gl           *GLService
+}
+
+// NewSettlementService builds a SettlementService. gl may be nil, in
+// which case the withheld fee is not moved into fee income. The net
+// payout's GL posting is made by txService's own GL service, whatever gl
+// is.
+func NewSettlementService(merchants *repository.MerchantRepository, transactions *repository.TransactionRepository, batches *repository.SettlementBatchRepository, txService *TransactionService, gl *GLService) *SettlementService {
+	return &SettlementService{merchants: merchants, transactions: transactions, batches: batches, txService: txService, gl: gl}
+}
+
+// RunNightly sweeps every active merchant on the platform and settles it.
+// A single merchant's failure is logged and skipped rather than aborting
+// the rest of the sweep.
+func (s *SettlementService) RunNightly(ctx context.Context) {
+	merchants, err := s.merchants.ListActive()
+	if err != nil {
+		log.Printf("failed to list active merchants for settlement: %v", err)
+		return
+	}
+
+	for _, merchant := range merchants {
+		if err := s.settle(ctx, merchant); err != nil {
+			log.Printf("settlement run for merchant %s failed: %v", merchant.ID, err)
+		}
+	}
+}
+
+// History returns every settlement batch produced for merchantID, most
+// recent first, for the merchant's downloadable settlement report.
+func (s *SettlementService) History(tenantID, merchantID string) ([]models.SettlementBatch, error) {
+	return s.batches.ListByMerchant(tenantID, merchantID)
+}
+
+// settle nets a single merchant's unsettled receipts and posts the payout.
+// A merchant with no unsettled receipts is left alone rather than posting
+// a zero-value batch.
+func (s *SettlementService) settle(ctx context.Context, merchant models.Merchant) error {
+	txs, err := s.transactions.ListUnsettledByMerchant(merchant.TenantID, merchant.Name)
+	if err != nil {
+		return err
+	}
+	if len(txs) == 0 {
+		return nil
+	}
+
+	var gross int64
+	ids := make([]string, 0, len(txs))
+	for _, tx := range txs {
+		gross += tx.Amount
+		ids = append(ids, tx.ID)
+	}
+
+	fee := gross * int64(merchant.FeeBps) / 10000
+	net := gross - fee
+
+	settlement, err := s.txService.Post(ctx, TransactionInput{
+		TenantID:      merchant.TenantID,
+		AccountID:     merchant.SettlementAccountID,
+		Type:          models.TransactionTypeAdjustmentCredit,
+		Amount:        net,
+		ReasonCode:    "merchant_settlement",
+		Justification: "nightly settlement of merchant receipts",
+		GLPosting: &GLPosting{
+			AccountType: models.GLAccountSettlement,
+			Direction:   models.GLEntryDebit,
+			Amount:      net,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if s.gl != nil && fee != 0 {
+		// The withheld fee is entirely internal to the bank: it moves from
+		// the same settlement clearing account the merchant's net payout
+		// just debited into fee income, rather than offsetting a customer
+		// Transaction. A failure here doesn't unwind the payout, which has
+		// already posted; it's logged for finance to reconcile by hand.
+		if err := s.gl.Transfer(merchant.TenantID, models.GLAccountSettlement, models.GLAccountFeeIncome, settlement.Currency, fee, "merchant_settlement_fee"); err != nil {
+			log.Printf("settlement fee GL transfer failed for merchant %s: %v", merchant.ID, err)
+		}
+	}
+
+	if err := s.transactions.MarkSettled(merchant.TenantID, ids); err != nil {
+		return err
+	}
+
+	return s.batches.Create(&models.SettlementBatch{
+		ID:                      newID(),
+		TenantID:                merchant.TenantID,
+		MerchantID:              merchant.ID,
+		TransactionCount:        len(txs),
+		GrossAmount:             gross,
+		FeeAmount:               fee,
+		NetAmount:               net,
+		SettlementTransactionID: settlement.ID,
+	})
+}
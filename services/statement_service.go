@@ -0,0 +1,183 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// statementEmailRateLimitWindow and statementEmailRateLimit cap how often a
+// user can request a statement email for the same account, to keep the
+// notification provider from being used to spam an inbox.
+const (
+	statementEmailRateLimitWindow = 24 * time.Hour
+	statementEmailRateLimit       = 5
+)
+
+// ErrStatementEmailRateLimited is returned when an account has already hit
+// its statement email request limit for the current window.
+var ErrStatementEmailRateLimited = errors.New("too many statement email requests, try again later")
+
+// StatementLine is a single ledger entry on a Statement, carrying the
+// running balance immediately after it posted.
+type StatementLine struct {
+	TransactionID  string                 `json:"transaction_id"`
+	Type           models.TransactionType `json:"type"`
+	Amount         int64                  `json:"amount"`
+	RunningBalance int64                  `json:"running_balance"`
+	CreatedAt      time.Time              `json:"created_at"`
+	ValueDate      *time.Time             `json:"value_date,omitempty"`
+}
+
+// Statement is an account's ledger activity over a date range, computed
+// from the same Transaction rows used everywhere else, so it stays
+// consistent with the PDF and email statements that will be rendered from
+// it.
+type Statement struct {
+	AccountID      string          `json:"account_id"`
+	Currency       string          `json:"currency"`
+	From           time.Time       `json:"from"`
+	To             time.Time       `json:"to"`
+	OpeningBalance int64           `json:"opening_balance"`
+	ClosingBalance int64           `json:"closing_balance"`
+	Lines          []StatementLine `json:"lines"`
+}
+
+// StatementReadyEvent is published whenever a statement finishes
+// generating, so partner apps and the notification service can react
+// without polling.
+type StatementReadyEvent struct {
+	TenantID       string    `json:"tenant_id"`
+	AccountID      string    `json:"account_id"`
+	From           time.Time `json:"from"`
+	To             time.Time `json:"to"`
+	OpeningBalance int64     `json:"opening_balance"`
+	ClosingBalance int64     `json:"closing_balance"`
+	Currency       string    `json:"currency"`
+	DownloadURL    string    `json:"download_url"`
+}
+
+// StatementService computes account statements over a date range.
+type StatementService struct {
+	transactions  *repository.TransactionRepository
+	accounts      *repository.AccountRepository
+	events        EventPublisher
+	emailRequests *repository.StatementEmailRequestRepository
+	notifications NotificationProvider
+	publicBaseURL string
+}
+
+// NewStatementService builds a StatementService. publicBaseURL is used to
+// build the download URL included in the statement-ready event and emailed
+// to the requesting user.
+func NewStatementService(transactions *repository.TransactionRepository, accounts *repository.AccountRepository, events EventPublisher, emailRequests *repository.StatementEmailRequestRepository, notifications NotificationProvider, publicBaseURL string) *StatementService {
+	return &StatementService{transactions: transactions, accounts: accounts, events: events, emailRequests: emailRequests, notifications: notifications, publicBaseURL: publicBaseURL}
+}
+
+// Generate returns accountID's statement for the [from, to] range and
+// publishes a statement-ready event carrying its summary totals and
+// download URL.
+func (s *StatementService) Generate(tenantID, accountID string, from, to time.Time) (*Statement, error) {
+	account, err := s.accounts.FindByID(tenantID, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	opening, err := s.transactions.BalanceBefore(tenantID, accountID, from)
+	if err != nil {
+		return nil, err
+	}
+
+	txs, err := s.transactions.ListByAccountBetween(tenantID, accountID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make([]StatementLine, len(txs))
+	closing := opening
+	for i, tx := range txs {
+		lines[i] = StatementLine{
+			TransactionID:  tx.ID,
+			Type:           tx.Type,
+			Amount:         tx.Amount,
+			RunningBalance: tx.BalanceAfter,
+			CreatedAt:      tx.CreatedAt,
+			ValueDate:      tx.ValueDate,
+		}
+		closing = tx.BalanceAfter
+	}
+
+	statement := &Statement{
+		AccountID:      accountID,
+		Currency:       account.Currency,
+		From:           from,
+		To:             to,
+		OpeningBalance: opening,
+		ClosingBalance: closing,
+		Lines:          lines,
+	}
+
+	if s.events != nil {
+		event := StatementReadyEvent{
+			TenantID:       tenantID,
+			AccountID:      accountID,
+			From:           from,
+			To:             to,
+			OpeningBalance: opening,
+			ClosingBalance: closing,
+			Currency:       account.Currency,
+			DownloadURL:    fmt.Sprintf("%s/accounts/%s/statement?from=%s&to=%s", s.publicBaseURL, accountID, from.Format(time.RFC3339), to.Format(time.RFC3339)),
+		}
+		if err := s.events.Publish("statement.ready", "", event); err != nil {
+			// The statement itself is already computed and correct; a
+			// failed event publish shouldn't fail the request that's
+			// waiting on it.
+			log.Printf("statement.ready event publish failed for account %s: %v", accountID, err)
+		}
+	}
+
+	return statement, nil
+}
+
+// EmailStatement generates the statement for [from, to] and delivers a
+// download link to userID via the configured NotificationProvider,
+// recording the request and its outcome. It's rate-limited per account to
+// keep the notification channel from being used to spam an inbox.
+func (s *StatementService) EmailStatement(tenantID, accountID, userID string, from, to time.Time) (*models.StatementEmailRequest, error) {
+	count, err := s.emailRequests.CountByAccountSince(tenantID, accountID, time.Now().Add(-statementEmailRateLimitWindow))
+	if err != nil {
+		return nil, err
+	}
+	if count >= statementEmailRateLimit {
+		return nil, ErrStatementEmailRateLimited
+	}
+
+	if _, err := s.Generate(tenantID, accountID, from, to); err != nil {
+		return nil, err
+	}
+
+	req := &models.StatementEmailRequest{
+		ID:        newID(),
+		TenantID:  tenantID,
+		AccountID: accountID,
+		UserID:    userID,
+		From:      from,
+		To:        to,
+		Status:    models.StatementEmailSent,
+	}
+
+	downloadURL := fmt.Sprintf("%s/accounts/%s/statement?from=%s&to=%s", s.publicBaseURL, accountID, from.Format(time.RFC3339), to.Format(time.RFC3339))
+	if err := s.notifications.Notify(userID, fmt.Sprintf("your statement is ready: %s", downloadURL)); err != nil {
+		req.Status = models.StatementEmailFailed
+		req.FailureReason = err.Error()
+	}
+
+	if err := s.emailRequests.Create(req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
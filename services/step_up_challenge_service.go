@@ -0,0 +1,141 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// ErrStepUpChallengeNotVerifiable is returned when a step-up challenge has
+// already been verified, has expired, or does not exist.
+var ErrStepUpChallengeNotVerifiable = errors.New("step up challenge is not verifiable")
+
+// ErrStepUpChallengeAttemptsExceeded is returned once a step-up challenge
+// has used up its allotted verification attempts.
+var ErrStepUpChallengeAttemptsExceeded = errors.New("too many step up verification attempts")
+
+// ErrStepUpCodeMismatch is returned when the submitted code doesn't match
+// the pending challenge.
+var ErrStepUpCodeMismatch = errors.New("step up code does not match")
+
+const (
+	// stepUpChallengeTTL is how long a step-up OTP remains valid.
+	stepUpChallengeTTL = 10 * time.Minute
+
+	// stepUpMaxAttempts is how many wrong codes a single challenge
+	// tolerates before it must be abandoned for a fresh transaction
+	// attempt.
+	stepUpMaxAttempts = 5
+)
+
+// StepUpChallengeService holds a transaction that cleared the tenant's
+// StepUpThreshold behind an OTP challenge, and posts it once the OTP is
+// confirmed. Unlike DeviceService, which only trusts a device as a side
+// effect and requires the caller to resubmit the original transaction,
+// this queues the transaction itself so Confirm can post it directly.
+type StepUpChallengeService struct {
+	challenges   *repository.StepUpChallengeRepository
+	transactions *TransactionService
+	otp          OTPProvider
+}
+
+// NewStepUpChallengeService builds a StepUpChallengeService.
+func NewStepUpChallengeService(challenges *repository.StepUpChallengeRepository, transactions *TransactionService, otp OTPProvider) *StepUpChallengeService {
+	return &StepUpChallengeService{challenges: challenges, transactions: transactions, otp: otp}
+}
+
+// Challenge queues in for posting and sends an OTP to destination. The
+// transaction only posts once the returned challenge is confirmed via
+// Confirm.
+func (s *StepUpChallengeService) Challenge(in TransactionInput, destination string) (*models.StepUpChallenge, error) {
+	challenge := &models.StepUpChallenge{
+		ID:                    newID(),
+		TenantID:              in.TenantID,
+		AccountID:             in.AccountID,
+		CounterpartyAccountID: in.CounterpartyAccountID,
+		Type:                  in.Type,
+		Amount:                in.Amount,
+		Currency:              in.Currency,
+		BranchID:              in.BranchID,
+		PerformedByUserID:     in.PerformedByUserID,
+		ClientIP:              in.ClientIP,
+		Category:              in.Category,
+		Merchant:              in.Merchant,
+		RequestID:             in.RequestID,
+		CorrelationID:         in.CorrelationID,
+		IdempotencyKey:        in.IdempotencyKey,
+		Code:                  generateNumericCode(),
+		Status:                models.StepUpChallengePending,
+		ExpiresAt:             time.Now().Add(stepUpChallengeTTL),
+	}
+	if err := s.challenges.Create(challenge); err != nil {
+		return nil, err
+	}
+	if err := s.otp.Send(destination, challenge.Code); err != nil {
+		return nil, err
+	}
+	return challenge, nil
+}
+
+// Confirm checks code against a pending challenge and, on success, posts
+// the transaction it was queuing.
+func (s *StepUpChallengeService) Confirm(ctx context.Context, tenantID, challengeID, code string) (*models.Transaction, error) {
+	challenge, err := s.challenges.FindByID(tenantID, challengeID)
+	if err != nil {
+		return nil, ErrStepUpChallengeNotVerifiable
+	}
+	if challenge.Status != models.StepUpChallengePending || time.Now().After(challenge.ExpiresAt) {
+		return nil, ErrStepUpChallengeNotVerifiable
+	}
+	if challenge.Attempts >= stepUpMaxAttempts {
+		return nil, ErrStepUpChallengeAttemptsExceeded
+	}
+	if challenge.Code != code {
+		_ = s.challenges.IncrementAttempts(tenantID, challenge.ID)
+		return nil, ErrStepUpCodeMismatch
+	}
+
+	// Claim the challenge before posting, not after: two concurrent
+	// Confirm calls with the correct code can both pass the checks
+	// above, and without a claim in between, both would post the
+	// transaction before either recorded the challenge verified.
+	// MarkStatus's WHERE status = pending guard means only one of them
+	// can win this update; the loser sees rowsAffected == 0 and reports
+	// ErrStepUpChallengeNotVerifiable instead of double-posting.
+	rowsAffected, err := s.challenges.MarkStatus(tenantID, challenge.ID, models.StepUpChallengeVerified)
+	if err != nil {
+		return nil, err
+	}
+	if rowsAffected == 0 {
+		return nil, ErrStepUpChallengeNotVerifiable
+	}
+
+	tx, err := s.transactions.Post(ctx, TransactionInput{
+		TenantID:              challenge.TenantID,
+		AccountID:             challenge.AccountID,
+		CounterpartyAccountID: challenge.CounterpartyAccountID,
+		Type:                  challenge.Type,
+		Amount:                challenge.Amount,
+		Currency:              challenge.Currency,
+		BranchID:              challenge.BranchID,
+		PerformedByUserID:     challenge.PerformedByUserID,
+		ClientIP:              challenge.ClientIP,
+		Category:              challenge.Category,
+		Merchant:              challenge.Merchant,
+		RequestID:             challenge.RequestID,
+		CorrelationID:         challenge.CorrelationID,
+		IdempotencyKey:        challenge.IdempotencyKey,
+	})
+	if err != nil {
+		// The claim above already flipped this challenge to verified;
+		// put it back to pending so it isn't stranded verified with
+		// nothing posted against it, and can be retried with the same
+		// code.
+		_ = s.challenges.Reopen(tenantID, challenge.ID)
+		return nil, err
+	}
+	return tx, nil
+}
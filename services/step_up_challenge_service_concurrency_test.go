@@ -0,0 +1,83 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// TestStepUpChallengeService_ConcurrentConfirmsPostOnce fires concurrent
+// Confirm calls with the correct code against the same pending challenge
+// and asserts exactly one of them posts. Before MarkStatus was guarded
+// by status = pending, every caller could pass the pending/attempts/code
+// checks and post before any of them recorded the challenge verified, so
+// all of them would post the large transaction the challenge was meant
+// to gate.
+func TestStepUpChallengeService_ConcurrentConfirmsPostOnce(t *testing.T) {
+	db := testDB(t)
+
+	const (
+		tenantID = "77777777-7777-7777-7777-777777777777"
+		amount   = int64(500)
+		code     = "123456"
+		attempts = 5
+	)
+
+	accounts := repository.NewAccountRepository(db)
+	account := &models.Account{ID: newID(), TenantID: tenantID, UserID: newID(), Currency: "USD", Balance: 10_000}
+	if err := accounts.Create(account); err != nil {
+		t.Fatalf("create account: %v", err)
+	}
+
+	challenges := repository.NewStepUpChallengeRepository(db)
+	challenge := &models.StepUpChallenge{
+		ID:        newID(),
+		TenantID:  tenantID,
+		AccountID: account.ID,
+		Type:      models.TransactionTypeWithdrawal,
+		Amount:    amount,
+		Code:      code,
+		Status:    models.StepUpChallengePending,
+		ExpiresAt: time.Now().Add(stepUpChallengeTTL),
+	}
+	if err := challenges.Create(challenge); err != nil {
+		t.Fatalf("create challenge: %v", err)
+	}
+
+	transactions := NewTransactionService(db, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	svc := NewStepUpChallengeService(challenges, transactions, nil)
+
+	var wg sync.WaitGroup
+	posted := make([]bool, attempts)
+	for i := 0; i < attempts; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			tx, err := svc.Confirm(context.Background(), tenantID, challenge.ID, code)
+			posted[i] = err == nil && tx != nil
+		}(i)
+	}
+	wg.Wait()
+
+	successCount := 0
+	for _, ok := range posted {
+		if ok {
+			successCount++
+		}
+	}
+	if successCount != 1 {
+		t.Fatalf("got %d successful Confirm calls, want exactly 1", successCount)
+	}
+
+	after, err := accounts.FindByID(tenantID, account.ID)
+	if err != nil {
+		t.Fatalf("find account: %v", err)
+	}
+	if after.Balance != 10_000-amount {
+		t.Fatalf("account balance = %d, want %d (posted exactly once)", after.Balance, 10_000-amount)
+	}
+}
@@ -0,0 +1,109 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// ErrSuspenseItemNotOpen is returned when Match or Return is attempted on
+// a suspense item that's already been resolved.
+var ErrSuspenseItemNotOpen = errors.New("suspense item is not open")
+
+// SuspenseService holds inbound external credits that couldn't be matched
+// to a known account, and lets an admin later release them to the right
+// customer or send them back to the sender.
+type SuspenseService struct {
+	items        *repository.SuspenseItemRepository
+	transactions *TransactionService
+	gl           *GLService
+}
+
+// NewSuspenseService builds a SuspenseService.
+func NewSuspenseService(items *repository.SuspenseItemRepository, transactions *TransactionService, gl *GLService) *SuspenseService {
+	return &SuspenseService{items: items, transactions: transactions, gl: gl}
+}
+
+// Record posts amount to tenantID's suspense GL account and files a
+// SuspenseItem for an admin to resolve, in place of the deposit that
+// couldn't be posted because reference didn't resolve to a known account.
+func (s *SuspenseService) Record(tenantID string, amount int64, currency, reference, externalEventID string) (*models.SuspenseItem, error) {
+	if err := s.gl.PostStandalone(tenantID, models.GLAccountSuspense, currency, models.GLEntryCredit, amount, "unmatched_inbound_credit"); err != nil {
+		return nil, err
+	}
+
+	item := &models.SuspenseItem{
+		ID:              newID(),
+		TenantID:        tenantID,
+		Amount:          amount,
+		Currency:        currency,
+		Reference:       reference,
+		ExternalEventID: externalEventID,
+		Status:          models.SuspenseItemOpen,
+	}
+	if err := s.items.Create(item); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// List returns tenantID's unresolved suspense items.
+func (s *SuspenseService) List(tenantID string) ([]models.SuspenseItem, error) {
+	return s.items.ListOpen(tenantID)
+}
+
+// Match releases an open suspense item to accountID: it deposits the held
+// amount into the account and debits the suspense GL account back down,
+// atomically, then marks the item matched.
+func (s *SuspenseService) Match(ctx context.Context, tenantID, itemID, accountID string) (*models.Transaction, error) {
+	item, err := s.items.FindByID(tenantID, itemID)
+	if err != nil {
+		return nil, err
+	}
+	if item.Status != models.SuspenseItemOpen {
+		return nil, ErrSuspenseItemNotOpen
+	}
+
+	tx, err := s.transactions.Post(ctx, TransactionInput{
+		TenantID:      tenantID,
+		AccountID:     accountID,
+		Type:          models.TransactionTypeDeposit,
+		Amount:        item.Amount,
+		ReasonCode:    "suspense_matched",
+		Justification: "matched from suspense item " + item.ID,
+		GLPosting: &GLPosting{
+			AccountType: models.GLAccountSuspense,
+			Direction:   models.GLEntryDebit,
+			Amount:      item.Amount,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.items.MarkMatched(tenantID, item.ID, accountID, tx.ID); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// Return debits an open suspense item's amount back out of the suspense
+// GL account and marks it returned, for money that never belonged to any
+// customer here and is being sent back to whoever sent it.
+func (s *SuspenseService) Return(tenantID, itemID, reason string) error {
+	item, err := s.items.FindByID(tenantID, itemID)
+	if err != nil {
+		return err
+	}
+	if item.Status != models.SuspenseItemOpen {
+		return ErrSuspenseItemNotOpen
+	}
+
+	if err := s.gl.PostStandalone(tenantID, models.GLAccountSuspense, item.Currency, models.GLEntryDebit, item.Amount, "suspense_returned"); err != nil {
+		return err
+	}
+
+	return s.items.MarkReturned(tenantID, item.ID, reason)
+}
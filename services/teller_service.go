@@ -0,0 +1,155 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// ErrNoOpenSession is returned when a teller operation requires an open
+// TellerSession but the agent does not have one.
+var ErrNoOpenSession = errors.New("no open teller session")
+
+// TellerService handles cash-in/cash-out reconciliation against a branch's
+// cash account and the agent's cash-drawer session.
+type TellerService struct {
+	transactions *TransactionService
+	branches     *repository.BranchRepository
+	sessions     *repository.TellerSessionRepository
+}
+
+// NewTellerService builds a TellerService.
+func NewTellerService(transactions *TransactionService, branches *repository.BranchRepository, sessions *repository.TellerSessionRepository) *TellerService {
+	return &TellerService{transactions: transactions, branches: branches, sessions: sessions}
+}
+
+// CashIn records a customer handing over physical cash: the customer's
+// account is credited and the branch cash account is credited by the same
+// amount, since the branch now physically holds that cash. Both legs post
+// inside a single database transaction, the same way TransactionService
+// posts both sides of a transfer, so a failure on the branch leg can't
+// leave the customer leg committed with no offsetting branch-cash entry
+// for reconciliation to find.
+func (s *TellerService) CashIn(ctx context.Context, tenantID, branchID, agentID, accountID string, amount int64) (*models.Transaction, error) {
+	if _, err := s.sessions.FindOpenForAgent(tenantID, agentID); err != nil {
+		return nil, ErrNoOpenSession
+	}
+
+	branch, err := s.branches.FindByID(tenantID, branchID)
+	if err != nil {
+		return nil, err
+	}
+
+	customerLeg := TransactionInput{
+		TenantID:          tenantID,
+		AccountID:         accountID,
+		Type:              models.TransactionTypeDeposit,
+		Amount:            amount,
+		BranchID:          branchID,
+		PerformedByUserID: agentID,
+	}
+	branchLeg := customerLeg
+	branchLeg.AccountID = branch.CashAccountID
+
+	var customerTx, branchTx *models.Transaction
+	err = s.transactions.db.Transaction(func(dbtx *gorm.DB) error {
+		var err error
+		customerTx, err = s.transactions.PostTx(dbtx, customerLeg)
+		if err != nil {
+			return err
+		}
+		branchTx, err = s.transactions.PostTx(dbtx, branchLeg)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.transactions.afterPost(ctx, customerTx, customerLeg)
+	s.transactions.afterPost(ctx, branchTx, branchLeg)
+	return customerTx, nil
+}
+
+// CashOut records a customer withdrawing physical cash: the customer's
+// account is debited and the branch cash account is debited by the same
+// amount, since that cash leaves the branch. Both legs post inside a
+// single database transaction, the same way TransactionService posts
+// both sides of a transfer, so a failure on the branch leg can't leave
+// the customer leg committed with no offsetting branch-cash entry for
+// reconciliation to find.
+func (s *TellerService) CashOut(ctx context.Context, tenantID, branchID, agentID, accountID string, amount int64) (*models.Transaction, error) {
+	if _, err := s.sessions.FindOpenForAgent(tenantID, agentID); err != nil {
+		return nil, ErrNoOpenSession
+	}
+
+	branch, err := s.branches.FindByID(tenantID, branchID)
+	if err != nil {
+		return nil, err
+	}
+
+	customerLeg := TransactionInput{
+		TenantID:          tenantID,
+		AccountID:         accountID,
+		Type:              models.TransactionTypeWithdrawal,
+		Amount:            amount,
+		BranchID:          branchID,
+		PerformedByUserID: agentID,
+	}
+	branchLeg := customerLeg
+	branchLeg.AccountID = branch.CashAccountID
+
+	var customerTx, branchTx *models.Transaction
+	err = s.transactions.db.Transaction(func(dbtx *gorm.DB) error {
+		var err error
+		customerTx, err = s.transactions.PostTx(dbtx, customerLeg)
+		if err != nil {
+			return err
+		}
+		branchTx, err = s.transactions.PostTx(dbtx, branchLeg)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.transactions.afterPost(ctx, customerTx, customerLeg)
+	s.transactions.afterPost(ctx, branchTx, branchLeg)
+	return customerTx, nil
+}
+
+// OpenSession starts a new cash-drawer session for an agent.
+func (s *TellerService) OpenSession(tenantID, branchID, agentID string, openingBalance int64) (*models.TellerSession, error) {
+	session := &models.TellerSession{
+		ID:             newID(),
+		TenantID:       tenantID,
+		BranchID:       branchID,
+		AgentID:        agentID,
+		Status:         models.TellerSessionOpen,
+		OpeningBalance: openingBalance,
+	}
+	if err := s.sessions.Create(session); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// CloseSession closes an agent's open session with the counted closing
+// balance, producing the figures used in the end-of-day report.
+func (s *TellerService) CloseSession(tenantID, agentID string, closingBalance int64) (*models.TellerSession, error) {
+	session, err := s.sessions.FindOpenForAgent(tenantID, agentID)
+	if err != nil {
+		return nil, ErrNoOpenSession
+	}
+
+	if err := s.sessions.Close(tenantID, session.ID, closingBalance); err != nil {
+		return nil, err
+	}
+
+	session.Status = models.TellerSessionClosed
+	session.ClosingBalance = closingBalance
+	return session, nil
+}
@@ -0,0 +1,64 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// TestTellerService_CashOutRollsBackBothLegsOnFailure exercises a branch
+// cash account that can't cover a cash-out (e.g. the drawer is short) and
+// asserts the customer leg never commits either. Before both legs shared
+// a database transaction, the customer leg posted and committed on its
+// own before the branch leg was attempted, so a branch-side failure left
+// the customer debited with no offsetting branch-cash entry for
+// reconciliation to find.
+func TestTellerService_CashOutRollsBackBothLegsOnFailure(t *testing.T) {
+	db := testDB(t)
+
+	const (
+		tenantID = "99999999-9999-9999-9999-999999999999"
+		amount   = int64(500)
+	)
+
+	accounts := repository.NewAccountRepository(db)
+	customer := &models.Account{ID: newID(), TenantID: tenantID, UserID: newID(), Currency: "USD", Balance: 10_000}
+	if err := accounts.Create(customer); err != nil {
+		t.Fatalf("create customer account: %v", err)
+	}
+	branchCash := &models.Account{ID: newID(), TenantID: tenantID, UserID: newID(), Currency: "USD", Balance: 100}
+	if err := accounts.Create(branchCash); err != nil {
+		t.Fatalf("create branch cash account: %v", err)
+	}
+
+	branches := repository.NewBranchRepository(db)
+	branch := &models.Branch{ID: newID(), TenantID: tenantID, Name: "Test Branch", Code: "TB1", CashAccountID: branchCash.ID}
+	if err := branches.Create(branch); err != nil {
+		t.Fatalf("create branch: %v", err)
+	}
+
+	sessions := repository.NewTellerSessionRepository(db)
+	agentID := newID()
+	if err := sessions.Create(&models.TellerSession{ID: newID(), TenantID: tenantID, BranchID: branch.ID, AgentID: agentID, Status: models.TellerSessionOpen}); err != nil {
+		t.Fatalf("create teller session: %v", err)
+	}
+
+	transactions := NewTransactionService(db, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	svc := NewTellerService(transactions, branches, sessions)
+
+	// The branch cash drawer only has 100 but the cash-out asks for 500,
+	// so the branch leg fails on insufficient funds.
+	if _, err := svc.CashOut(context.Background(), tenantID, branch.ID, agentID, customer.ID, amount); err == nil {
+		t.Fatal("expected CashOut to fail when the branch cash account can't cover the amount")
+	}
+
+	after, err := accounts.FindByID(tenantID, customer.ID)
+	if err != nil {
+		t.Fatalf("find customer account: %v", err)
+	}
+	if after.Balance != 10_000 {
+		t.Fatalf("customer balance = %d, want 10000 (customer leg rolled back with the failed branch leg)", after.Balance)
+	}
+}
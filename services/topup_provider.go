@@ -0,0 +1,20 @@
+package services
+
+import "fmt"
+
+// TopupProvider abstracts the external telco top-up gateway so it can be
+// swapped per tenant or mocked in tests.
+type TopupProvider interface {
+	// Purchase attempts to credit amount worth of airtime to phoneNumber
+	// and returns a provider reference on success.
+	Purchase(phoneNumber string, amount int64) (providerRef string, err error)
+}
+
+// NoopTopupProvider is a placeholder provider used until a real telco
+// integration is configured. It always succeeds and fabricates a reference.
+type NoopTopupProvider struct{}
+
+// Purchase implements TopupProvider.
+func (NoopTopupProvider) Purchase(phoneNumber string, amount int64) (string, error) {
+	return fmt.Sprintf("noop-%s-%d", phoneNumber, amount), nil
+}
@@ -0,0 +1,69 @@
+package services
+
+import (
+	"context"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// TopUpService debits an account and purchases airtime through a pluggable
+// TopupProvider, reversing the debit if the provider call fails.
+type TopUpService struct {
+	transactions *TransactionService
+	topups       *repository.TopUpRepository
+	provider     TopupProvider
+}
+
+// NewTopUpService builds a TopUpService.
+func NewTopUpService(transactions *TransactionService, topups *repository.TopUpRepository, provider TopupProvider) *TopUpService {
+	return &TopUpService{transactions: transactions, topups: topups, provider: provider}
+}
+
+// Purchase debits accountID for amount and buys airtime for phoneNumber.
+// On provider failure, the debit is reversed and the top-up is recorded as
+// failed rather than returned as an error, so the caller can surface the
+// failure reason without treating it as a request error.
+func (s *TopUpService) Purchase(ctx context.Context, tenantID, accountID, phoneNumber string, amount int64) (*models.TopUp, error) {
+	debit, err := s.transactions.Post(ctx, TransactionInput{
+		TenantID:  tenantID,
+		AccountID: accountID,
+		Type:      models.TransactionTypeWithdrawal,
+		Amount:    amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	topup := &models.TopUp{
+		ID:            newID(),
+		TenantID:      tenantID,
+		AccountID:     accountID,
+		PhoneNumber:   phoneNumber,
+		Amount:        amount,
+		TransactionID: debit.ID,
+	}
+
+	ref, providerErr := s.provider.Purchase(phoneNumber, amount)
+	if providerErr != nil {
+		if _, reverseErr := s.transactions.Post(ctx, TransactionInput{
+			TenantID:  tenantID,
+			AccountID: accountID,
+			Type:      models.TransactionTypeDeposit,
+			Amount:    amount,
+		}); reverseErr != nil {
+			return nil, reverseErr
+		}
+		topup.Status = models.TopupFailed
+		topup.FailureReason = providerErr.Error()
+	} else {
+		topup.Status = models.TopupSucceeded
+		topup.ProviderRef = ref
+	}
+
+	if err := s.topups.Create(topup); err != nil {
+		return nil, err
+	}
+
+	return topup, nil
+}
@@ -0,0 +1,959 @@
+// Package services holds business logic that spans multiple repositories,
+// such as posting a transaction and keeping the account balance, the
+// system-of-record row, and the Mongo transaction log all consistent.
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+const (
+	// maxEventPublishAttempts is how many delivery attempts publishEvent
+	// makes for an event, the first one included, before giving up and
+	// recording a dead letter.
+	maxEventPublishAttempts = 3
+
+	// quarantineAlertWindow and quarantineAlertThreshold define what counts
+	// as a quarantine rate spike: this many poison messages within this
+	// window fires an alert.
+	quarantineAlertWindow    = 5 * time.Minute
+	quarantineAlertThreshold = 5
+)
+
+// ErrInsufficientFunds is returned when a withdrawal or transfer would
+// overdraw an account.
+var ErrInsufficientFunds = errors.New("insufficient funds")
+
+// ErrLimitExceeded is returned when a withdrawal or transfer would exceed
+// the account's configured per-transaction, daily, or monthly limit.
+var ErrLimitExceeded = errors.New("transaction exceeds account limit")
+
+// ErrAccountFrozen is returned when an account has an active freeze, such
+// as the one PINService places after a PIN reset.
+var ErrAccountFrozen = errors.New("account is temporarily frozen")
+
+// ErrRateLimitExceeded is returned when an account has already hit its
+// configured max transactions per minute, regardless of transaction type.
+var ErrRateLimitExceeded = errors.New("transaction submission rate limit exceeded")
+
+// ErrCurrencyMismatch is returned when in.Currency is set and doesn't
+// match the account's own currency. Cross-currency movements aren't
+// supported until FX conversion is implemented.
+var ErrCurrencyMismatch = errors.New("transaction currency does not match account currency")
+
+// transactionRateLimitWindow is the rolling window
+// checkRateLimit counts submissions over.
+const transactionRateLimitWindow = time.Minute
+
+// ErrBatchNotDepositOnly is returned by PostBatch when any input isn't a
+// deposit.
+var ErrBatchNotDepositOnly = errors.New("batch posting only supports deposits")
+
+// ErrBatchTenantMismatch is returned by PostBatch when an input's
+// TenantID doesn't match the tenantID the batch was submitted for.
+var ErrBatchTenantMismatch = errors.New("batch input belongs to a different tenant")
+
+// TransactionInput describes a single balance-affecting operation to post.
+type TransactionInput struct {
+	TenantID              string
+	AccountID             string
+	CounterpartyAccountID string
+	Type                  models.TransactionType
+	Amount                int64
+	// Currency, if set, must match the account's own currency; Post
+	// rejects the transaction otherwise, since cross-currency movements
+	// aren't supported until FX conversion is implemented.
+	Currency          string
+	BranchID          string
+	PerformedByUserID string
+	ClientIP          string
+	ReasonCode        string
+	Justification     string
+	Category          string
+	Merchant          string
+
+	// IdempotencyKey, if set, makes Post safe to call more than once for
+	// the same logical operation: a redelivered request bearing a key
+	// that's already been processed returns the original result instead of
+	// double-applying it.
+	IdempotencyKey string
+
+	// RequestID, if set, is used as the posted Transaction's ID instead of
+	// generating a fresh one, so a caller that already handed the ID out
+	// (e.g. before a maker-checker approval or compliance hold resolves)
+	// gets back the same transaction it was told to expect.
+	RequestID string
+
+	// CorrelationID, if set, is carried into the published event envelope
+	// and the Mongo transaction log so a support engineer can trace a
+	// customer's app log line through to the exact backend activity it
+	// caused.
+	CorrelationID string
+
+	// GLPosting, if set, makes Post record an offsetting double-entry leg
+	// against an internal GL account in the same database transaction as
+	// the customer-side posting, so the two can never fall out of sync.
+	// Callers that credit or debit a customer account for money the bank
+	// itself is party to (interest paid, fees earned, external
+	// settlements) should set this instead of letting the movement post
+	// uncounterpartied.
+	GLPosting *GLPosting
+
+	// DescriptionLocale and DescriptionVars, together with ReasonCode,
+	// tell Post how to render the posted Transaction's Description via
+	// DescriptionTemplateService. DescriptionLocale defaults to "en" if
+	// empty. Only meaningful when ReasonCode is set.
+	DescriptionLocale string
+	DescriptionVars   map[string]string
+}
+
+// GLPosting describes the offsetting GL leg a TransactionInput should
+// post alongside its customer-side movement.
+type GLPosting struct {
+	AccountType models.GLAccountType
+	Direction   models.GLEntryDirection
+	Amount      int64
+}
+
+// ErrTransactionBlocked is returned when the fraud rules engine blocks a
+// transaction outright instead of letting it post.
+var ErrTransactionBlocked = errors.New("transaction blocked by fraud rules")
+
+// TransactionService posts transactions atomically against Postgres and
+// mirrors them into the Mongo transaction log on a best-effort basis.
+type TransactionService struct {
+	db            *gorm.DB
+	logs          *repository.TransactionLogRepository
+	fraud         *FraudEngine
+	fraudReviews  *repository.FraudReviewRepository
+	auditLogs     *repository.AuditLogRepository
+	events        EventPublisher
+	deadLetters   *repository.DeadLetterRepository
+	alerts        AlertProvider
+	processed     *repository.ProcessedTransactionRepository
+	limits        *repository.LimitRepository
+	freezes       *repository.AccountFreezeRepository
+	metrics       *EventMetrics
+	fees          *FeeService
+	largeTxAlerts *LargeTransactionAlertService
+	webhooks      *AccountWebhookService
+	gl            *GLService
+	cutoffs       *CutoffService
+	enrichment    *LogEnrichmentService
+	descriptions  *DescriptionTemplateService
+	chaos         *ChaosService
+	exposure      *ExposureService
+}
+
+// NewTransactionService builds a TransactionService. metrics may be nil,
+// in which case event-publish throughput and outcomes simply aren't
+// recorded. fees may be nil, in which case Preview reports every
+// transaction as fee-free. largeTxAlerts may be nil, in which case no
+// large-transaction alerts are ever evaluated. webhooks may be nil, in
+// which case no account webhook subscriptions are ever evaluated. gl may
+// be nil, in which case a TransactionInput with GLPosting set fails to
+// post its GL leg silently rather than erroring, since a caller that sets
+// GLPosting is asking for reconciliation, not asking Post to require it.
+// cutoffs may be nil, in which case a posted Transaction's ValueDate is
+// left nil rather than computed. enrichment may be nil, in which case a
+// log entry is never annotated beyond what Post already has on hand.
+// descriptions may be nil, in which case a posted Transaction's
+// Description is always left empty. chaos may be nil, in which case Post
+// never simulates a Postgres or Mongo failure regardless of what a
+// ChaosController elsewhere has armed. exposure may be nil, in which
+// case a deposit or inbound transfer is never rejected for pushing its
+// recipient over an ExposureLimit.
+func NewTransactionService(db *gorm.DB, logs *repository.TransactionLogRepository, fraud *FraudEngine, fraudReviews *repository.FraudReviewRepository, auditLogs *repository.AuditLogRepository, events EventPublisher, deadLetters *repository.DeadLetterRepository, alerts AlertProvider, processed *repository.ProcessedTransactionRepository, limits *repository.LimitRepository, freezes *repository.AccountFreezeRepository, metrics *EventMetrics, fees *FeeService, largeTxAlerts *LargeTransactionAlertService, webhooks *AccountWebhookService, gl *GLService, cutoffs *CutoffService, enrichment *LogEnrichmentService, descriptions *DescriptionTemplateService, chaos *ChaosService, exposure *ExposureService) *TransactionService {
+	return &TransactionService{db: db, logs: logs, fraud: fraud, fraudReviews: fraudReviews, auditLogs: auditLogs, events: events, deadLetters: deadLetters, alerts: alerts, processed: processed, limits: limits, freezes: freezes, metrics: metrics, fees: fees, largeTxAlerts: largeTxAlerts, webhooks: webhooks, gl: gl, cutoffs: cutoffs, enrichment: enrichment, descriptions: descriptions, chaos: chaos, exposure: exposure}
+}
+
+// Post applies in.Amount to in.AccountID (and, for transfers, the inverse
+// to in.CounterpartyAccountID), writes the Transaction row, and appends to
+// the transaction log, all inside a single Postgres transaction for the
+// balance and ledger row. If in.IdempotencyKey has already been processed,
+// Post returns the original result instead of applying it again. If
+// in.RequestID is set, it becomes the posted Transaction's ID.
+func (s *TransactionService) Post(ctx context.Context, in TransactionInput) (*models.Transaction, error) {
+	if in.IdempotencyKey != "" && s.processed != nil {
+		if existing, err := s.processed.FindByKey(in.TenantID, in.IdempotencyKey); err == nil {
+			return repository.NewTransactionRepository(s.db).FindByID(in.TenantID, existing.TransactionID)
+		}
+	}
+
+	var tx *models.Transaction
+	err := s.db.Transaction(func(dbtx *gorm.DB) error {
+		var err error
+		tx, err = s.postCore(dbtx, in)
+		return err
+	})
+	if err != nil {
+		s.publishEvent(transactionEventType(in.Type, "failed"), in.TenantID, in.AccountID, in.CorrelationID, map[string]interface{}{
+			"tenant_id":  in.TenantID,
+			"account_id": in.AccountID,
+			"type":       in.Type,
+			"amount":     in.Amount,
+			"error":      err.Error(),
+		})
+		if s.webhooks != nil {
+			s.webhooks.OnFailed(in.TenantID, in.AccountID, in.Amount)
+		}
+		return nil, err
+	}
+
+	s.afterPost(ctx, tx, in)
+	return tx, nil
+}
+
+// PostTx posts in using dbtx instead of opening its own database
+// transaction, for a caller that needs to post more than one leg
+// atomically, such as TellerService pairing a customer leg with the
+// branch cash leg. Idempotency dedup and the post-commit side effects
+// Post runs afterward (logs, published events, large-transaction alerts,
+// webhooks) are the caller's job once every leg in dbtx has committed;
+// PostTx only does the transactional posting itself.
+func (s *TransactionService) PostTx(dbtx *gorm.DB, in TransactionInput) (*models.Transaction, error) {
+	return s.postCore(dbtx, in)
+}
+
+// postCore performs the transactional work of posting in against dbtx:
+// locking the accounts it touches, running freeze/limit/fraud checks,
+// updating balances, and writing the Transaction (and its GL offset,
+// idempotency, audit, and fraud-review rows). It has no side effects
+// outside dbtx, so Post and PostTx can share it while deciding
+// separately when their own database transaction commits and when the
+// post-commit side effects in afterPost run.
+func (s *TransactionService) postCore(dbtx *gorm.DB, in TransactionInput) (*models.Transaction, error) {
+	var tx *models.Transaction
+	var flaggedRule, flaggedReason string
+	var riskScore int
+
+	err := func() error {
+		if s.chaos != nil {
+			if err := s.chaos.CheckPostgres(); err != nil {
+				return err
+			}
+		}
+
+		accounts := repository.NewAccountRepository(dbtx)
+		txs := repository.NewTransactionRepository(dbtx)
+
+		// Lock every account this transaction touches before anything
+		// below reads an aggregate against one of them (checkLimits'
+		// and checkRateLimit's window sums, the fraud engine's
+		// daily-withdrawal sum). Under READ COMMITTED, concurrent Post
+		// calls against the same account that all read those aggregates
+		// before any of them holds this lock would all see the same
+		// "amount so far" and all pass a daily/monthly/rate limit that,
+		// taken together, they blow through. Locking first serializes
+		// every check below per account, same as it already serializes
+		// the balance update.
+		//
+		// For a transfer, both accounts are locked here, in a fixed
+		// order (lexicographically by ID) regardless of which one is
+		// the source: locking source-then-counterparty in caller-
+		// supplied order means two transfers between the same pair
+		// moving in opposite directions lock in opposite orders and can
+		// deadlock under concurrent load.
+		var account, counterparty *models.Account
+		var err error
+		if in.Type == models.TransactionTypeTransfer {
+			firstID, secondID := in.AccountID, in.CounterpartyAccountID
+			if secondID < firstID {
+				firstID, secondID = secondID, firstID
+			}
+			first, ferr := accounts.FindByIDForUpdate(in.TenantID, firstID)
+			if ferr != nil {
+				return ferr
+			}
+			second, serr := accounts.FindByIDForUpdate(in.TenantID, secondID)
+			if serr != nil {
+				return serr
+			}
+			if firstID == in.AccountID {
+				account, counterparty = first, second
+			} else {
+				account, counterparty = second, first
+			}
+		} else {
+			account, err = accounts.FindByIDForUpdate(in.TenantID, in.AccountID)
+			if err != nil {
+				return err
+			}
+		}
+
+		if s.freezes != nil {
+			if err := s.checkFreeze(dbtx, in); err != nil {
+				return err
+			}
+		}
+
+		if s.limits != nil {
+			if err := s.checkLimits(dbtx, txs, in); err != nil {
+				return err
+			}
+			if err := s.checkRateLimit(dbtx, txs, in); err != nil {
+				return err
+			}
+		}
+
+		if s.fraud != nil {
+			decision, rule, reason, score, err := s.fraud.WithTx(dbtx).Evaluate(in)
+			if err != nil {
+				return err
+			}
+			riskScore = score
+			switch decision {
+			case models.FraudDecisionBlock:
+				return ErrTransactionBlocked
+			case models.FraudDecisionReview:
+				flaggedRule, flaggedReason = rule, reason
+			}
+		}
+
+		if in.Currency != "" && in.Currency != account.Currency {
+			return ErrCurrencyMismatch
+		}
+		balanceBefore := account.Balance
+
+		switch in.Type {
+		case models.TransactionTypeDeposit:
+			if s.exposure != nil {
+				if err := s.checkExposure(dbtx, in.TenantID, account.UserID, in.Amount); err != nil {
+					return err
+				}
+			}
+			account.Balance += in.Amount
+		case models.TransactionTypeAdjustmentCredit:
+			account.Balance += in.Amount
+		case models.TransactionTypeWithdrawal, models.TransactionTypeAdjustmentDebit:
+			if account.Available() < in.Amount {
+				return ErrInsufficientFunds
+			}
+			account.Balance -= in.Amount
+		case models.TransactionTypeTransfer:
+			if account.Available() < in.Amount {
+				return ErrInsufficientFunds
+			}
+			account.Balance -= in.Amount
+
+			if s.exposure != nil {
+				if err := s.checkExposure(dbtx, in.TenantID, counterparty.UserID, in.Amount); err != nil {
+					return err
+				}
+			}
+			counterparty.Balance += in.Amount
+			if err := accounts.UpdateBalance(in.TenantID, counterparty.ID, counterparty.Balance); err != nil {
+				return err
+			}
+		default:
+			return errors.New("unsupported transaction type")
+		}
+
+		if err := accounts.UpdateBalance(in.TenantID, account.ID, account.Balance); err != nil {
+			return err
+		}
+
+		txID := in.RequestID
+		if txID == "" {
+			txID = newID()
+		}
+
+		var valueDate *time.Time
+		if s.cutoffs != nil {
+			vd, err := s.cutoffs.ValueDate(in.TenantID, in.Type, time.Now())
+			if err != nil {
+				return err
+			}
+			valueDate = &vd
+		}
+
+		var description string
+		if in.ReasonCode != "" && s.descriptions != nil {
+			description, err = s.descriptions.Render(in.TenantID, in.ReasonCode, in.DescriptionLocale, in.DescriptionVars)
+			if err != nil {
+				return err
+			}
+		}
+
+		tx = &models.Transaction{
+			ID:                    txID,
+			TenantID:              in.TenantID,
+			AccountID:             in.AccountID,
+			CounterpartyAccountID: in.CounterpartyAccountID,
+			Type:                  in.Type,
+			Amount:                in.Amount,
+			BalanceAfter:          account.Balance,
+			Currency:              account.Currency,
+			Status:                models.TransactionStatusPosted,
+			BranchID:              in.BranchID,
+			PerformedByUserID:     in.PerformedByUserID,
+			ClientIP:              in.ClientIP,
+			RiskScore:             riskScore,
+			ReasonCode:            in.ReasonCode,
+			Justification:         in.Justification,
+			Category:              in.Category,
+			Merchant:              in.Merchant,
+			ValueDate:             valueDate,
+			Description:           description,
+		}
+
+		if err := txs.Create(tx); err != nil {
+			return err
+		}
+
+		if in.GLPosting != nil && s.gl != nil {
+			if err := s.gl.Post(dbtx, in.TenantID, in.GLPosting.AccountType, account.Currency, in.GLPosting.Direction, in.GLPosting.Amount, tx.ID, in.ReasonCode); err != nil {
+				return err
+			}
+		}
+
+		if in.IdempotencyKey != "" && s.processed != nil {
+			processed := &models.ProcessedTransaction{
+				ID:             newID(),
+				TenantID:       in.TenantID,
+				IdempotencyKey: in.IdempotencyKey,
+				TransactionID:  tx.ID,
+			}
+			if err := s.processed.WithTx(dbtx).Create(processed); err != nil {
+				return err
+			}
+		}
+
+		if in.ReasonCode != "" && s.auditLogs != nil {
+			audit := &models.AuditLog{
+				ID:          newID(),
+				TenantID:    in.TenantID,
+				ActorUserID: in.PerformedByUserID,
+				Action:      "manual_balance_adjustment",
+				EntityType:  "transaction",
+				EntityID:    tx.ID,
+				ReasonCode:  in.ReasonCode,
+				Description: in.Justification,
+				BeforeValue: fmt.Sprintf(`{"balance":%d}`, balanceBefore),
+				AfterValue:  fmt.Sprintf(`{"balance":%d}`, account.Balance),
+			}
+			if err := s.auditLogs.WithTx(dbtx).Create(audit); err != nil {
+				return err
+			}
+		}
+
+		if flaggedRule != "" && s.fraudReviews != nil {
+			review := &models.FraudReview{
+				ID:            newID(),
+				TenantID:      in.TenantID,
+				AccountID:     in.AccountID,
+				TransactionID: tx.ID,
+				Rule:          flaggedRule,
+				Reason:        flaggedReason,
+				Status:        models.FraudReviewPending,
+			}
+			if err := s.fraudReviews.WithTx(dbtx).Create(review); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}()
+	if err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// afterPost runs Post's post-commit side effects for tx once every leg of
+// its database transaction has committed: the Mongo transaction log, the
+// completed event, large-transaction alerts, and account webhooks.
+func (s *TransactionService) afterPost(ctx context.Context, tx *models.Transaction, in TransactionInput) {
+	if s.logs != nil {
+		for _, entry := range s.buildLogEntries(tx, in) {
+			insertErr := s.logs.Insert(ctx, entry)
+			if insertErr == nil && s.chaos != nil {
+				insertErr = s.chaos.CheckMongo()
+			}
+			if insertErr != nil {
+				// The Postgres row is the system of record; the Mongo log is a
+				// best-effort mirror for search and reporting, so we log and
+				// continue rather than fail an already-posted transaction.
+				log.Printf("transaction log write failed for %s: %v", entry.ID, insertErr)
+				continue
+			}
+			if s.enrichment != nil {
+				s.enrichment.Run(entry, in.ClientIP)
+			}
+		}
+	}
+
+	s.publishEvent(transactionEventType(tx.Type, "completed"), tx.TenantID, tx.AccountID, in.CorrelationID, tx)
+
+	if s.largeTxAlerts != nil {
+		s.largeTxAlerts.OnPosted(tx)
+	}
+
+	if s.webhooks != nil {
+		s.webhooks.OnPosted(tx)
+	}
+}
+
+// buildLogEntries returns the TransactionLog entries tx should produce: a
+// single entry for a deposit, withdrawal, or adjustment, or one entry per
+// side of a transfer so each account's history reads correctly, complete
+// with direction and counterparty details, on its own.
+func (s *TransactionService) buildLogEntries(tx *models.Transaction, in TransactionInput) []*models.TransactionLog {
+	direction := models.TransactionLogDirectionCredit
+	if tx.Type == models.TransactionTypeWithdrawal || tx.Type == models.TransactionTypeAdjustmentDebit || tx.Type == models.TransactionTypeTransfer {
+		direction = models.TransactionLogDirectionDebit
+	}
+
+	primary := &models.TransactionLog{
+		ID:                    tx.ID,
+		TenantID:              tx.TenantID,
+		AccountID:             tx.AccountID,
+		Type:                  tx.Type,
+		Amount:                tx.Amount,
+		Currency:              tx.Currency,
+		Status:                string(tx.Status),
+		RiskScore:             tx.RiskScore,
+		ReasonCode:            tx.ReasonCode,
+		Category:              tx.Category,
+		Merchant:              tx.Merchant,
+		Direction:             direction,
+		CounterpartyAccountID: tx.CounterpartyAccountID,
+		CounterpartyName:      s.accountOwnerName(tx.TenantID, tx.CounterpartyAccountID),
+		CorrelationID:         in.CorrelationID,
+		CreatedAt:             tx.CreatedAt,
+	}
+
+	entries := []*models.TransactionLog{primary}
+
+	if tx.Type == models.TransactionTypeTransfer && tx.CounterpartyAccountID != "" {
+		entries = append(entries, &models.TransactionLog{
+			ID:                    tx.ID + "-credit",
+			TenantID:              tx.TenantID,
+			AccountID:             tx.CounterpartyAccountID,
+			Type:                  tx.Type,
+			Amount:                tx.Amount,
+			Currency:              tx.Currency,
+			Status:                string(tx.Status),
+			RiskScore:             tx.RiskScore,
+			Category:              tx.Category,
+			Merchant:              tx.Merchant,
+			Direction:             models.TransactionLogDirectionCredit,
+			CounterpartyAccountID: tx.AccountID,
+			CounterpartyName:      s.accountOwnerName(tx.TenantID, tx.AccountID),
+			CorrelationID:         in.CorrelationID,
+			CreatedAt:             tx.CreatedAt,
+		})
+	}
+
+	return entries
+}
+
+// accountOwnerName looks up accountID's owner's name, for filing on the
+// counterparty side of a transfer log entry. Any failure, including an
+// empty accountID, resolves to "" rather than blocking the log write.
+func (s *TransactionService) accountOwnerName(tenantID, accountID string) string {
+	if accountID == "" {
+		return ""
+	}
+	account, err := repository.NewAccountRepository(s.db).FindByID(tenantID, accountID)
+	if err != nil {
+		return ""
+	}
+	user, err := repository.NewUserRepository(s.db, nil, nil).FindByID(tenantID, account.UserID)
+	if err != nil {
+		return ""
+	}
+	return user.Name
+}
+
+// PostBatch posts a set of same-tenant deposits within a single database
+// transaction, crediting every account with one multi-row UPDATE and
+// inserting every Transaction row with one multi-row INSERT, instead of
+// opening a fresh Postgres transaction per deposit. It trades the
+// per-item guarantees Post makes for throughput, so it's meant for
+// high-volume, already-screened deposit sources (e.g. a payroll batch)
+// rather than the interactive path: it skips the fraud engine, account
+// freezes, limits, and rate limiting, and every input must be a deposit
+// for tenantID, since those are the only case where "add amount to
+// balance" needs no read of the account's current state to validate.
+// Multiple deposits to the same account within a batch are summed before
+// the update; each resulting Transaction row records the account's final
+// balance after the whole batch applies, not its balance after that one
+// deposit.
+func (s *TransactionService) PostBatch(ctx context.Context, tenantID string, inputs []TransactionInput) ([]*models.Transaction, error) {
+	for _, in := range inputs {
+		if in.Type != models.TransactionTypeDeposit {
+			return nil, ErrBatchNotDepositOnly
+		}
+		if in.TenantID != tenantID {
+			return nil, ErrBatchTenantMismatch
+		}
+	}
+	if len(inputs) == 0 {
+		return nil, nil
+	}
+
+	var txs []*models.Transaction
+	err := s.db.Transaction(func(dbtx *gorm.DB) error {
+		accounts := repository.NewAccountRepository(dbtx)
+		transactions := repository.NewTransactionRepository(dbtx)
+
+		deltas := make(map[string]int64, len(inputs))
+		currencies := make(map[string]string, len(inputs))
+		for _, in := range inputs {
+			account, err := accounts.FindByIDForUpdate(tenantID, in.AccountID)
+			if err != nil {
+				return err
+			}
+			deltas[in.AccountID] += in.Amount
+			currencies[in.AccountID] = account.Currency
+		}
+
+		if err := accounts.CreditBatch(tenantID, deltas); err != nil {
+			return err
+		}
+
+		balances := make(map[string]int64, len(deltas))
+		for accountID := range deltas {
+			account, err := accounts.FindByID(tenantID, accountID)
+			if err != nil {
+				return err
+			}
+			balances[accountID] = account.Balance
+		}
+
+		txs = make([]*models.Transaction, 0, len(inputs))
+		for _, in := range inputs {
+			txID := in.RequestID
+			if txID == "" {
+				txID = newID()
+			}
+			txs = append(txs, &models.Transaction{
+				ID:           txID,
+				TenantID:     tenantID,
+				AccountID:    in.AccountID,
+				Type:         in.Type,
+				Amount:       in.Amount,
+				BalanceAfter: balances[in.AccountID],
+				Currency:     currencies[in.AccountID],
+				Status:       models.TransactionStatusPosted,
+				Category:     in.Category,
+				Merchant:     in.Merchant,
+			})
+		}
+
+		return transactions.CreateBatch(txs)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tx := range txs {
+		s.publishEvent(transactionEventType(tx.Type, "completed"), tx.TenantID, tx.AccountID, tx.ID, tx)
+	}
+
+	return txs, nil
+}
+
+// transactionEventType builds the routing key/event type for a
+// transaction event: "transaction.<type>.<stage>". AMQPEventPublisher
+// binds a dedicated queue per transaction type against the "transaction.
+// <type>.#" pattern, so deposits, withdrawals, and transfers can each run
+// their own consumer concurrency, retry policy, and priority.
+func transactionEventType(txType models.TransactionType, stage string) string {
+	return fmt.Sprintf("transaction.%s.%s", txType, stage)
+}
+
+// TransactionPreview is the outcome of a dry run: what Post would do to
+// in.AccountID without actually posting anything.
+type TransactionPreview struct {
+	Amount           int64  `json:"amount"`
+	Fee              int64  `json:"fee"`
+	TotalDebit       int64  `json:"total_debit"`
+	Currency         string `json:"currency"`
+	AccountBalance   int64  `json:"account_balance"`
+	AccountAvailable int64  `json:"account_available"`
+}
+
+// Preview runs the same freeze, limit, rate-limit, and currency checks
+// Post would, and computes the fee in.Type would incur, without posting
+// anything or holding any funds. It returns the same errors Post would
+// return on the equivalent input.
+func (s *TransactionService) Preview(ctx context.Context, in TransactionInput) (*TransactionPreview, error) {
+	accounts := repository.NewAccountRepository(s.db)
+	account, err := accounts.FindByID(in.TenantID, in.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	if in.Currency != "" && in.Currency != account.Currency {
+		return nil, ErrCurrencyMismatch
+	}
+
+	if s.freezes != nil {
+		if err := s.checkFreeze(s.db, in); err != nil {
+			return nil, err
+		}
+	}
+
+	if s.limits != nil {
+		txs := repository.NewTransactionRepository(s.db)
+		if err := s.checkLimits(s.db, txs, in); err != nil {
+			return nil, err
+		}
+		if err := s.checkRateLimit(s.db, txs, in); err != nil {
+			return nil, err
+		}
+	}
+
+	var fee int64
+	if s.fees != nil {
+		fee, err = s.fees.Calculate(in.TenantID, in.Type, in.Amount)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	totalDebit := in.Amount
+	if in.Type == models.TransactionTypeWithdrawal || in.Type == models.TransactionTypeTransfer {
+		totalDebit += fee
+		if account.Available() < totalDebit {
+			return nil, ErrInsufficientFunds
+		}
+	}
+
+	return &TransactionPreview{
+		Amount:           in.Amount,
+		Fee:              fee,
+		TotalDebit:       totalDebit,
+		Currency:         account.Currency,
+		AccountBalance:   account.Balance,
+		AccountAvailable: account.Available(),
+	}, nil
+}
+
+// checkExposure resolves userID and delegates to ExposureService.CheckCredit,
+// so a deposit or inbound transfer that would push the recipient over their
+// KYC level's exposure ceiling is rejected before the balance is mutated.
+func (s *TransactionService) checkExposure(dbtx *gorm.DB, tenantID, userID string, incoming int64) error {
+	user, err := repository.NewUserRepository(dbtx, nil, nil).FindByID(tenantID, userID)
+	if err != nil {
+		return err
+	}
+	return s.exposure.CheckCredit(tenantID, user, incoming)
+}
+
+// checkFreeze rejects the transaction outright if in.AccountID has an
+// active freeze, e.g. one PINService placed after a PIN reset.
+func (s *TransactionService) checkFreeze(dbtx *gorm.DB, in TransactionInput) error {
+	if _, err := s.freezes.WithTx(dbtx).FindActive(in.TenantID, in.AccountID, time.Now()); err == nil {
+		return ErrAccountFrozen
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	return nil
+}
+
+// checkLimits enforces any admin-configured per-transaction, daily, or
+// monthly limit on in.AccountID against outbound movements (withdrawals
+// and transfers). An account with no limit override configured is
+// unrestricted.
+func (s *TransactionService) checkLimits(dbtx *gorm.DB, txs *repository.TransactionRepository, in TransactionInput) error {
+	if in.Type != models.TransactionTypeWithdrawal && in.Type != models.TransactionTypeTransfer {
+		return nil
+	}
+
+	limit, err := s.limits.WithTx(dbtx).FindForAccount(in.TenantID, in.AccountID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if limit.PerTransactionLimit > 0 && in.Amount > limit.PerTransactionLimit {
+		return ErrLimitExceeded
+	}
+
+	if limit.DailyLimit > 0 {
+		sum, err := txs.SumAmountByAccountSince(in.TenantID, in.AccountID, in.Type, time.Now().Add(-24*time.Hour))
+		if err != nil {
+			return err
+		}
+		if sum+in.Amount > limit.DailyLimit {
+			return ErrLimitExceeded
+		}
+	}
+
+	if limit.MonthlyLimit > 0 {
+		sum, err := txs.SumAmountByAccountSince(in.TenantID, in.AccountID, in.Type, time.Now().Add(-30*24*time.Hour))
+		if err != nil {
+			return err
+		}
+		if sum+in.Amount > limit.MonthlyLimit {
+			return ErrLimitExceeded
+		}
+	}
+
+	return nil
+}
+
+// checkRateLimit throttles how many transactions of any type in.AccountID
+// may submit within transactionRateLimitWindow, distinct from the
+// amount-based limits in checkLimits. It targets runaway client loops and
+// simple fraud scripts rather than legitimate high-value activity, so an
+// account with no rate limit configured is unrestricted.
+func (s *TransactionService) checkRateLimit(dbtx *gorm.DB, txs *repository.TransactionRepository, in TransactionInput) error {
+	limit, err := s.limits.WithTx(dbtx).FindForAccount(in.TenantID, in.AccountID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if limit.MaxTransactionsPerMinute <= 0 {
+		return nil
+	}
+
+	count, err := txs.CountAllTypesByAccountSince(in.TenantID, in.AccountID, time.Now().Add(-transactionRateLimitWindow))
+	if err != nil {
+		return err
+	}
+	if count >= int64(limit.MaxTransactionsPerMinute) {
+		return ErrRateLimitExceeded
+	}
+	return nil
+}
+
+// publishEvent delivers an event to downstream consumers (notifications,
+// webhooks, analytics), retrying a few times before giving up. A delivery
+// failure here must never undo or retry an already-posted transaction, so
+// once retries are exhausted the event is recorded as a dead letter for an
+// operator to inspect instead of being dropped silently.
+//
+// A payload that fails to serialize is a poison message: retrying would
+// fail identically every time, so it skips the retry loop entirely and
+// goes straight to quarantine.
+func (s *TransactionService) publishEvent(eventType, tenantID, accountID, correlationID string, payload interface{}) {
+	if s.events == nil {
+		return
+	}
+
+	if s.metrics != nil {
+		stopMetrics := s.metrics.StartPublish()
+		defer stopMetrics()
+	}
+
+	if _, err := json.Marshal(payload); err != nil {
+		log.Printf("%s event payload failed to serialize, quarantining without retry: %v", eventType, err)
+		s.quarantine(eventType, tenantID, accountID, payload, err)
+		return
+	}
+
+	firstAttempt := time.Now()
+	var lastErr error
+	for attempt := 1; attempt <= maxEventPublishAttempts; attempt++ {
+		if lastErr = s.events.Publish(eventType, correlationID, payload); lastErr == nil {
+			if s.metrics != nil {
+				s.metrics.Record(eventOutcomeProcessed, eventType)
+			}
+			return
+		}
+		log.Printf("%s event publish attempt %d/%d failed: %v", eventType, attempt, maxEventPublishAttempts, lastErr)
+		if s.metrics != nil && attempt < maxEventPublishAttempts {
+			s.metrics.Record(eventOutcomeRetried, eventType)
+		}
+	}
+
+	if s.metrics != nil {
+		s.metrics.Record(eventOutcomeFailed, eventType)
+	}
+
+	if s.deadLetters == nil {
+		return
+	}
+
+	dl := &models.DeadLetter{
+		ID:             newID(),
+		TenantID:       tenantID,
+		AccountID:      accountID,
+		EventType:      eventType,
+		Payload:        mustMarshal(payload),
+		ErrorType:      "publish_failed",
+		Error:          lastErr.Error(),
+		Attempts:       maxEventPublishAttempts,
+		FirstAttemptAt: firstAttempt,
+		LastAttemptAt:  time.Now(),
+	}
+	if err := s.deadLetters.Create(dl); err != nil {
+		log.Printf("failed to record dead letter for %s event: %v", eventType, err)
+		return
+	}
+	if s.metrics != nil {
+		s.metrics.Record(eventOutcomeDeadLettered, eventType)
+	}
+}
+
+// quarantine records a payload that failed to serialize as a poison-message
+// dead letter, with no retry attempts spent on it, and alerts if the
+// quarantine rate has spiked.
+func (s *TransactionService) quarantine(eventType, tenantID, accountID string, payload interface{}, marshalErr error) {
+	if s.deadLetters == nil {
+		return
+	}
+
+	now := time.Now()
+	dl := &models.DeadLetter{
+		ID:             newID(),
+		TenantID:       tenantID,
+		AccountID:      accountID,
+		EventType:      eventType,
+		Payload:        fmt.Sprintf("%+v", payload),
+		ErrorType:      "poison_message",
+		Error:          marshalErr.Error(),
+		Attempts:       1,
+		FirstAttemptAt: now,
+		LastAttemptAt:  now,
+	}
+	if err := s.deadLetters.Create(dl); err != nil {
+		log.Printf("failed to record quarantined %s event: %v", eventType, err)
+		return
+	}
+	if s.metrics != nil {
+		s.metrics.Record(eventOutcomeDeadLettered, eventType)
+	}
+
+	if s.alerts == nil {
+		return
+	}
+	count, err := s.deadLetters.CountSince("poison_message", now.Add(-quarantineAlertWindow))
+	if err != nil || count < quarantineAlertThreshold {
+		return
+	}
+	if err := s.alerts.Alert(
+		"poison message quarantine rate spike",
+		fmt.Sprintf("%d messages quarantined for failing to serialize in the last %s", count, quarantineAlertWindow),
+	); err != nil {
+		log.Printf("failed to send quarantine spike alert: %v", err)
+	}
+}
+
+// mustMarshal JSON-encodes v for storage, falling back to a plain string
+// representation if it can't be encoded.
+func mustMarshal(v interface{}) string {
+	body, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%+v", v)
+	}
+	return string(body)
+}
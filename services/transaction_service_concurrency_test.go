@@ -0,0 +1,78 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// TestPost_ConcurrentWithdrawalsRespectDailyLimit fires concurrent
+// withdrawals against a single account with a configured DailyLimit and
+// asserts that the account is never walked past it. Before checkLimits
+// ran after the account was locked, every goroutine could read the same
+// "amount withdrawn so far" under READ COMMITTED and all pass the check,
+// letting the account's daily withdrawals collectively exceed the limit.
+func TestPost_ConcurrentWithdrawalsRespectDailyLimit(t *testing.T) {
+	db := testDB(t)
+
+	const (
+		tenantID   = "11111111-1111-1111-1111-111111111111"
+		dailyLimit = int64(1000)
+		perCall    = int64(300)
+		callers    = 5
+	)
+
+	account := &models.Account{
+		ID:       newID(),
+		TenantID: tenantID,
+		UserID:   newID(),
+		Currency: "USD",
+		Balance:  1_000_000,
+	}
+	if err := repository.NewAccountRepository(db).Create(account); err != nil {
+		t.Fatalf("create account: %v", err)
+	}
+
+	limit := &models.Limit{
+		ID:         newID(),
+		TenantID:   tenantID,
+		Scope:      models.LimitScopeAccount,
+		AccountID:  account.ID,
+		DailyLimit: dailyLimit,
+	}
+	if err := repository.NewLimitRepository(db).Create(limit); err != nil {
+		t.Fatalf("create limit: %v", err)
+	}
+
+	txService := NewTransactionService(db, nil, nil, nil, nil, nil, nil, nil, nil, repository.NewLimitRepository(db), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	var wg sync.WaitGroup
+	successes := make([]bool, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, err := txService.Post(context.Background(), TransactionInput{
+				TenantID:  tenantID,
+				AccountID: account.ID,
+				Type:      models.TransactionTypeWithdrawal,
+				Amount:    perCall,
+			})
+			successes[i] = err == nil
+		}(i)
+	}
+	wg.Wait()
+
+	var posted int64
+	for _, ok := range successes {
+		if ok {
+			posted += perCall
+		}
+	}
+	if posted > dailyLimit {
+		t.Fatalf("posted %d against a daily limit of %d: concurrent withdrawals bypassed the limit", posted, dailyLimit)
+	}
+}
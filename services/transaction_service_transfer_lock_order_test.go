@@ -0,0 +1,69 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// TestPost_ConcurrentOppositeTransfersDoNotDeadlock fires transfers
+// between the same two accounts in both directions concurrently and
+// asserts every one of them eventually completes. Locking source-then-
+// counterparty in caller-supplied order means A->B and B->A lock the
+// two accounts in opposite orders and can deadlock; Postgres detects
+// the cycle and aborts one side with a serialization error, which would
+// surface here as a Post call returning an unexpected error.
+func TestPost_ConcurrentOppositeTransfersDoNotDeadlock(t *testing.T) {
+	db := testDB(t)
+
+	const (
+		tenantID = "22222222-2222-2222-2222-222222222222"
+		rounds   = 20
+		amount   = int64(10)
+	)
+
+	accounts := repository.NewAccountRepository(db)
+	a := &models.Account{ID: newID(), TenantID: tenantID, UserID: newID(), Currency: "USD", Balance: 1_000_000}
+	b := &models.Account{ID: newID(), TenantID: tenantID, UserID: newID(), Currency: "USD", Balance: 1_000_000}
+	if err := accounts.Create(a); err != nil {
+		t.Fatalf("create account a: %v", err)
+	}
+	if err := accounts.Create(b); err != nil {
+		t.Fatalf("create account b: %v", err)
+	}
+
+	txService := NewTransactionService(db, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, rounds*2)
+	for i := 0; i < rounds; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_, err := txService.Post(context.Background(), TransactionInput{
+				TenantID: tenantID, AccountID: a.ID, CounterpartyAccountID: b.ID,
+				Type: models.TransactionTypeTransfer, Amount: amount,
+			})
+			errs <- err
+		}()
+		go func() {
+			defer wg.Done()
+			_, err := txService.Post(context.Background(), TransactionInput{
+				TenantID: tenantID, AccountID: b.ID, CounterpartyAccountID: a.ID,
+				Type: models.TransactionTypeTransfer, Amount: amount,
+			})
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("concurrent opposite-direction transfer failed: %v", err)
+		}
+	}
+}
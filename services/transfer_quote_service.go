@@ -0,0 +1,150 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// transferQuoteTTL is how long a transfer quote holds its rate before the
+// customer must fetch a fresh one.
+const transferQuoteTTL = 5 * time.Minute
+
+// crossCurrencyArrivalWindow is the estimated time a cross-currency
+// external transfer takes to settle once executed.
+const crossCurrencyArrivalWindow = 2 * 24 * time.Hour
+
+// ErrTransferQuoteNotFound is returned when a transfer quote to execute
+// doesn't exist under the calling tenant.
+var ErrTransferQuoteNotFound = errors.New("transfer quote not found")
+
+// ErrTransferQuoteNotPending is returned when a transfer quote has
+// already been executed or has expired, enforcing that it can be
+// executed at most once.
+var ErrTransferQuoteNotPending = errors.New("transfer quote is not pending")
+
+// ErrTransferQuoteExpired is returned when a transfer quote is executed
+// after its rate lock has passed; the caller must request a fresh quote
+// rather than post at a stale rate.
+var ErrTransferQuoteExpired = errors.New("transfer quote has expired, request a new one")
+
+// TransferQuoteService prices a cross-currency external transfer,
+// combining the tenant's configured FX rate and fee schedule into a
+// quote the customer can review, and executes that exact quote through
+// ClearingService once the customer commits.
+type TransferQuoteService struct {
+	accounts *repository.AccountRepository
+	fxRates  *FXRateService
+	fees     *FeeService
+	quotes   *repository.TransferQuoteRepository
+	clearing *ClearingService
+}
+
+// NewTransferQuoteService builds a TransferQuoteService.
+func NewTransferQuoteService(accounts *repository.AccountRepository, fxRates *FXRateService, fees *FeeService, quotes *repository.TransferQuoteRepository, clearing *ClearingService) *TransferQuoteService {
+	return &TransferQuoteService{accounts: accounts, fxRates: fxRates, fees: fees, quotes: quotes, clearing: clearing}
+}
+
+// Quote prices a transfer of sourceAmount out of accountID, in the
+// account's own currency, delivered to the counterparty in
+// targetCurrency. The quoted fee, rate, and target amount are locked in
+// for transferQuoteTTL; Execute honors them exactly or fails with
+// ErrTransferQuoteExpired.
+func (s *TransferQuoteService) Quote(tenantID, accountID, counterpartyName, routingNumber, accountNumber string, sourceAmount int64, targetCurrency string) (*models.TransferQuote, error) {
+	account, err := s.accounts.FindByID(tenantID, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	fee, err := s.fees.Calculate(tenantID, models.TransactionTypeWithdrawal, sourceAmount)
+	if err != nil {
+		return nil, err
+	}
+	netSource := sourceAmount - fee
+	if netSource < 0 {
+		return nil, ErrInsufficientFunds
+	}
+
+	rateValue := 1.0
+	if account.Currency != targetCurrency {
+		rate, err := s.fxRates.Get(tenantID, account.Currency, targetCurrency)
+		if err != nil {
+			return nil, err
+		}
+		rateValue = rate.Rate
+	}
+	targetAmount := models.RoundMinorUnits(float64(netSource) * rateValue)
+
+	quote := &models.TransferQuote{
+		ID:                        newID(),
+		TenantID:                  tenantID,
+		AccountID:                 accountID,
+		CounterpartyName:          counterpartyName,
+		CounterpartyRoutingNumber: routingNumber,
+		CounterpartyAccountNumber: accountNumber,
+		SourceCurrency:            account.Currency,
+		TargetCurrency:            targetCurrency,
+		SourceAmount:              sourceAmount,
+		Fee:                       fee,
+		TargetAmount:              targetAmount,
+		Rate:                      rateValue,
+		EstimatedArrival:          time.Now().Add(crossCurrencyArrivalWindow),
+		Status:                    models.TransferQuotePending,
+		ExpiresAt:                 time.Now().Add(transferQuoteTTL),
+	}
+	if err := s.quotes.Create(quote); err != nil {
+		return nil, err
+	}
+	return quote, nil
+}
+
+// Execute submits the external transfer priced by quote id: accountID is
+// debited SourceAmount in SourceCurrency and the transfer is filed to
+// settle TargetAmount in TargetCurrency, exactly as quoted. A quote that
+// has already been executed, rejected, or has expired can never be
+// executed again.
+func (s *TransferQuoteService) Execute(ctx context.Context, tenantID, id string) (*models.ExternalTransfer, error) {
+	quote, err := s.quotes.FindByID(tenantID, id)
+	if err != nil {
+		return nil, ErrTransferQuoteNotFound
+	}
+	if quote.Status != models.TransferQuotePending {
+		return nil, ErrTransferQuoteNotPending
+	}
+	if time.Now().After(quote.ExpiresAt) {
+		_ = s.quotes.MarkExpired(tenantID, id)
+		return nil, ErrTransferQuoteExpired
+	}
+
+	// Claim the quote before executing, not after: two concurrent
+	// Execute calls can both read Pending above, and without a claim in
+	// between, both would submit the external transfer before either
+	// recorded the quote executed. MarkExecuted's WHERE status = pending
+	// guard means only one of them can win this update; the loser sees
+	// rowsAffected == 0 and reports ErrTransferQuoteNotPending instead of
+	// double-executing a "guaranteed" transfer.
+	rowsAffected, err := s.quotes.MarkExecuted(tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if rowsAffected == 0 {
+		return nil, ErrTransferQuoteNotPending
+	}
+
+	transfer, err := s.clearing.SubmitConverted(ctx, tenantID, quote.AccountID, quote.SourceAmount, quote.TargetAmount, quote.TargetCurrency, quote.CounterpartyName, quote.CounterpartyRoutingNumber, quote.CounterpartyAccountNumber)
+	if err != nil {
+		// The claim above already flipped this quote to executed; put
+		// it back to pending so it isn't stranded executed with nothing
+		// filed against it, and can be retried.
+		_ = s.quotes.Reopen(tenantID, id)
+		return nil, err
+	}
+
+	if err := s.quotes.SetExternalTransferID(tenantID, id, transfer.ID); err != nil {
+		return nil, err
+	}
+	return transfer, nil
+}
@@ -0,0 +1,89 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// TestTransferQuoteService_ConcurrentExecutesRunOnce fires concurrent
+// Execute calls against the same pending quote and asserts exactly one
+// of them submits the external transfer. Before MarkExecuted was
+// guarded by status = pending, SubmitConverted ran first and only
+// recorded executed afterward, so every caller that read Pending before
+// any of them updated the status would submit its own "guaranteed" FX
+// transfer.
+func TestTransferQuoteService_ConcurrentExecutesRunOnce(t *testing.T) {
+	db := testDB(t)
+
+	const (
+		tenantID = "88888888-8888-8888-8888-888888888888"
+		amount   = int64(500)
+		attempts = 5
+	)
+
+	accounts := repository.NewAccountRepository(db)
+	account := &models.Account{ID: newID(), TenantID: tenantID, UserID: newID(), Currency: "USD", Balance: 10_000}
+	if err := accounts.Create(account); err != nil {
+		t.Fatalf("create account: %v", err)
+	}
+
+	quotes := repository.NewTransferQuoteRepository(db)
+	quote := &models.TransferQuote{
+		ID:                        newID(),
+		TenantID:                  tenantID,
+		AccountID:                 account.ID,
+		CounterpartyName:          "Jane Doe",
+		CounterpartyRoutingNumber: "123456789",
+		CounterpartyAccountNumber: "000111222",
+		SourceCurrency:            "USD",
+		TargetCurrency:            "USD",
+		SourceAmount:              amount,
+		TargetAmount:              amount,
+		Rate:                      1,
+		EstimatedArrival:          time.Now().Add(crossCurrencyArrivalWindow),
+		Status:                    models.TransferQuotePending,
+		ExpiresAt:                 time.Now().Add(transferQuoteTTL),
+	}
+	if err := quotes.Create(quote); err != nil {
+		t.Fatalf("create quote: %v", err)
+	}
+
+	transactions := NewTransactionService(db, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	clearing := NewClearingService(repository.NewExternalTransferRepository(db), repository.NewClearingFileRepository(db), transactions)
+	svc := NewTransferQuoteService(accounts, nil, nil, quotes, clearing)
+
+	var wg sync.WaitGroup
+	executed := make([]bool, attempts)
+	for i := 0; i < attempts; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			transfer, err := svc.Execute(context.Background(), tenantID, quote.ID)
+			executed[i] = err == nil && transfer != nil
+		}(i)
+	}
+	wg.Wait()
+
+	successCount := 0
+	for _, ok := range executed {
+		if ok {
+			successCount++
+		}
+	}
+	if successCount != 1 {
+		t.Fatalf("got %d successful Execute calls, want exactly 1", successCount)
+	}
+
+	after, err := accounts.FindByID(tenantID, account.ID)
+	if err != nil {
+		t.Fatalf("find account: %v", err)
+	}
+	if after.Balance != 10_000-amount {
+		t.Fatalf("account balance = %d, want %d (executed exactly once)", after.Balance, 10_000-amount)
+	}
+}
@@ -0,0 +1,78 @@
+package services
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+)
+
+// webhookDeliveryTimeout bounds how long HTTPWebhookDispatcher waits for a
+// subscriber to respond, so a slow or unreachable endpoint can't hold up
+// the transaction it's reporting on.
+const webhookDeliveryTimeout = 10 * time.Second
+
+// WebhookDispatcher delivers an outbound, HMAC-signed webhook payload to a
+// subscriber's URL.
+type WebhookDispatcher interface {
+	// Deliver POSTs payload to url, signing it with secret. It returns an
+	// error if the payload couldn't be delivered or the subscriber
+	// responded with anything other than a 2xx status.
+	Deliver(url, secret string, payload []byte) error
+}
+
+// NoopWebhookDispatcher is a placeholder dispatcher used until a real one
+// is wired in. It logs instead of delivering.
+type NoopWebhookDispatcher struct{}
+
+// Deliver implements WebhookDispatcher.
+func (NoopWebhookDispatcher) Deliver(url, secret string, payload []byte) error {
+	log.Printf("webhook delivery to %s (noop dispatcher, not actually delivered): %d bytes", url, len(payload))
+	return nil
+}
+
+// HTTPWebhookDispatcher delivers outbound webhooks over plain HTTP POST,
+// signing each payload the same way WebhookService verifies inbound
+// deliveries: a hex-encoded HMAC-SHA256 of the body, carried in the
+// X-Webhook-Signature header.
+type HTTPWebhookDispatcher struct {
+	client *http.Client
+}
+
+// NewHTTPWebhookDispatcher builds an HTTPWebhookDispatcher.
+func NewHTTPWebhookDispatcher() *HTTPWebhookDispatcher {
+	return &HTTPWebhookDispatcher{client: &http.Client{Timeout: webhookDeliveryTimeout}}
+}
+
+// Deliver implements WebhookDispatcher.
+func (d *HTTPWebhookDispatcher) Deliver(url, secret string, payload []byte) error {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Webhook-Signature", signWebhookPayload(secret, payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook delivery to %s returned status %d", url, resp.StatusCode)
+	}
+	return nil
+}
+
+// signWebhookPayload returns the hex-encoded HMAC-SHA256 of payload keyed
+// by secret.
+func signWebhookPayload(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
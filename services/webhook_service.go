@@ -0,0 +1,167 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// ErrWebhookProviderNotFound is returned when a webhook is delivered for
+// a provider name that hasn't been registered for the tenant.
+var ErrWebhookProviderNotFound = errors.New("webhook provider not registered")
+
+// ErrWebhookSignatureInvalid is returned when a webhook's signature
+// doesn't verify against the registered provider's secret.
+var ErrWebhookSignatureInvalid = errors.New("webhook signature does not verify")
+
+// ErrUnsupportedWebhookEvent is returned when a webhook's event type
+// isn't one this codebase knows how to translate.
+var ErrUnsupportedWebhookEvent = errors.New("unsupported webhook event type")
+
+const (
+	webhookEventExternalDepositSettled = "external_deposit.settled"
+	webhookEventAccountVerified        = "account.verified"
+)
+
+// webhookEventPayload is the shape every supported provider's webhook
+// body is expected to decode into. Aggregators vary their JSON casing and
+// nesting in practice, but adapting that per-provider is a concern for
+// the aggregator's own client library, not this receiver.
+type webhookEventPayload struct {
+	EventID           string `json:"event_id"`
+	Type              string `json:"type"`
+	AccountID         string `json:"account_id"`
+	ExternalAccountID string `json:"external_account_id"`
+	Amount            int64  `json:"amount"`
+	Currency          string `json:"currency"`
+	Reference         string `json:"reference"`
+}
+
+// WebhookService receives, verifies, and dedups inbound webhooks from
+// external account-linking aggregators and payment processors, then
+// translates each supported event type into a local transaction or
+// status update.
+type WebhookService struct {
+	providers    *repository.WebhookProviderRepository
+	events       *repository.WebhookEventRepository
+	links        *repository.ExternalAccountLinkRepository
+	transactions *TransactionService
+	suspense     *SuspenseService
+}
+
+// NewWebhookService builds a WebhookService. suspense may be nil, in
+// which case a deposit that names an unknown account fails the webhook
+// delivery as it did before suspense handling existed.
+func NewWebhookService(providers *repository.WebhookProviderRepository, events *repository.WebhookEventRepository, links *repository.ExternalAccountLinkRepository, transactions *TransactionService, suspense *SuspenseService) *WebhookService {
+	return &WebhookService{providers: providers, events: events, links: links, transactions: transactions, suspense: suspense}
+}
+
+// Receive verifies signatureHex over payload using the secret registered
+// for providerName, then dedups and dispatches the event it decodes to.
+// A redelivery of an event already recorded is treated as a no-op success
+// rather than an error, since that's the outcome the aggregator expects.
+func (s *WebhookService) Receive(ctx context.Context, tenantID, providerName, signatureHex string, payload []byte) error {
+	provider, err := s.providers.FindByName(tenantID, providerName)
+	if err != nil {
+		return ErrWebhookProviderNotFound
+	}
+
+	if !verifyWebhookSignature(provider.Secret, payload, signatureHex) {
+		return ErrWebhookSignatureInvalid
+	}
+
+	var in webhookEventPayload
+	if err := json.Unmarshal(payload, &in); err != nil {
+		return err
+	}
+
+	event := &models.WebhookEvent{
+		ID:              newID(),
+		TenantID:        tenantID,
+		ProviderID:      provider.ID,
+		ExternalEventID: in.EventID,
+		EventType:       in.Type,
+	}
+	if err := s.events.Create(event); err != nil {
+		if _, ok := isUniqueViolation(err); ok {
+			return nil
+		}
+		return err
+	}
+
+	switch in.Type {
+	case webhookEventExternalDepositSettled:
+		return s.handleDepositSettled(ctx, tenantID, in)
+	case webhookEventAccountVerified:
+		return s.handleAccountVerified(tenantID, provider.ID, in)
+	default:
+		return ErrUnsupportedWebhookEvent
+	}
+}
+
+// handleDepositSettled posts a deposit for an external credit the
+// aggregator or processor has confirmed has settled. If AccountID doesn't
+// resolve to a known account, the funds are held in suspense instead of
+// failing the webhook delivery, so the aggregator doesn't retry a delivery
+// this codebase will never be able to post.
+func (s *WebhookService) handleDepositSettled(ctx context.Context, tenantID string, in webhookEventPayload) error {
+	_, err := s.transactions.Post(ctx, TransactionInput{
+		TenantID:       tenantID,
+		AccountID:      in.AccountID,
+		Type:           models.TransactionTypeDeposit,
+		Amount:         in.Amount,
+		ReasonCode:     "external_deposit_settled",
+		IdempotencyKey: in.EventID,
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) && s.suspense != nil {
+		_, suspenseErr := s.suspense.Record(tenantID, in.Amount, in.Currency, in.AccountID, in.EventID)
+		return suspenseErr
+	}
+	return err
+}
+
+// handleAccountVerified records that the aggregator has confirmed
+// ExternalAccountID belongs to the customer, creating the link on first
+// sight and marking it verified.
+func (s *WebhookService) handleAccountVerified(tenantID, providerID string, in webhookEventPayload) error {
+	link, err := s.links.FindByExternalAccountID(tenantID, providerID, in.ExternalAccountID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		link = &models.ExternalAccountLink{
+			ID:                newID(),
+			TenantID:          tenantID,
+			AccountID:         in.AccountID,
+			ProviderID:        providerID,
+			ExternalAccountID: in.ExternalAccountID,
+			Status:            models.ExternalAccountLinkPending,
+		}
+		if err := s.links.Create(link); err != nil {
+			return err
+		}
+	} else if err != nil {
+		return err
+	}
+
+	return s.links.MarkVerified(tenantID, link.ID)
+}
+
+// verifyWebhookSignature reports whether signatureHex is the hex-encoded
+// HMAC-SHA256 of payload keyed by secret.
+func verifyWebhookSignature(secret string, payload []byte, signatureHex string) bool {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	expected := mac.Sum(nil)
+
+	signature, err := hex.DecodeString(signatureHex)
+	if err != nil {
+		return false
+	}
+	return hmac.Equal(expected, signature)
+}
@@ -0,0 +1,82 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/divzzrk/go_bank_api/models"
+	"github.com/divzzrk/go_bank_api/repository"
+)
+
+// TestWithdrawalCodeService_ConcurrentRedeemsPostOnce fires concurrent
+// Redeem calls against the same pending code and asserts exactly one of
+// them posts a withdrawal. Before MarkStatus was guarded by
+// status = pending, every caller could read the code as pending and post
+// before any of them recorded it redeemed, so all of them would post.
+func TestWithdrawalCodeService_ConcurrentRedeemsPostOnce(t *testing.T) {
+	db := testDB(t)
+
+	const (
+		tenantID = "55555555-5555-5555-5555-555555555555"
+		amount   = int64(500)
+		attempts = 5
+	)
+
+	accounts := repository.NewAccountRepository(db)
+	account := &models.Account{ID: newID(), TenantID: tenantID, UserID: newID(), Currency: "USD", Balance: 10_000, HeldAmount: amount}
+	if err := accounts.Create(account); err != nil {
+		t.Fatalf("create account: %v", err)
+	}
+
+	codes := repository.NewWithdrawalCodeRepository(db)
+	code := &models.WithdrawalCode{
+		ID:        newID(),
+		TenantID:  tenantID,
+		AccountID: account.ID,
+		Code:      "123456",
+		Amount:    amount,
+		Status:    models.WithdrawalCodePending,
+		ExpiresAt: time.Now().Add(withdrawalCodeTTL),
+	}
+	if err := codes.Create(code); err != nil {
+		t.Fatalf("create code: %v", err)
+	}
+
+	transactions := NewTransactionService(db, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	svc := NewWithdrawalCodeService(db, transactions)
+
+	var wg sync.WaitGroup
+	posted := make([]bool, attempts)
+	for i := 0; i < attempts; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			tx, err := svc.Redeem(context.Background(), tenantID, code.Code)
+			posted[i] = err == nil && tx != nil
+		}(i)
+	}
+	wg.Wait()
+
+	successCount := 0
+	for _, ok := range posted {
+		if ok {
+			successCount++
+		}
+	}
+	if successCount != 1 {
+		t.Fatalf("got %d successful Redeem calls, want exactly 1", successCount)
+	}
+
+	after, err := accounts.FindByID(tenantID, account.ID)
+	if err != nil {
+		t.Fatalf("find account: %v", err)
+	}
+	if after.HeldAmount != 0 {
+		t.Fatalf("held amount = %d, want 0 (released exactly once)", after.HeldAmount)
+	}
+	if after.Balance != 10_000-amount {
+		t.Fatalf("account balance = %d, want %d (posted exactly once)", after.Balance, 10_000-amount)
+	}
+}
@@ -0,0 +1,9 @@
+// Package utils holds small helpers shared across controllers.
+package utils
+
+import "github.com/gin-gonic/gin"
+
+// Error writes a uniform {"error": message} JSON body.
+func Error(c *gin.Context, status int, message string) {
+	c.JSON(status, gin.H{"error": message})
+}
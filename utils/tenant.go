@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/divzzrk/go_bank_api/middleware"
+	"github.com/divzzrk/go_bank_api/models"
+)
+
+// TenantFromContext returns the tenant resolved by middleware.TenantResolver
+// for the current request. It panics if called on a route that does not run
+// behind that middleware, since that would indicate a routing bug rather
+// than a recoverable request error.
+func TenantFromContext(c *gin.Context) *models.Tenant {
+	return c.MustGet(middleware.TenantContextKey).(*models.Tenant)
+}
+
+// ActorFromContext returns the user resolved by middleware.RequireRole for
+// the current request. It panics if called on a route that does not run
+// behind that middleware.
+func ActorFromContext(c *gin.Context) *models.User {
+	return c.MustGet(middleware.ActorContextKey).(*models.User)
+}
+
+// JWTUserFromContext returns the user resolved by
+// middleware.JWTAuth for the current request. It panics if called
+// on a route that does not run behind that middleware.
+func JWTUserFromContext(c *gin.Context) *models.User {
+	return c.MustGet(middleware.JWTUserContextKey).(*models.User)
+}
+
+// ImpersonationFromContext returns the session resolved by
+// middleware.ImpersonationRequired for the current request. It panics if
+// called on a route that does not run behind that middleware.
+func ImpersonationFromContext(c *gin.Context) *models.ImpersonationSession {
+	return c.MustGet(middleware.ImpersonationContextKey).(*models.ImpersonationSession)
+}